@@ -14,6 +14,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/aperture/l402"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweep"
@@ -81,6 +82,11 @@ type Client struct {
 	// channel of a swap if the client requests to abandon it.
 	abandonChans map[lntypes.Hash]chan struct{}
 
+	// externalHtlcChans allows for handing an externally published htlc
+	// tx to the loop in swap identified by its swap hash, so that the swap
+	// itself persists it.
+	externalHtlcChans map[lntypes.Hash]chan *externalHtlcTx
+
 	lndServices *lndclient.LndServices
 	sweeper     *sweep.Sweeper
 	executor    *executor
@@ -88,6 +94,10 @@ type Client struct {
 	resumeReady chan struct{}
 	wg          sync.WaitGroup
 
+	// loopInPsbtMu serializes the publication of externally signed loop in
+	// htlc transactions.
+	loopInPsbtMu sync.Mutex
+
 	clientConfig
 }
 
@@ -131,6 +141,11 @@ type ClientConfig struct {
 	// MaxPaymentRetries is the maximum times we retry an off-chain payment
 	// (used in loop out).
 	MaxPaymentRetries int
+
+	// PresignedTimeoutFeeRates are the sorted fee rates in sat/vbyte at
+	// which the timeout txs of confirmed loop in htlcs are signed in
+	// advance. If empty, no timeout txs are presigned.
+	PresignedTimeoutFeeRates []uint64
 }
 
 // NewClient returns a new instance to initiate swaps with.
@@ -202,6 +217,13 @@ func NewClient(dbDir string, loopDB loopdb.SwapStore,
 		maxPaymentRetries:   cfg.MaxPaymentRetries,
 		cancelSwap:          swapServerClient.CancelLoopOutSwap,
 		verifySchnorrSig:    verifySchnorrSig,
+		routeSender:         newLndRouteSender(cfg.Lnd),
+		htlcFeeBumper:       newLndHtlcFeeBumper(cfg.Lnd),
+		htlcBatcher: newHtlcBatcher(
+			cfg.Lnd.WalletKit, cfg.Lnd.Client,
+			defaultHtlcBatchWindow,
+		),
+		presignedTimeoutFeeRates: cfg.PresignedTimeoutFeeRates,
 	})
 
 	client := &Client{
@@ -212,6 +234,9 @@ func NewClient(dbDir string, loopDB loopdb.SwapStore,
 		executor:     executor,
 		resumeReady:  make(chan struct{}),
 		abandonChans: make(map[lntypes.Hash]chan struct{}),
+		externalHtlcChans: make(
+			map[lntypes.Hash]chan *externalHtlcTx,
+		),
 	}
 
 	cleanup := func() {
@@ -242,73 +267,114 @@ func (s *Client) FetchSwaps(ctx context.Context) ([]*SwapInfo, error) {
 	swaps := make([]*SwapInfo, 0, len(loopInSwaps)+len(loopOutSwaps))
 
 	for _, swp := range loopOutSwaps {
-		swp := swp
-
-		swapInfo := &SwapInfo{
-			SwapType:      swap.TypeOut,
-			SwapContract:  swp.Contract.SwapContract,
-			SwapStateData: swp.State(),
-			SwapHash:      swp.Hash,
-			LastUpdate:    swp.LastUpdateTime(),
-		}
-
-		htlc, err := utils.GetHtlc(
-			swp.Hash, &swp.Contract.SwapContract,
-			s.lndServices.ChainParams,
-		)
+		swapInfo, err := s.loopOutSwapInfo(swp)
 		if err != nil {
 			return nil, err
 		}
 
-		switch htlc.OutputType {
-		case swap.HtlcP2WSH:
-			swapInfo.HtlcAddressP2WSH = htlc.Address
-
-		case swap.HtlcP2TR:
-			swapInfo.HtlcAddressP2TR = htlc.Address
+		swaps = append(swaps, swapInfo)
+	}
 
-		default:
-			return nil, swap.ErrInvalidOutputType
+	for _, swp := range loopInSwaps {
+		swapInfo, err := s.loopInSwapInfo(swp)
+		if err != nil {
+			return nil, err
 		}
 
 		swaps = append(swaps, swapInfo)
 	}
 
-	for _, swp := range loopInSwaps {
-		swp := swp
-
-		swapInfo := &SwapInfo{
-			SwapType:      swap.TypeIn,
-			SwapContract:  swp.Contract.SwapContract,
-			SwapStateData: swp.State(),
-			SwapHash:      swp.Hash,
-			LastUpdate:    swp.LastUpdateTime(),
-			LastHop:       swp.Contract.LastHop,
+	return swaps, nil
+}
+
+// FetchSwap returns the loop in or out swap with the given hash from the
+// database.
+func (s *Client) FetchSwap(ctx context.Context, hash lntypes.Hash,
+	swapType swap.Type) (*SwapInfo, error) {
+
+	switch swapType {
+	case swap.TypeOut:
+		swp, err := s.Store.FetchLoopOutSwap(ctx, hash)
+		if err != nil {
+			return nil, err
 		}
 
-		htlc, err := utils.GetHtlc(
-			swp.Hash, &swp.Contract.SwapContract,
-			s.lndServices.ChainParams,
-		)
+		return s.loopOutSwapInfo(swp)
+
+	case swap.TypeIn:
+		swp, err := s.Store.FetchLoopInSwap(ctx, hash)
 		if err != nil {
 			return nil, err
 		}
 
-		switch htlc.OutputType {
-		case swap.HtlcP2WSH:
-			swapInfo.HtlcAddressP2WSH = htlc.Address
+		return s.loopInSwapInfo(swp)
 
-		case swap.HtlcP2TR:
-			swapInfo.HtlcAddressP2TR = htlc.Address
+	default:
+		return nil, fmt.Errorf("unknown swap type: %v", swapType)
+	}
+}
 
-		default:
-			return nil, swap.ErrInvalidOutputType
-		}
+// loopOutSwapInfo returns the swap info of the passed stored loop out.
+func (s *Client) loopOutSwapInfo(swp *loopdb.LoopOut) (*SwapInfo, error) {
+	swapInfo := &SwapInfo{
+		SwapType:        swap.TypeOut,
+		SwapContract:    swp.Contract.SwapContract,
+		SwapStateData:   swp.State(),
+		SwapHash:        swp.Hash,
+		LastUpdate:      swp.LastUpdateTime(),
+		OutgoingChanSet: swp.Contract.OutgoingChanSet,
+	}
 
-		swaps = append(swaps, swapInfo)
+	err := s.setHtlcAddress(swapInfo)
+	if err != nil {
+		return nil, err
 	}
 
-	return swaps, nil
+	return swapInfo, nil
+}
+
+// loopInSwapInfo returns the swap info of the passed stored loop in.
+func (s *Client) loopInSwapInfo(swp *loopdb.LoopIn) (*SwapInfo, error) {
+	swapInfo := &SwapInfo{
+		SwapType:      swap.TypeIn,
+		SwapContract:  swp.Contract.SwapContract,
+		SwapStateData: swp.State(),
+		SwapHash:      swp.Hash,
+		LastUpdate:    swp.LastUpdateTime(),
+		LastHop:       swp.Contract.LastHop,
+	}
+
+	err := s.setHtlcAddress(swapInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return swapInfo, nil
+}
+
+// setHtlcAddress sets the htlc address of the passed swap info according to
+// the output type of its htlc.
+func (s *Client) setHtlcAddress(swapInfo *SwapInfo) error {
+	htlc, err := utils.GetHtlc(
+		swapInfo.SwapHash, &swapInfo.SwapContract,
+		s.lndServices.ChainParams,
+	)
+	if err != nil {
+		return err
+	}
+
+	switch htlc.OutputType {
+	case swap.HtlcP2WSH:
+		swapInfo.HtlcAddressP2WSH = htlc.Address
+
+	case swap.HtlcP2TR:
+		swapInfo.HtlcAddressP2TR = htlc.Address
+
+	default:
+		return swap.ErrInvalidOutputType
+	}
+
+	return nil
 }
 
 // Run is a blocking call that executes all swaps. Any pending swaps are
@@ -356,7 +422,9 @@ func (s *Client) Run(ctx context.Context, statusChan chan<- SwapInfo) error {
 	}()
 
 	// Main event loop.
-	err = s.executor.run(mainCtx, statusChan, s.abandonChans)
+	err = s.executor.run(
+		mainCtx, statusChan, s.abandonChans, s.externalHtlcChans,
+	)
 
 	// Consider canceled as happy flow.
 	if errors.Is(err, context.Canceled) {
@@ -417,12 +485,48 @@ func (s *Client) resumeSwaps(ctx context.Context,
 		// abandon the swap by providing the swap hash.
 		s.executor.Lock()
 		s.abandonChans[swap.hash] = swap.abandonChan
+		s.externalHtlcChans[swap.hash] = swap.externalHtlcChan
 		s.executor.Unlock()
 
 		s.executor.initiateSwap(ctx, swap)
 	}
 }
 
+// ResumeSwaps resumes the pending swaps with the given hashes. It is used to
+// execute swaps that were added to the store while the client is running, for
+// example when they are restored from a backup.
+func (s *Client) ResumeSwaps(ctx context.Context, loopOutHashes,
+	loopInHashes []lntypes.Hash) error {
+
+	if err := s.waitForInitialized(ctx); err != nil {
+		return err
+	}
+
+	loopOutSwaps := make([]*loopdb.LoopOut, 0, len(loopOutHashes))
+	for _, hash := range loopOutHashes {
+		swap, err := s.Store.FetchLoopOutSwap(ctx, hash)
+		if err != nil {
+			return err
+		}
+
+		loopOutSwaps = append(loopOutSwaps, swap)
+	}
+
+	loopInSwaps := make([]*loopdb.LoopIn, 0, len(loopInHashes))
+	for _, hash := range loopInHashes {
+		swap, err := s.Store.FetchLoopInSwap(ctx, hash)
+		if err != nil {
+			return err
+		}
+
+		loopInSwaps = append(loopInSwaps, swap)
+	}
+
+	s.resumeSwaps(ctx, loopOutSwaps, loopInSwaps)
+
+	return nil
+}
+
 // LoopOut initiates a loop out swap. It blocks until the swap is initiation
 // with the swap server is completed (typically this takes only a short amount
 // of time). From there on further status information can be acquired through
@@ -625,6 +729,7 @@ func (s *Client) LoopIn(globalCtx context.Context,
 
 	s.executor.Lock()
 	s.abandonChans[swap.hash] = swap.abandonChan
+	s.externalHtlcChans[swap.hash] = swap.externalHtlcChan
 	s.executor.Unlock()
 
 	// Post swap to the main loop.
@@ -804,6 +909,19 @@ func (s *Client) Probe(ctx context.Context, req *ProbeRequest) error {
 	)
 }
 
+// GetSwapStateMachine returns the type and the state machine of an active
+// swap.
+func (s *Client) GetSwapStateMachine(hash lntypes.Hash) (swap.Type,
+	fsm.Introspector, error) {
+
+	stateMachine, ok := s.executor.stateMachine(hash)
+	if !ok {
+		return 0, nil, fmt.Errorf("no active swap %v", hash)
+	}
+
+	return stateMachine.swapType, stateMachine.machine, nil
+}
+
 // AbandonSwap sends a signal on the abandon channel of the swap identified by
 // the passed swap hash. This will cause the swap to abandon itself.
 func (s *Client) AbandonSwap(ctx context.Context,
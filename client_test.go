@@ -5,11 +5,13 @@ import (
 	"crypto/sha256"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/test"
@@ -83,6 +85,14 @@ func TestLoopOutSuccess(t *testing.T) {
 	signalSwapPaymentResult := ctx.AssertPaid(swapInvoiceDesc)
 	signalPrepaymentResult := ctx.AssertPaid(prepayInvoiceDesc)
 
+	// The state machine of the executing swap can be inspected.
+	swapType, machine, err := ctx.swapClient.GetSwapStateMachine(
+		info.SwapHash,
+	)
+	require.NoError(t, err)
+	require.Equal(t, swap.TypeOut, swapType)
+	require.NotEmpty(t, machine.Snapshot().CurrentState)
+
 	// Expect client to register for conf.
 	confIntent := ctx.Context.AssertRegisterConf(false, req.HtlcConfirmations)
 
@@ -90,6 +100,12 @@ func TestLoopOutSuccess(t *testing.T) {
 		signalPrepaymentResult, signalSwapPaymentResult, false,
 		confIntent, swap.HtlcV3,
 	)
+
+	// Once the swap finished, its state machine is no longer available.
+	require.Eventually(t, func() bool {
+		_, _, err := ctx.swapClient.GetSwapStateMachine(info.SwapHash)
+		return err != nil
+	}, test.Timeout, 10*time.Millisecond)
 }
 
 // TestLoopOutFailOffchain tests the handling of swap for which the server
@@ -305,6 +321,10 @@ func testLoopOutResume(t *testing.T, confs uint32, expired, preimageRevealed,
 	// Assert that the loopout htlc equals to the expected one.
 	require.Equal(t, htlc.PkScript, confIntent.PkScript)
 
+	// The resumed swap starts over from the beginning of its state
+	// machine, the actions pick up from the stored swap state.
+	machine := getStateMachine(t, ctx.swapClient, hash)
+
 	signalSwapPaymentResult(nil)
 	signalPrepaymentResult(nil)
 
@@ -312,6 +332,16 @@ func testLoopOutResume(t *testing.T, confs uint32, expired, preimageRevealed,
 		ctx.assertStatus(loopdb.StateFailTimeout)
 		ctx.assertStoreFinished(loopdb.StateFailTimeout)
 		ctx.finish()
+
+		assertStateHistory(
+			t, machine, fsm.EmptyState, loopOutPayInvoices,
+			loopOutWaitForHtlcConf, loopOutFailTimeout,
+		)
+		assertLoopOutUpdates(
+			t, ctx.store, hash, loopdb.StateInitiated,
+			loopdb.StateFailTimeout,
+		)
+
 		return
 	}
 
@@ -323,6 +353,25 @@ func testLoopOutResume(t *testing.T, confs uint32, expired, preimageRevealed,
 		preimageRevealed,
 		confIntent, utils.GetHtlcScriptVersion(protocolVersion),
 	)
+
+	assertStateHistory(
+		t, machine, fsm.EmptyState, loopOutPayInvoices,
+		loopOutWaitForHtlcConf, loopOutHtlcConfirmed,
+		loopOutPreimageRevealed, loopOutSuccess,
+	)
+
+	// A preimage that was revealed in a previous run isn't stored again.
+	if preimageRevealed {
+		assertLoopOutUpdates(
+			t, ctx.store, hash, loopdb.StatePreimageRevealed,
+			loopdb.StateSuccess,
+		)
+	} else {
+		assertLoopOutUpdates(
+			t, ctx.store, hash, loopdb.StateInitiated,
+			loopdb.StatePreimageRevealed, loopdb.StateSuccess,
+		)
+	}
 }
 
 func testLoopOutSuccess(ctx *testContext, amt btcutil.Amount, hash lntypes.Hash,
@@ -456,7 +505,8 @@ func TestWrapGrpcError(t *testing.T) {
 	}
 }
 
-// TestFetchSwapsLastHop asserts that FetchSwaps loads LastHop for LoopIn's.
+// TestFetchSwapsLastHop asserts that FetchSwaps and FetchSwap load LastHop for
+// LoopIn's.
 func TestFetchSwapsLastHop(t *testing.T) {
 	defer test.Guard(t)()
 
@@ -466,7 +516,7 @@ func TestFetchSwapsLastHop(t *testing.T) {
 
 	// Create a loop in swap.
 	swapHash := lntypes.Hash{1, 1, 1}
-	swap := &loopdb.LoopInContract{
+	contract := &loopdb.LoopInContract{
 		SwapContract: loopdb.SwapContract{
 			CltvExpiry:      111,
 			AmountRequested: 111,
@@ -475,7 +525,7 @@ func TestFetchSwapsLastHop(t *testing.T) {
 		},
 		LastHop: &lastHop,
 	}
-	err := ctx.store.CreateLoopIn(context.Background(), swapHash, swap)
+	err := ctx.store.CreateLoopIn(context.Background(), swapHash, contract)
 	require.NoError(t, err, "CreateLoopOut failed")
 
 	// Now read all the swaps from the store
@@ -508,6 +558,13 @@ func TestFetchSwapsLastHop(t *testing.T) {
 
 	require.Equal(t, wantLoopInInfo, loopInInfo)
 
+	// The single swap is fetched the same way.
+	loopInInfo, err = ctx.swapClient.FetchSwap(
+		context.Background(), swapHash, swap.TypeIn,
+	)
+	require.NoError(t, err)
+	require.Equal(t, wantLoopInInfo, loopInInfo)
+
 	// Shutdown the client not to leak goroutines.
 	ctx.finish()
 }
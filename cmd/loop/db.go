@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var dbCommands = cli.Command{
+	Name:  "db",
+	Usage: "export, import or compact the swap database",
+	Description: `
+		With loopd running, you can use these commands to move the swap
+		database between hosts or database backends, or to inspect it
+		offline.
+	`,
+	Subcommands: []cli.Command{
+		dbExportCommand,
+		dbImportCommand,
+		dbCompactCommand,
+	},
+}
+
+var dbExportCommand = cli.Command{
+	Name:      "export",
+	Usage:     "export the swap database into a JSON archive",
+	ArgsUsage: "file",
+	Description: `
+		Export the loop outs, loop ins, their updates, the liquidity
+		parameters, the reservations, the instant outs and the sweep
+		batches into a versioned JSON archive file. The archive
+		contains the swap preimages, so it must be kept secret.
+	`,
+	Action: dbExport,
+}
+
+func dbExport(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "export")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ExportDatabase(
+		context.Background(), &looprpc.ExportDatabaseRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	file := ctx.Args().First()
+	err = os.WriteFile(file, resp.Archive, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to write archive: %v", err)
+	}
+
+	fmt.Printf("Exported swap database (archive version %d) to %v\n",
+		resp.Version, file)
+
+	return nil
+}
+
+var dbImportCommand = cli.Command{
+	Name:      "import",
+	Usage:     "import a JSON archive into the swap database",
+	ArgsUsage: "file",
+	Description: `
+		Import an archive that was created with 'loop db export'. The
+		database of loopd must not contain any swaps, reservations or
+		sweep batches yet. Restart loopd after the import to resume
+		the pending swaps of the archive.
+	`,
+	Action: dbImport,
+}
+
+func dbImport(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "import")
+	}
+
+	archive, err := os.ReadFile(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to read archive: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ImportDatabase(
+		context.Background(), &looprpc.ImportDatabaseRequest{
+			Archive: archive,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var dbCompactCommand = cli.Command{
+	Name:  "compact",
+	Usage: "compact the finished swaps in the swap database",
+	Description: `
+		Compact the loop outs and loop ins that reached a final state
+		more than the given number of days ago. Only the final update
+		of such a swap is kept, which holds the final state and the
+		costs of the swap. The intermediate updates are deleted.
+
+		If an archive file is given, the compacted swaps are written to
+		it with all their updates before they are compacted. The
+		archive contains the swap preimages, so it must be kept
+		secret.
+	`,
+	Flags: []cli.Flag{
+		cli.UintFlag{
+			Name: "days",
+			Usage: "the minimum number of days since a swap " +
+				"reached its final state",
+		},
+		cli.StringFlag{
+			Name: "archive_file",
+			Usage: "the file to write an archive of the " +
+				"compacted swaps to",
+		},
+	},
+	Action: dbCompact,
+}
+
+func dbCompact(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || !ctx.IsSet("days") {
+		return cli.ShowCommandHelp(ctx, "compact")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	archiveFile := ctx.String("archive_file")
+	resp, err := client.CompactSwaps(
+		context.Background(), &looprpc.CompactSwapsRequest{
+			OlderThanDays: uint32(ctx.Uint("days")),
+			Archive:       archiveFile != "",
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if archiveFile != "" && len(resp.Archive) > 0 {
+		err = os.WriteFile(archiveFile, resp.Archive, 0600)
+		if err != nil {
+			return fmt.Errorf("unable to write archive: %v", err)
+		}
+	}
+
+	fmt.Printf("Compacted %d swaps, deleted %d swap updates\n",
+		len(resp.Ids), resp.DeletedUpdates)
+
+	return nil
+}
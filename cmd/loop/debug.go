@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/urfave/cli"
+)
+
+var debugCommands = cli.Command{
+	Name:  "debug",
+	Usage: "inspect the internals of loopd",
+	Description: `
+		With loopd running, you can use this command to inspect the
+		internal state of loopd for debugging purposes.
+	`,
+	Subcommands: []cli.Command{
+		debugFsmCommand,
+	},
+}
+
+var debugFsmCommand = cli.Command{
+	Name:      "fsm",
+	Usage:     "show the state machine of a swap, reservation or instant out",
+	ArgsUsage: "id",
+	Description: `
+		Show the live state of the state machine of an active swap,
+		reservation or instant out, identified by the swap hash or the
+		reservation id. The output contains the current state, the
+		recent transitions and the last action error. If a graph
+		format is set, only the state graph is printed in that format,
+		with the current state highlighted.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "format",
+			Usage: "print the state graph as 'mermaid' or 'dot' " +
+				"instead of the state machine details",
+		},
+	},
+	Action: debugFsm,
+}
+
+func debugFsm(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "fsm")
+	}
+
+	id, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	if len(id) != lntypes.HashSize {
+		return fmt.Errorf("invalid id")
+	}
+
+	var format looprpc.StateMachineGraphFormat
+	switch ctx.String("format") {
+	case "":
+		format = looprpc.StateMachineGraphFormat_GRAPH_FORMAT_NONE
+
+	case "mermaid":
+		format = looprpc.StateMachineGraphFormat_GRAPH_FORMAT_MERMAID
+
+	case "dot":
+		format = looprpc.StateMachineGraphFormat_GRAPH_FORMAT_DOT
+
+	default:
+		return fmt.Errorf("unknown graph format %v, expected mermaid "+
+			"or dot", ctx.String("format"))
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetStateMachine(
+		context.Background(), &looprpc.GetStateMachineRequest{
+			Id:          id,
+			GraphFormat: format,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if format != looprpc.StateMachineGraphFormat_GRAPH_FORMAT_NONE {
+		fmt.Print(resp.Graph)
+		return nil
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
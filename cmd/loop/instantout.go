@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/lightninglabs/loop/instantout/reservation"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/urfave/cli"
 )
 
@@ -16,10 +18,23 @@ var instantOutCommand = cli.Command{
 	Name:  "instantout",
 	Usage: "perform an instant off-chain to on-chain swap (looping out)",
 	Description: `
-	Attempts to instantly loop out into the backing lnd's wallet. The amount
-	will be chosen via the cli.
+	Attempts to instantly loop out into the backing lnd's wallet. The
+	reservations to use will be chosen via the cli. By default their full
+	value is looped out. If a lower amount is set, the remainder is returned
+	into a new reservation.
+
+	The looped out funds can be split between several destinations with
+	the repeatable --dest and --account_dest flags. A destination with an
+	amount receives exactly that amount, while the single destination
+	without an amount receives the remainder of the swap after fees.
 	`,
 	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "amt",
+			Usage: "the amount in satoshis to loop out, if left " +
+				"blank the full value of the selected " +
+				"reservations is used",
+		},
 		cli.StringFlag{
 			Name: "channel",
 			Usage: "the comma-separated list of short " +
@@ -31,6 +46,19 @@ var instantOutCommand = cli.Command{
 				"should be sent to, if let blank the funds " +
 				"will go to lnd's wallet",
 		},
+		cli.StringSliceFlag{
+			Name: "dest",
+			Usage: "an additional destination of the swap in the " +
+				"form address[:amount]. Can be set multiple " +
+				"times",
+		},
+		cli.StringSliceFlag{
+			Name: "account_dest",
+			Usage: "an additional destination of the swap in the " +
+				"form account[:amount] that receives a new " +
+				"p2tr address of the named lnd account. Can " +
+				"be set multiple times",
+		},
 	},
 	Action: instantOut,
 }
@@ -52,6 +80,37 @@ func instantOut(ctx *cli.Context) error {
 		}
 	}
 
+	var destinations []*looprpc.InstantOutDestination
+	for _, dest := range ctx.StringSlice("dest") {
+		addr, amt, err := parseInstantOutDest(dest)
+		if err != nil {
+			return err
+		}
+
+		destinations = append(
+			destinations, &looprpc.InstantOutDestination{
+				Addr: addr,
+				Amt:  amt,
+			},
+		)
+	}
+
+	for _, dest := range ctx.StringSlice("account_dest") {
+		account, amt, err := parseInstantOutDest(dest)
+		if err != nil {
+			return err
+		}
+
+		destinations = append(
+			destinations, &looprpc.InstantOutDestination{
+				Account: account,
+				AccountAddrType: looprpc.
+					AddressType_TAPROOT_PUBKEY,
+				Amt: amt,
+			},
+		)
+	}
+
 	// First set up the swap client itself.
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -153,12 +212,24 @@ func instantOut(ctx *cli.Context) error {
 		}
 	}
 
+	// If an amount is set, only that part of the reservations is swapped.
+	swapAmt := selectedAmt
+	if ctx.IsSet("amt") {
+		swapAmt = ctx.Uint64("amt")
+		if swapAmt == 0 || swapAmt > selectedAmt {
+			return fmt.Errorf("amount must be between 1 and %v",
+				selectedAmt)
+		}
+	}
+
 	// Now that we have the selected reservations we can estimate the
 	// fee-rates.
 	quote, err := client.InstantOutQuote(
 		context.Background(), &looprpc.InstantOutQuoteRequest{
-			Amt:             selectedAmt,
+			Amt:             swapAmt,
 			NumReservations: int32(len(selectedReservations)),
+			ReservationIds:  selectedReservations,
+			Destinations:    destinations,
 		},
 	)
 	if err != nil {
@@ -167,6 +238,9 @@ func instantOut(ctx *cli.Context) error {
 	fmt.Println()
 	fmt.Printf(satAmtFmt, "Estimated on-chain fee:", quote.SweepFeeSat)
 	fmt.Printf(satAmtFmt, "Service fee:", quote.ServiceFeeSat)
+	if quote.ChangeAmtSat > 0 {
+		fmt.Printf(satAmtFmt, "Change reservation:", quote.ChangeAmtSat)
+	}
 	fmt.Println()
 
 	fmt.Printf("CONTINUE SWAP? (y/n): ")
@@ -185,6 +259,8 @@ func instantOut(ctx *cli.Context) error {
 			ReservationIds:  selectedReservations,
 			OutgoingChanSet: outgoingChanSet,
 			DestAddr:        ctx.String("addr"),
+			Amt:             swapAmt,
+			Destinations:    destinations,
 		},
 	)
 
@@ -203,6 +279,28 @@ func instantOut(ctx *cli.Context) error {
 	return nil
 }
 
+// parseInstantOutDest parses an instant out destination in the form
+// target[:amount]. An amount of zero means that the destination receives the
+// remainder of the swap.
+func parseInstantOutDest(dest string) (string, uint64, error) {
+	target, amtStr, hasAmt := strings.Cut(dest, ":")
+	if target == "" {
+		return "", 0, fmt.Errorf("invalid destination \"%v\"", dest)
+	}
+
+	if !hasAmt {
+		return target, 0, nil
+	}
+
+	amt, err := strconv.ParseUint(amtStr, 10, 64)
+	if err != nil || amt == 0 {
+		return "", 0, fmt.Errorf("invalid amount in destination "+
+			"\"%v\"", dest)
+	}
+
+	return target, amt, nil
+}
+
 var listInstantOutsCommand = cli.Command{
 	Name:  "listinstantouts",
 	Usage: "list all instant out swaps",
@@ -230,3 +328,62 @@ func listInstantOuts(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var cancelInstantOutCommand = cli.Command{
+	Name:  "cancelinstantout",
+	Usage: "cancel an instant out swap",
+	Description: `
+	Cancels an instant out swap with a given swap hash. A swap can only be
+	cancelled as long as its htlc signatures haven't been sent to the server.
+	The reservations of a cancelled swap are unlocked and can be used again.
+	`,
+	ArgsUsage: "ID",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the swap hash of the instant out to cancel",
+		},
+	},
+	Action: cancelInstantOut,
+}
+
+func cancelInstantOut(ctx *cli.Context) error {
+	var id string
+	switch {
+	case ctx.IsSet("id"):
+		id = ctx.String("id")
+
+	case ctx.NArg() > 0:
+		id = ctx.Args().First()
+
+	default:
+		// Show command help if no arguments and flags were provided.
+		return cli.ShowCommandHelp(ctx, "cancelinstantout")
+	}
+
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CancelInstantOut(
+		context.Background(), &looprpc.CancelInstantOutRequest{
+			SwapHash: idBytes,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
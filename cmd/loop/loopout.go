@@ -13,6 +13,7 @@ import (
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/urfave/cli"
 )
 
@@ -101,6 +102,30 @@ var loopOutCommand = cli.Command{
 				"payment might be retried, the actual total " +
 				"time may be longer",
 		},
+		cli.StringFlag{
+			Name: "route_hops",
+			Usage: "the comma-separated list of node pubkeys " +
+				"that the swap payment must take, ending " +
+				"with the destination of the swap invoice",
+		},
+		cli.StringFlag{
+			Name: "prepay_route_hops",
+			Usage: "the comma-separated list of node pubkeys " +
+				"that the prepayment must take, ending with " +
+				"the destination of the prepay invoice",
+		},
+		cli.StringFlag{
+			Name: "include_nodes",
+			Usage: "the comma-separated list of node pubkeys " +
+				"that the swap and prepay payments may use " +
+				"as intermediate hops",
+		},
+		cli.StringFlag{
+			Name: "exclude_nodes",
+			Usage: "the comma-separated list of node pubkeys " +
+				"that the swap and prepay payments must not " +
+				"go through",
+		},
 		forceFlag,
 		labelFlag,
 		verboseFlag,
@@ -145,6 +170,11 @@ func loopOut(ctx *cli.Context) error {
 		}
 	}
 
+	swapRoutePrefs, prepayRoutePrefs, err := parseRoutePreferences(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Validate our label early so that we can fail before getting a quote.
 	label := ctx.String(labelFlag.Name)
 	if err := labels.Validate(label); err != nil {
@@ -281,6 +311,8 @@ func loopOut(ctx *cli.Context) error {
 		Label:                   label,
 		Initiator:               defaultInitiator,
 		PaymentTimeout:          uint32(paymentTimeout),
+		SwapRoutePreferences:    swapRoutePrefs,
+		PrepayRoutePreferences:  prepayRoutePrefs,
 	})
 	if err != nil {
 		return err
@@ -297,3 +329,75 @@ func loopOut(ctx *cli.Context) error {
 
 	return nil
 }
+
+// parseRoutePreferences parses the route restriction flags into the route
+// preferences of the swap and prepay payments. The include and exclude sets
+// apply to both payments.
+func parseRoutePreferences(ctx *cli.Context) (*looprpc.RoutePreferences,
+	*looprpc.RoutePreferences, error) {
+
+	includeNodes, err := parseNodeList(ctx.String("include_nodes"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid include_nodes: %w", err)
+	}
+
+	excludeNodes, err := parseNodeList(ctx.String("exclude_nodes"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid exclude_nodes: %w", err)
+	}
+
+	swapHops, err := parseNodeList(ctx.String("route_hops"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid route_hops: %w", err)
+	}
+
+	prepayHops, err := parseNodeList(ctx.String("prepay_route_hops"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid prepay_route_hops: %w",
+			err)
+	}
+
+	var swapPrefs, prepayPrefs *looprpc.RoutePreferences
+	if len(swapHops) > 0 || len(includeNodes) > 0 ||
+		len(excludeNodes) > 0 {
+
+		swapPrefs = &looprpc.RoutePreferences{
+			Hops:         swapHops,
+			IncludeNodes: includeNodes,
+			ExcludeNodes: excludeNodes,
+		}
+	}
+
+	if len(prepayHops) > 0 || len(includeNodes) > 0 ||
+		len(excludeNodes) > 0 {
+
+		prepayPrefs = &looprpc.RoutePreferences{
+			Hops:         prepayHops,
+			IncludeNodes: includeNodes,
+			ExcludeNodes: excludeNodes,
+		}
+	}
+
+	return swapPrefs, prepayPrefs, nil
+}
+
+// parseNodeList parses a comma-separated list of hex encoded node pubkeys.
+// Don't string split if the list is empty. Otherwise, strings.Split returns a
+// slice of length one with an empty element.
+func parseNodeList(nodeList string) ([][]byte, error) {
+	if nodeList == "" {
+		return nil, nil
+	}
+
+	var nodes [][]byte
+	for _, nodeStr := range strings.Split(nodeList, ",") {
+		node, err := route.NewVertexFromStr(strings.TrimSpace(nodeStr))
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node[:])
+	}
+
+	return nodes, nil
+}
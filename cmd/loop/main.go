@@ -149,6 +149,8 @@ func main() {
 		setLiquidityRuleCommand, suggestSwapCommand, setParamsCommand,
 		getInfoCommand, abandonSwapCommand, reservationsCommands,
 		instantOutCommand, listInstantOutsCommand,
+		cancelInstantOutCommand, psbtCommands, debugCommands,
+		dbCommands, recoverCommand, presignedTimeoutsCommand,
 	}
 
 	err := app.Run(os.Args)
@@ -2,17 +2,31 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/urfave/cli"
 )
 
 var monitorCommand = cli.Command{
-	Name:        "monitor",
-	Usage:       "monitor progress of any active swaps",
-	Description: "Allows the user to monitor progress of any active swaps",
-	Action:      monitor,
+	Name:  "monitor",
+	Usage: "monitor progress of any active swaps",
+	Description: "Allows the user to monitor progress of any active " +
+		"swaps. Optionally, the state changes of reservations and " +
+		"instant outs can be monitored as well.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "reservations",
+			Usage: "also monitor the state of reservations",
+		},
+		cli.BoolFlag{
+			Name:  "instantouts",
+			Usage: "also monitor the state of instant out swaps",
+		},
+	},
+	Action: monitor,
 }
 
 func monitor(ctx *cli.Context) error {
@@ -22,20 +36,105 @@ func monitor(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	stream, err := client.Monitor(
-		context.Background(), &looprpc.MonitorRequest{})
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The updates of all streams are printed from this goroutine, so that
+	// they don't interleave.
+	updates := make(chan func())
+	errChan := make(chan error, 3)
+
+	stream, err := client.Monitor(streamCtx, &looprpc.MonitorRequest{})
 	if err != nil {
 		return err
 	}
+	go forwardUpdates(streamCtx, stream.Recv, logSwap, updates, errChan)
+
+	if ctx.Bool("reservations") {
+		stream, err := client.MonitorReservations(
+			streamCtx, &looprpc.MonitorReservationsRequest{},
+		)
+		if err != nil {
+			return err
+		}
+		go forwardUpdates(
+			streamCtx, stream.Recv, logReservation, updates,
+			errChan,
+		)
+	}
+
+	if ctx.Bool("instantouts") {
+		stream, err := client.MonitorInstantOuts(
+			streamCtx, &looprpc.MonitorInstantOutsRequest{},
+		)
+		if err != nil {
+			return err
+		}
+		go forwardUpdates(
+			streamCtx, stream.Recv, logInstantOut, updates,
+			errChan,
+		)
+	}
 
 	fmt.Printf("Note: offchain cost may report as 0 after loopd restart " +
 		"during swap\n")
 
 	for {
-		swap, err := stream.Recv()
+		select {
+		case printUpdate := <-updates:
+			printUpdate()
+
+		case err := <-errChan:
+			return err
+		}
+	}
+}
+
+// forwardUpdates receives updates from a stream until it fails and forwards
+// a function printing each update to the updates channel.
+func forwardUpdates[T any](ctx context.Context, recv func() (T, error),
+	logUpdate func(T), updates chan<- func(), errChan chan<- error) {
+
+	for {
+		update, err := recv()
 		if err != nil {
-			return fmt.Errorf("recv: %v", err)
+			errChan <- fmt.Errorf("recv: %v", err)
+			return
+		}
+
+		select {
+		case updates <- func() { logUpdate(update) }:
+
+		case <-ctx.Done():
+			return
 		}
-		logSwap(swap)
 	}
 }
+
+func logReservation(res *looprpc.ClientReservation) {
+	fmt.Printf("RESERVATION %v %v - %v", res.State,
+		btcutil.Amount(res.Amount),
+		hex.EncodeToString(res.ReservationId))
+
+	if res.TxId != "" {
+		fmt.Printf(" outpoint: %v:%v", res.TxId, res.Vout)
+	}
+
+	if res.Alert != "" {
+		fmt.Printf(" ALERT: %v", res.Alert)
+	}
+
+	fmt.Println()
+}
+
+func logInstantOut(instantOut *looprpc.InstantOut) {
+	fmt.Printf("INSTANT_OUT %v %v - %v", instantOut.State,
+		btcutil.Amount(instantOut.Amount),
+		hex.EncodeToString(instantOut.SwapHash))
+
+	if instantOut.SweepTxId != "" {
+		fmt.Printf(" sweep tx: %v", instantOut.SweepTxId)
+	}
+
+	fmt.Println()
+}
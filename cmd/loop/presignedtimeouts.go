@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var presignedTimeoutsCommand = cli.Command{
+	Name:      "presignedtimeouts",
+	Usage:     "show the presigned timeout txs of pending loop in swaps",
+	ArgsUsage: "[ID]",
+	Description: `
+		Shows the timeout transactions that loopd signed in advance for
+		the confirmed htlcs of pending loop in swaps at the fee rates
+		of the presignedtimeoutfeerates option. They can be handed to
+		a third party that publishes one of them once the htlc
+		expired, in case loopd is offline at that time.
+
+		If a swap hash is given, only the transactions of that swap are
+		shown.
+	`,
+	Action: presignedTimeouts,
+}
+
+func presignedTimeouts(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return cli.ShowCommandHelp(ctx, "presignedtimeouts")
+	}
+
+	req := &looprpc.GetPresignedTimeoutsRequest{}
+	if ctx.NArg() == 1 {
+		id, err := parseSwapID(ctx.Args().First())
+		if err != nil {
+			return err
+		}
+		req.Id = id
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetPresignedTimeouts(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
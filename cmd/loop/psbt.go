@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/urfave/cli"
+)
+
+var psbtCommands = cli.Command{
+	Name:  "psbt",
+	Usage: "fund the htlc of an external loop in swap with a PSBT",
+	Description: `
+		With loopd running, you can use these commands to fund the htlc
+		of a loop in swap that was created with the --external flag
+		from a PSBT that is signed outside of lnd, for example by a
+		hardware wallet.
+
+		First create a funded PSBT with 'loop psbt fund', sign it
+		with your external signer and then publish it with
+		'loop psbt publish'.
+	`,
+	Subcommands: []cli.Command{
+		fundPsbtCommand,
+		publishPsbtCommand,
+	},
+}
+
+var (
+	fundPsbtCommand = cli.Command{
+		Name:      "fund",
+		Usage:     "create a funded PSBT that pays the swap htlc",
+		ArgsUsage: "ID",
+		Description: `
+		Creates a PSBT that pays the exact swap amount to the htlc of
+		the external loop in swap with the given swap hash. The inputs
+		of the PSBT are locked in lnd's wallet and the PSBT is printed
+		in base64 encoding.
+	`,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name: "account",
+				Usage: "the name of the lnd wallet account to " +
+					"fund the htlc from",
+			},
+			cli.StringSliceFlag{
+				Name: "utxo",
+				Usage: "an outpoint in the form txid:index to " +
+					"fund the htlc with, can be specified " +
+					"multiple times",
+			},
+			cli.Uint64Flag{
+				Name: "sat_per_vbyte",
+				Usage: "the fee rate in sat/vbyte to use for the " +
+					"htlc transaction",
+			},
+			cli.Uint64Flag{
+				Name: "conf_target",
+				Usage: "the number of blocks the htlc " +
+					"transaction should confirm in, " +
+					"defaults to the htlc confirmation " +
+					"target of the swap",
+			},
+		},
+		Action: fundPsbt,
+	}
+
+	publishPsbtCommand = cli.Command{
+		Name:      "publish",
+		Usage:     "publish a signed PSBT that pays the swap htlc",
+		ArgsUsage: "ID",
+		Description: `
+		Verifies that the signed PSBT pays the exact swap amount to the
+		htlc of the external loop in swap with the given swap hash and
+		publishes the resulting transaction.
+	`,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "psbt",
+				Usage: "the base64 encoded signed PSBT",
+			},
+			cli.StringFlag{
+				Name: "psbt_file",
+				Usage: "the path to a file containing the " +
+					"signed PSBT in binary or base64 " +
+					"encoding",
+			},
+		},
+		Action: publishPsbt,
+	}
+)
+
+func fundPsbt(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "fund")
+	}
+
+	id, err := parseSwapID(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	if ctx.IsSet("sat_per_vbyte") && ctx.IsSet("conf_target") {
+		return errors.New("only one of sat_per_vbyte and conf_target " +
+			"can be set")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.FundLoopInPsbt(
+		context.Background(), &looprpc.FundLoopInPsbtRequest{
+			Id:          id,
+			Account:     ctx.String("account"),
+			Outpoints:   ctx.StringSlice("utxo"),
+			SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+			ConfTarget:  int32(ctx.Uint64("conf_target")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		Psbt              string `json:"psbt"`
+		HtlcOutputIndex   uint32 `json:"htlc_output_index"`
+		ChangeOutputIndex int32  `json:"change_output_index"`
+		FeeSat            int64  `json:"fee_sat"`
+	}{
+		Psbt:              base64.StdEncoding.EncodeToString(resp.Psbt),
+		HtlcOutputIndex:   resp.HtlcOutputIndex,
+		ChangeOutputIndex: resp.ChangeOutputIndex,
+		FeeSat:            resp.FeeSat,
+	})
+
+	return nil
+}
+
+func publishPsbt(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "publish")
+	}
+
+	id, err := parseSwapID(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	var packet []byte
+	switch {
+	case ctx.IsSet("psbt") && ctx.IsSet("psbt_file"):
+		return errors.New("only one of psbt and psbt_file can be set")
+
+	case ctx.IsSet("psbt"):
+		packet, err = base64.StdEncoding.DecodeString(
+			strings.TrimSpace(ctx.String("psbt")),
+		)
+		if err != nil {
+			return fmt.Errorf("cannot base64 decode psbt: %v", err)
+		}
+
+	case ctx.IsSet("psbt_file"):
+		packet, err = readPsbtFile(ctx.String("psbt_file"))
+		if err != nil {
+			return err
+		}
+
+	default:
+		return errors.New("either psbt or psbt_file must be set")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.PublishLoopInPsbt(
+		context.Background(), &looprpc.PublishLoopInPsbtRequest{
+			Id:         id,
+			SignedPsbt: packet,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// readPsbtFile reads a PSBT from the passed file. Both the binary and the
+// base64 encoding are supported.
+func readPsbtFile(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read psbt file: %v", err)
+	}
+
+	// A binary PSBT always starts with the magic bytes "psbt", base64
+	// encoded ones with "cHNidP".
+	if strings.HasPrefix(string(content), "psbt") {
+		return content, nil
+	}
+
+	packet, err := base64.StdEncoding.DecodeString(
+		strings.TrimSpace(string(content)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot base64 decode psbt: %v", err)
+	}
+
+	return packet, nil
+}
+
+// parseSwapID decodes the passed hex encoded swap hash.
+func parseSwapID(id string) ([]byte, error) {
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return nil, fmt.Errorf("invalid swap ID")
+	}
+
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return nil, fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	return idBytes, nil
+}
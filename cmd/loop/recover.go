@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swapbackup"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+var recoverCommand = cli.Command{
+	Name:      "recover",
+	Usage:     "restore pending swaps from an encrypted swap backup",
+	ArgsUsage: "[backup_file]",
+	Description: `
+		Restore the pending swaps of an encrypted swap backup file that
+		aren't stored in the database of loopd yet and resume them.
+		loopd keeps this file up to date while it is running. The
+		backup must have been created by a loopd that was connected to
+		an lnd with the same seed.
+
+		If no file is given, the backup file in the loop directory of
+		the selected network is used.
+	`,
+	Action: recoverSwaps,
+	Subcommands: []cli.Command{
+		recoverHtlcCommand,
+	},
+}
+
+var recoverHtlcCommand = cli.Command{
+	Name:      "htlc",
+	Usage:     "spend the htlc of a swap to the wallet",
+	ArgsUsage: "ID",
+	Description: `
+		Creates and signs a transaction that spends the confirmed htlc
+		of the swap with the given swap hash. The htlc of a loop in
+		swap is spent with the timeout path, the htlc of a loop out
+		swap with the success path which reveals the preimage of the
+		swap.
+
+		This is meant as a last resort if a swap can't be completed by
+		loopd anymore. The transaction is only printed unless the
+		--publish flag is set. The timeout transaction of a loop in
+		swap can only be published once the htlc expired.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "the address to send the htlc funds to, " +
+				"defaults to a new address of the lnd wallet",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate in sat/vbyte to use for the " +
+				"transaction",
+		},
+		cli.BoolFlag{
+			Name:  "publish",
+			Usage: "publish the transaction",
+		},
+	},
+	Action: recoverHtlc,
+}
+
+func recoverSwaps(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	file := ctx.Args().First()
+	if file == "" {
+		loopDir := lncfg.CleanAndExpandPath(
+			ctx.GlobalString(loopDirFlag.Name),
+		)
+		network := strings.ToLower(ctx.GlobalString(networkFlag.Name))
+
+		file = filepath.Join(
+			loopDir, network, swapbackup.DefaultBackupFilename,
+		)
+	}
+
+	backup, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("unable to read backup: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RecoverSwaps(
+		context.Background(), &looprpc.RecoverSwapsRequest{
+			Backup: backup,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+func recoverHtlc(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "htlc")
+	}
+
+	id, err := parseSwapID(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("sat_per_vbyte") {
+		return fmt.Errorf("sat_per_vbyte missing")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RecoverHtlc(
+		context.Background(), &looprpc.RecoverHtlcRequest{
+			Id:          id,
+			Addr:        ctx.String("addr"),
+			SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+			Publish:     ctx.Bool("publish"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
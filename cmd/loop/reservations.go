@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/urfave/cli"
@@ -20,6 +23,7 @@ var reservationsCommands = cli.Command{
 	`,
 	Subcommands: []cli.Command{
 		listReservationsCommand,
+		requestReservationCommand,
 	},
 }
 
@@ -34,8 +38,40 @@ var (
 	`,
 		Action: listReservations,
 	}
+
+	requestReservationCommand = cli.Command{
+		Name:      "request",
+		ShortName: "r",
+		Usage:     "request a new reservation from the server",
+		ArgsUsage: "",
+		Description: `
+		Request a new reservation of the given amount and expiry from
+		the server. The fee the server charges for opening the
+		reservation is shown and needs to be confirmed before the
+		reservation is requested.
+	`,
+		Flags: []cli.Flag{
+			cli.Uint64Flag{
+				Name: "amt",
+				Usage: "the amount in satoshis of the " +
+					"reservation",
+			},
+			cli.Uint64Flag{
+				Name: "expiry",
+				Usage: "the number of blocks until the " +
+					"reservation expires",
+				Value: defaultReservationExpiry,
+			},
+			forceFlag,
+		},
+		Action: requestReservation,
+	}
 )
 
+// defaultReservationExpiry is the default number of blocks until a requested
+// reservation expires.
+const defaultReservationExpiry = 2016
+
 func listReservations(ctx *cli.Context) error {
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -53,3 +89,58 @@ func listReservations(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+func requestReservation(ctx *cli.Context) error {
+	if !ctx.IsSet("amt") {
+		return cli.ShowCommandHelp(ctx, "request")
+	}
+
+	amt := ctx.Uint64("amt")
+	expiry := ctx.Uint64("expiry")
+	if expiry == 0 || expiry > math.MaxUint32 {
+		return fmt.Errorf("invalid expiry %v", expiry)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	quote, err := client.ReservationQuote(
+		context.Background(), &looprpc.ReservationQuoteRequest{
+			Amt:    amt,
+			Expiry: uint32(expiry),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !(ctx.Bool("force") || ctx.Bool("f")) {
+		fmt.Printf(satAmtFmt, "Reservation amount:", amt)
+		fmt.Printf("%-36s %12d blocks\n", "Reservation expiry:", expiry)
+		fmt.Printf(satAmtFmt, "Reservation fee:", quote.FeeSat)
+		fmt.Printf("\nCONTINUE? (y/n): ")
+
+		var answer string
+		fmt.Scanln(&answer)
+		if answer != "y" {
+			return errors.New("reservation request canceled")
+		}
+	}
+
+	resp, err := client.RequestReservation(
+		context.Background(), &looprpc.RequestReservationRequest{
+			Amt:       amt,
+			Expiry:    uint32(expiry),
+			MaxFeeSat: quote.FeeSat,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -17,7 +18,8 @@ var listSwapsCommand = cli.Command{
 	Name:  "listswaps",
 	Usage: "list all swaps in the local database",
 	Description: "Allows the user to get a list of all swaps that are " +
-		"currently stored in the database",
+		"currently stored in the database. The swaps can be " +
+		"filtered, sorted and paginated",
 	Action: listSwaps,
 	Flags: []cli.Flag{
 		cli.BoolFlag{
@@ -35,6 +37,50 @@ var listSwapsCommand = cli.Command{
 		labelFlag,
 		channelFlag,
 		lastHopFlag,
+		cli.StringSliceFlag{
+			Name: "state",
+			Usage: "only list swaps in this state, one of " +
+				"initiated, preimage_revealed, " +
+				"htlc_published, invoice_settled, success " +
+				"or failed, can be repeated",
+		},
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "only list swaps initiated at or after this " +
+				"unix timestamp",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "only list swaps initiated before this unix " +
+				"timestamp",
+		},
+		cli.Int64Flag{
+			Name:  "min_amt",
+			Usage: "only list swaps with at least this amount",
+		},
+		cli.Int64Flag{
+			Name:  "max_amt",
+			Usage: "only list swaps with at most this amount",
+		},
+		cli.StringFlag{
+			Name: "sort_by",
+			Usage: "the field to sort the swaps by, either time " +
+				"or amount",
+			Value: "time",
+		},
+		cli.BoolFlag{
+			Name:  "descending",
+			Usage: "sort the swaps in descending order",
+		},
+		cli.Uint64Flag{
+			Name:  "offset",
+			Usage: "the number of swaps to skip",
+		},
+		cli.Uint64Flag{
+			Name: "max_swaps",
+			Usage: "the maximum number of swaps to list, all " +
+				"swaps are listed if not set",
+		},
 	},
 }
 
@@ -99,11 +145,49 @@ func listSwaps(ctx *cli.Context) error {
 		filter.Label = ctx.String(labelFlag.Name)
 	}
 
-	resp, err := client.ListSwaps(
-		context.Background(), &looprpc.ListSwapsRequest{
-			ListSwapFilter: filter,
-		},
-	)
+	// Parse the states.
+	for _, name := range ctx.StringSlice("state") {
+		state, ok := looprpc.SwapState_value[strings.ToUpper(name)]
+		if !ok {
+			return fmt.Errorf("unknown swap state \"%v\"", name)
+		}
+		filter.States = append(filter.States, looprpc.SwapState(state))
+	}
+
+	// Set the time range.
+	if ctx.IsSet("start_time") {
+		filter.StartTimeNs = time.Unix(
+			ctx.Int64("start_time"), 0,
+		).UnixNano()
+	}
+	if ctx.IsSet("end_time") {
+		filter.EndTimeNs = time.Unix(ctx.Int64("end_time"), 0).UnixNano()
+	}
+
+	// Set the amount range.
+	filter.MinAmt = ctx.Int64("min_amt")
+	filter.MaxAmt = ctx.Int64("max_amt")
+
+	req := &looprpc.ListSwapsRequest{
+		ListSwapFilter: filter,
+		Descending:     ctx.Bool("descending"),
+		Offset:         uint32(ctx.Uint64("offset")),
+		MaxSwaps:       uint32(ctx.Uint64("max_swaps")),
+	}
+
+	switch ctx.String("sort_by") {
+	case "time":
+		req.SortBy = looprpc.ListSwapsRequest_INITIATION_TIME
+
+	case "amount":
+		req.SortBy = looprpc.ListSwapsRequest_AMOUNT
+
+	default:
+		return fmt.Errorf("unknown sort field \"%v\"",
+			ctx.String("sort_by"))
+	}
+
+	resp, err := client.ListSwaps(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -11,7 +11,9 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweep"
 	"github.com/lightninglabs/loop/sweepbatcher"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -39,6 +41,20 @@ type executorConfig struct {
 	cancelSwap func(ctx context.Context, details *outCancelDetails) error
 
 	verifySchnorrSig func(pubKey *btcec.PublicKey, hash, sig []byte) error
+
+	routeSender routeSender
+
+	// htlcFeeBumper bumps the fee of unconfirmed loop in htlc txs.
+	htlcFeeBumper htlcFeeBumper
+
+	// htlcBatcher combines the htlcs of concurrent loop in swaps into a
+	// single transaction. If nil, every htlc is published on its own.
+	htlcBatcher *htlcBatcher
+
+	// presignedTimeoutFeeRates are the sorted fee rates in sat/vbyte at
+	// which the timeout txs of confirmed loop in htlcs are signed in
+	// advance. If empty, no timeout txs are presigned.
+	presignedTimeoutFeeRates []uint64
 }
 
 // executor is responsible for executing swaps.
@@ -50,6 +66,9 @@ type executor struct {
 	currentHeight uint32
 	ready         chan struct{}
 
+	// stateMachines contains the state machines of the active swaps.
+	stateMachines map[lntypes.Hash]swapStateMachine
+
 	sync.Mutex
 
 	executorConfig
@@ -61,14 +80,52 @@ func newExecutor(cfg *executorConfig) *executor {
 		executorConfig: *cfg,
 		newSwaps:       make(chan genericSwap),
 		ready:          make(chan struct{}),
+		stateMachines:  make(map[lntypes.Hash]swapStateMachine),
 	}
 }
 
+// swapStateMachine is the state machine of an active swap.
+type swapStateMachine struct {
+	swapType swap.Type
+	machine  fsm.Introspector
+}
+
+// trackStateMachine makes the state machine of an active swap available for
+// introspection until the returned function is called.
+func (s *executor) trackStateMachine(hash lntypes.Hash, swapType swap.Type,
+	machine fsm.Introspector) func() {
+
+	s.Lock()
+	s.stateMachines[hash] = swapStateMachine{
+		swapType: swapType,
+		machine:  machine,
+	}
+	s.Unlock()
+
+	return func() {
+		s.Lock()
+		defer s.Unlock()
+
+		delete(s.stateMachines, hash)
+	}
+}
+
+// stateMachine returns the state machine of an active swap.
+func (s *executor) stateMachine(hash lntypes.Hash) (swapStateMachine, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	stateMachine, ok := s.stateMachines[hash]
+
+	return stateMachine, ok
+}
+
 // run starts the executor event loop. It accepts and executes new swaps,
 // providing them with required config data.
 func (s *executor) run(mainCtx context.Context,
 	statusChan chan<- SwapInfo,
-	abandonChans map[lntypes.Hash]chan struct{}) error {
+	abandonChans map[lntypes.Hash]chan struct{},
+	externalHtlcChans map[lntypes.Hash]chan *externalHtlcTx) error {
 
 	var (
 		err            error
@@ -140,6 +197,17 @@ func (s *executor) run(mainCtx context.Context,
 		}
 	}()
 
+	if s.htlcBatcher != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			// The htlc batcher only exits once the context is
+			// canceled, so there is no error to report.
+			_ = s.htlcBatcher.Run(mainCtx)
+		}()
+	}
+
 	// Start main event loop.
 	log.Infof("Starting event loop at height %v", height)
 
@@ -183,6 +251,12 @@ func (s *executor) run(mainCtx context.Context,
 					maxPaymentRetries:   s.executorConfig.maxPaymentRetries,
 					cancelSwap:          s.executorConfig.cancelSwap,
 					verifySchnorrSig:    s.executorConfig.verifySchnorrSig,
+					routeSender:         s.executorConfig.routeSender,
+					htlcFeeBumper:       s.executorConfig.htlcFeeBumper,
+					htlcBatcher:         s.executorConfig.htlcBatcher,
+					trackStateMachine:   s.trackStateMachine,
+					presignedTimeoutFeeRates: s.executorConfig.
+						presignedTimeoutFeeRates,
 				}, height)
 				if err != nil && !errors.Is(
 					err, context.Canceled,
@@ -192,11 +266,12 @@ func (s *executor) run(mainCtx context.Context,
 				}
 
 				// If a loop-in ended we have to remove its
-				// abandon channel from our abandonChans map
-				// since the swap finalized.
+				// abandon and external htlc channels from our
+				// maps since the swap finalized.
 				if swap, ok := newSwap.(*loopInSwap); ok {
 					s.Lock()
 					delete(abandonChans, swap.hash)
+					delete(externalHtlcChans, swap.hash)
 					s.Unlock()
 				}
 
@@ -0,0 +1,227 @@
+package fsm
+
+import (
+	"fmt"
+	"sort"
+)
+
+// IssueType is the type of a problem found in the states of a state machine.
+type IssueType uint8
+
+const (
+	// IssueUnreachableState is reported for states that can't be reached
+	// from the initial state.
+	IssueUnreachableState IssueType = iota
+
+	// IssueDeadEndState is reported for reachable states that aren't
+	// final but have no transition to another state.
+	IssueDeadEndState
+
+	// IssueUnknownState is reported for transitions into a state that
+	// isn't defined.
+	IssueUnknownState
+
+	// IssueMissingAction is reported for states that can be transitioned
+	// into but don't have an action.
+	IssueMissingAction
+
+	// IssueMissingErrorTransition is reported for reachable states that
+	// aren't final and don't handle the OnError event returned by failing
+	// actions.
+	IssueMissingErrorTransition
+
+	// IssueUndeclaredEvent is reported for transitions that are triggered
+	// by an event that isn't declared.
+	IssueUndeclaredEvent
+
+	// IssueUnhandledEvent is reported for declared events that aren't
+	// handled by any state.
+	IssueUnhandledEvent
+)
+
+// String returns a human readable description of the issue type.
+func (t IssueType) String() string {
+	switch t {
+	case IssueUnreachableState:
+		return "unreachable state"
+
+	case IssueDeadEndState:
+		return "dead end state"
+
+	case IssueUnknownState:
+		return "unknown state"
+
+	case IssueMissingAction:
+		return "missing action"
+
+	case IssueMissingErrorTransition:
+		return "missing error transition"
+
+	case IssueUndeclaredEvent:
+		return "undeclared event"
+
+	case IssueUnhandledEvent:
+		return "unhandled event"
+
+	default:
+		return fmt.Sprintf("unknown issue type %d", uint8(t))
+	}
+}
+
+// Issue is a problem found in the states of a state machine.
+type Issue struct {
+	// Type is the type of the issue.
+	Type IssueType
+
+	// State is the state the issue was found in. It is empty for
+	// unhandled events.
+	State StateType
+
+	// Event is the event the issue relates to, if any.
+	Event EventType
+}
+
+// String returns a human readable description of the issue.
+func (i Issue) String() string {
+	switch {
+	case i.Type == IssueUnhandledEvent:
+		return fmt.Sprintf("%v: %v", i.Type, i.Event)
+
+	case i.Event != "":
+		return fmt.Sprintf("%v: %v in state %q", i.Type, i.Event,
+			i.State)
+
+	default:
+		return fmt.Sprintf("%v: %q", i.Type, i.State)
+	}
+}
+
+// CheckConfig configures the checks of CheckStates.
+type CheckConfig struct {
+	// InitialState is the state the exploration of the states starts
+	// from. It defaults to the EmptyState.
+	InitialState StateType
+
+	// FinalStates are the states in which the state machine is expected
+	// to stop. They don't need to handle errors or leave the state.
+	FinalStates []StateType
+
+	// Events are all events of the state machine. If set, transitions on
+	// other events and events that aren't handled by any state are
+	// reported. OnError doesn't need to be declared.
+	Events []EventType
+}
+
+// CheckStates statically explores the states of a state machine and reports
+// unreachable and dead end states, transitions into unknown states or states
+// without an action, states that don't handle errors as well as undeclared
+// and unhandled events. The issues are sorted by their type, state and event.
+func CheckStates[C any](states TypedStates[C], cfg *CheckConfig) []Issue {
+	var issues []Issue
+	report := func(issueType IssueType, state StateType, event EventType) {
+		issues = append(issues, Issue{
+			Type:  issueType,
+			State: state,
+			Event: event,
+		})
+	}
+
+	final := make(map[StateType]bool, len(cfg.FinalStates))
+	for _, state := range cfg.FinalStates {
+		final[state] = true
+	}
+
+	declared := map[EventType]bool{
+		OnError: true,
+	}
+	for _, event := range cfg.Events {
+		declared[event] = true
+	}
+
+	// Explore all states reachable from the initial state.
+	reachable := map[StateType]bool{
+		cfg.InitialState: true,
+	}
+	queue := []StateType{cfg.InitialState}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		for _, next := range states[state].Transitions {
+			if _, ok := states[next]; !ok || reachable[next] {
+				continue
+			}
+
+			reachable[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	handled := make(map[EventType]bool)
+	missingAction := make(map[StateType]bool)
+	for state, definition := range states {
+		if !reachable[state] {
+			report(IssueUnreachableState, state, "")
+		}
+
+		leaves := false
+		for event, next := range definition.Transitions {
+			handled[event] = true
+
+			if next != state {
+				leaves = true
+			}
+
+			if len(cfg.Events) > 0 && !declared[event] {
+				report(IssueUndeclaredEvent, state, event)
+			}
+
+			target, ok := states[next]
+			switch {
+			case !ok:
+				report(IssueUnknownState, state, event)
+
+			case target.Action == nil && !missingAction[next]:
+				missingAction[next] = true
+				report(IssueMissingAction, next, "")
+			}
+		}
+
+		if !reachable[state] || final[state] {
+			continue
+		}
+
+		if !leaves {
+			report(IssueDeadEndState, state, "")
+		}
+
+		// The initial state has no action that could fail.
+		_, handlesErrors := definition.Transitions[OnError]
+		if state != cfg.InitialState && !handlesErrors {
+			report(IssueMissingErrorTransition, state, OnError)
+		}
+	}
+
+	if len(cfg.Events) > 0 {
+		for _, event := range cfg.Events {
+			if !handled[event] {
+				report(IssueUnhandledEvent, "", event)
+			}
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+
+		if a.State != b.State {
+			return a.State < b.State
+		}
+
+		return a.Event < b.Event
+	})
+
+	return issues
+}
@@ -0,0 +1,85 @@
+package fsm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckStates tests that the checker reports the issues of a broken set
+// of states.
+func TestCheckStates(t *testing.T) {
+	states := States{
+		EmptyState: State{
+			Transitions: Transitions{
+				"Start":   "Pending",
+				"Unknown": "Missing",
+			},
+		},
+		"Pending": State{
+			Action: NoOpAction,
+			Transitions: Transitions{
+				"Wait":  "Stuck",
+				"Done":  "Finished",
+				"Retry": "Pending",
+			},
+		},
+		"Stuck": State{
+			Transitions: Transitions{
+				"Wait":  "Stuck",
+				OnError: "Stuck",
+			},
+		},
+		"Finished": State{
+			Action: NoOpAction,
+		},
+		"Orphan": State{
+			Action: NoOpAction,
+			Transitions: Transitions{
+				OnError: "Finished",
+			},
+		},
+	}
+
+	issues := CheckStates(states, &CheckConfig{
+		FinalStates: []StateType{"Finished"},
+		Events: []EventType{
+			"Start", "Wait", "Done", "Retry", "Cancel",
+		},
+	})
+
+	require.Equal(t, []Issue{
+		{Type: IssueUnreachableState, State: "Orphan"},
+		{Type: IssueDeadEndState, State: "Stuck"},
+		{Type: IssueUnknownState, State: EmptyState, Event: "Unknown"},
+		{Type: IssueMissingAction, State: "Stuck"},
+		{
+			Type:  IssueMissingErrorTransition,
+			State: "Pending",
+			Event: OnError,
+		},
+		{
+			Type:  IssueUndeclaredEvent,
+			State: EmptyState,
+			Event: "Unknown",
+		},
+		{Type: IssueUnhandledEvent, Event: "Cancel"},
+	}, issues)
+
+	require.Equal(t, `missing error transition: OnError in state "Pending"`,
+		issues[4].String())
+	require.Equal(t, `dead end state: "Stuck"`, issues[1].String())
+	require.Equal(t, "unhandled event: Cancel", issues[6].String())
+}
+
+// TestCheckExampleStates tests that the example state machine passes the
+// checker.
+func TestCheckExampleStates(t *testing.T) {
+	issues := CheckStates((&ExampleFSM{}).GetStates(), &CheckConfig{
+		FinalStates: []StateType{StuffSuccess, StuffFailed},
+		Events: []EventType{
+			OnRequestStuff, OnStuffSentOut, OnStuffSuccess,
+		},
+	})
+	require.Empty(t, issues)
+}
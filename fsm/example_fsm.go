@@ -1,7 +1,7 @@
 package fsm
 
 import (
-	"fmt"
+	"errors"
 )
 
 // ExampleService is an example service that we want to wait for in the FSM.
@@ -15,9 +15,10 @@ type ExampleStore interface {
 }
 
 // ExampleFSM implements the FSM and uses the ExampleService and ExampleStore
-// to implement the actions.
+// to implement the actions. Its actions receive typed *InitStuffRequest event
+// contexts.
 type ExampleFSM struct {
-	*StateMachine
+	*TypedStateMachine[*InitStuffRequest]
 
 	service ExampleService
 	store   ExampleStore
@@ -31,7 +32,9 @@ func NewExampleFSMContext(service ExampleService,
 		service: service,
 		store:   store,
 	}
-	exampleFSM.StateMachine = NewStateMachine(exampleFSM.GetStates(), 10)
+	exampleFSM.TypedStateMachine = NewTypedStateMachine(
+		exampleFSM.GetStates(), 10,
+	)
 
 	return exampleFSM
 }
@@ -53,32 +56,32 @@ var (
 )
 
 // GetStates returns the states for the example FSM.
-func (e *ExampleFSM) GetStates() States {
-	return States{
-		EmptyState: State{
+func (e *ExampleFSM) GetStates() TypedStates[*InitStuffRequest] {
+	return TypedStates[*InitStuffRequest]{
+		EmptyState: {
 			Transitions: Transitions{
 				OnRequestStuff: InitFSM,
 			},
 		},
-		InitFSM: State{
+		InitFSM: {
 			Action: e.initFSM,
 			Transitions: Transitions{
 				OnStuffSentOut: StuffSentOut,
 				OnError:        StuffFailed,
 			},
 		},
-		StuffSentOut: State{
+		StuffSentOut: {
 			Action: e.waitForStuff,
 			Transitions: Transitions{
 				OnStuffSuccess: StuffSuccess,
 				OnError:        StuffFailed,
 			},
 		},
-		StuffFailed: State{
-			Action: NoOpAction,
+		StuffFailed: {
+			Action: TypedNoOpAction[*InitStuffRequest],
 		},
-		StuffSuccess: State{
-			Action: NoOpAction,
+		StuffSuccess: {
+			Action: TypedNoOpAction[*InitStuffRequest],
 		},
 	}
 }
@@ -90,12 +93,9 @@ type InitStuffRequest struct {
 }
 
 // initFSM is the action for the InitFSM state.
-func (e *ExampleFSM) initFSM(eventCtx EventContext) EventType {
-	req, ok := eventCtx.(*InitStuffRequest)
-	if !ok {
-		return e.HandleError(
-			fmt.Errorf("invalid event context type: %T", eventCtx),
-		)
+func (e *ExampleFSM) initFSM(req *InitStuffRequest) EventType {
+	if req == nil {
+		return e.HandleError(errors.New("missing stuff request"))
 	}
 
 	err := e.store.StoreStuff()
@@ -109,7 +109,7 @@ func (e *ExampleFSM) initFSM(eventCtx EventContext) EventType {
 }
 
 // waitForStuff is an action that waits for stuff to happen.
-func (e *ExampleFSM) waitForStuff(eventCtx EventContext) EventType {
+func (e *ExampleFSM) waitForStuff(_ *InitStuffRequest) EventType {
 	waitChan, err := e.service.WaitForStuffHappening()
 	if err != nil {
 		return e.HandleError(err)
@@ -38,11 +38,16 @@ func newInitStuffRequest() *InitStuffRequest {
 	}
 }
 
+// newExampleObserver returns a cached observer for the example FSM.
+func newExampleObserver() *TypedCachedObserver[*InitStuffRequest] {
+	return NewTypedCachedObserver[*InitStuffRequest](100)
+}
+
 func TestExampleFSM(t *testing.T) {
 	testCases := []struct {
 		name                    string
 		expectedState           StateType
-		eventCtx                EventContext
+		eventCtx                *InitStuffRequest
 		expectedLastActionError error
 
 		sendEvent    EventType
@@ -80,8 +85,8 @@ func TestExampleFSM(t *testing.T) {
 
 		t.Run(tc.name, func(t *testing.T) {
 			respondChan := make(chan string, 1)
-			if req, ok := tc.eventCtx.(*InitStuffRequest); ok {
-				req.respondChan = respondChan
+			if tc.eventCtx != nil {
+				tc.eventCtx.respondChan = respondChan
 			}
 
 			serviceResponseChan := make(chan bool, 1)
@@ -97,7 +102,7 @@ func TestExampleFSM(t *testing.T) {
 			}
 
 			exampleContext := NewExampleFSMContext(service, store)
-			cachedObserver := NewCachedObserver(100)
+			cachedObserver := newExampleObserver()
 
 			exampleContext.RegisterObserver(cachedObserver)
 
@@ -124,7 +129,9 @@ func TestExampleFSM(t *testing.T) {
 
 // getTestContext returns a test context for the example FSM and a cached
 // observer that can be used to verify the state transitions.
-func getTestContext() (*ExampleFSM, *CachedObserver) {
+func getTestContext() (*ExampleFSM,
+	*TypedCachedObserver[*InitStuffRequest]) {
+
 	service := &mockService{
 		respondChan: make(chan bool, 1),
 	}
@@ -133,7 +140,7 @@ func getTestContext() (*ExampleFSM, *CachedObserver) {
 	store := &mockStore{}
 
 	exampleContext := NewExampleFSMContext(service, store)
-	cachedObserver := NewCachedObserver(100)
+	cachedObserver := newExampleObserver()
 
 	exampleContext.RegisterObserver(cachedObserver)
 
@@ -277,7 +284,7 @@ func TestObserverAsyncWait(t *testing.T) {
 			store := &mockStore{}
 
 			exampleContext := NewExampleFSMContext(service, store)
-			cachedObserver := NewCachedObserver(100)
+			cachedObserver := newExampleObserver()
 			exampleContext.RegisterObserver(cachedObserver)
 
 			t0 := time.Now()
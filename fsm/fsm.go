@@ -40,34 +40,34 @@ type StateType string
 // EventType represents an extensible event type in the state machine.
 type EventType string
 
-// EventContext represents the context to be passed to the action
-// implementation.
-type EventContext interface{}
-
-// Action represents the action to be executed in a given state.
-type Action func(eventCtx EventContext) EventType
+// TypedAction represents the action to be executed in a given state of a
+// state machine whose event contexts are of type C.
+type TypedAction[C any] func(eventCtx C) EventType
 
 // Transitions represents a mapping of events and states.
 type Transitions map[EventType]StateType
 
-// State binds a state with an action and a set of events it can handle.
-type State struct {
+// TypedState binds a state with an action and a set of events it can handle.
+type TypedState[C any] struct {
 	// EntryFunc is a function that is called when the state is entered.
 	EntryFunc func()
 	// ExitFunc is a function that is called when the state is exited.
 	ExitFunc func()
 	// Action is the action to be executed in the state.
-	Action Action
+	Action TypedAction[C]
 	// Transitions is a mapping of events and states.
 	Transitions Transitions
+	// Timeouts are events that are sent to the state machine if it stays
+	// in the state for too long.
+	Timeouts []Timeout
 }
 
-// States represents a mapping of states and their implementations.
-type States map[StateType]State
+// TypedStates represents a mapping of states and their implementations.
+type TypedStates[C any] map[StateType]TypedState[C]
 
-// Notification represents a notification sent to the state machine's
-// notification channel.
-type Notification struct {
+// TypedNotification represents a notification sent to the state machine's
+// observers.
+type TypedNotification[C any] struct {
 	// PreviousState is the state the state machine was in before the event
 	// was processed.
 	PreviousState StateType
@@ -76,24 +76,28 @@ type Notification struct {
 	NextState StateType
 	// Event is the event that was processed.
 	Event EventType
+	// EventContext is the event context that is passed to the action of
+	// the next state.
+	EventContext C
 	// LastActionError is the error returned by the last action executed.
 	LastActionError error
 }
 
-// Observer is an interface that can be implemented by types that want to
-// observe the state machine.
-type Observer interface {
-	Notify(Notification)
+// TypedObserver is an interface that can be implemented by types that want to
+// observe a state machine whose event contexts are of type C.
+type TypedObserver[C any] interface {
+	Notify(TypedNotification[C])
 }
 
-// StateMachine represents the state machine.
-type StateMachine struct {
+// TypedStateMachine represents a state machine whose actions receive event
+// contexts of type C.
+type TypedStateMachine[C any] struct {
 	// Context represents the state machine context.
-	States States
+	States TypedStates[C]
 
 	// ActionEntryFunc is a function that is called before an action is
 	// executed.
-	ActionEntryFunc func(Notification)
+	ActionEntryFunc func(TypedNotification[C])
 
 	// ActionExitFunc is a function that is called after an action is
 	// executed, it is called with the EventType returned by the action.
@@ -104,7 +108,7 @@ type StateMachine struct {
 
 	// DefaultObserver is the default observer that is notified when the
 	// state machine transitions between states.
-	DefaultObserver *CachedObserver
+	DefaultObserver *TypedCachedObserver[C]
 
 	// previous represents the previous state.
 	previous StateType
@@ -114,7 +118,7 @@ type StateMachine struct {
 
 	// observers is a slice of observers that are notified when the state
 	// machine transitions between states.
-	observers []Observer
+	observers []TypedObserver[C]
 
 	// observerMutex ensures that observers are only added or removed
 	// safely.
@@ -123,27 +127,41 @@ type StateMachine struct {
 	// mutex ensures that only 1 event is processed by the state machine at
 	// any given time.
 	mutex sync.Mutex
+
+	// stateMutex guards the previous and current state as well as the
+	// last action error, so that they can be inspected while an action is
+	// running. They are only changed while the mutex is held as well.
+	stateMutex sync.RWMutex
+
+	// persistence is the optional configuration used to record the
+	// transitions of the state machine.
+	persistence *TypedPersistenceConfig[C]
+
+	// timeouts tracks the timeouts of the current state.
+	timeouts timeoutState[C]
 }
 
-// NewStateMachine creates a new state machine.
-func NewStateMachine(states States, observerSize int) *StateMachine {
-	return NewStateMachineWithState(states, EmptyState, observerSize)
+// NewTypedStateMachine creates a new typed state machine.
+func NewTypedStateMachine[C any](states TypedStates[C],
+	observerSize int) *TypedStateMachine[C] {
+
+	return NewTypedStateMachineWithState(states, EmptyState, observerSize)
 }
 
-// NewStateMachineWithState creates a new state machine and sets the initial
-// state.
-func NewStateMachineWithState(states States, current StateType,
-	observerSize int) *StateMachine {
+// NewTypedStateMachineWithState creates a new typed state machine and sets the
+// initial state.
+func NewTypedStateMachineWithState[C any](states TypedStates[C],
+	current StateType, observerSize int) *TypedStateMachine[C] {
 
-	observers := []Observer{}
-	var defaultObserver *CachedObserver
+	observers := []TypedObserver[C]{}
+	var defaultObserver *TypedCachedObserver[C]
 
 	if observerSize > 0 {
-		defaultObserver = NewCachedObserver(observerSize)
+		defaultObserver = NewTypedCachedObserver[C](observerSize)
 		observers = append(observers, defaultObserver)
 	}
 
-	return &StateMachine{
+	return &TypedStateMachine[C]{
 		States:          states,
 		current:         current,
 		DefaultObserver: defaultObserver,
@@ -153,27 +171,31 @@ func NewStateMachineWithState(states States, current StateType,
 
 // getNextState returns the next state for the event given the machine's current
 // state, or an error if the event can't be handled in the given state.
-func (s *StateMachine) getNextState(event EventType) (State, error) {
+func (s *TypedStateMachine[C]) getNextState(event EventType) (TypedState[C],
+	error) {
+
 	var (
-		state State
+		state TypedState[C]
 		ok    bool
 	)
 
 	stateMap := s.States
 
 	if state, ok = stateMap[s.current]; !ok {
-		return State{}, NewErrConfigError("current state not found")
+		return TypedState[C]{}, NewErrConfigError(
+			"current state not found",
+		)
 	}
 
 	if state.Transitions == nil {
-		return State{}, NewErrConfigError(
+		return TypedState[C]{}, NewErrConfigError(
 			"current state has no transitions",
 		)
 	}
 
 	var next StateType
 	if next, ok = state.Transitions[event]; !ok {
-		return State{}, NewErrConfigError(
+		return TypedState[C]{}, NewErrConfigError(
 			"event not found in current transitions",
 		)
 	}
@@ -181,16 +203,22 @@ func (s *StateMachine) getNextState(event EventType) (State, error) {
 	// Identify the state definition for the next state.
 	state, ok = stateMap[next]
 	if !ok {
-		return State{}, NewErrConfigError("next state not found")
+		return TypedState[C]{}, NewErrConfigError(
+			"next state not found",
+		)
 	}
 
 	if state.Action == nil {
-		return State{}, NewErrConfigError("next state has no action")
+		return TypedState[C]{}, NewErrConfigError(
+			"next state has no action",
+		)
 	}
 
 	// Transition over to the next state.
+	s.stateMutex.Lock()
 	s.previous = s.current
 	s.current = next
+	s.stateMutex.Unlock()
 
 	return state, nil
 }
@@ -198,7 +226,7 @@ func (s *StateMachine) getNextState(event EventType) (State, error) {
 // SendEvent sends an event to the state machine. It returns an error if the
 // event cannot be processed in the current state. Otherwise, it only returns
 // nil if the event for the last action is a no-op.
-func (s *StateMachine) SendEvent(event EventType, eventCtx EventContext) error {
+func (s *TypedStateMachine[C]) SendEvent(event EventType, eventCtx C) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -206,7 +234,15 @@ func (s *StateMachine) SendEvent(event EventType, eventCtx EventContext) error {
 		return NewErrConfigError("state machine config is nil")
 	}
 
+	return s.sendEvent(event, eventCtx)
+}
+
+// sendEvent processes the event and the events returned by the subsequent
+// actions until an action returns a no-op. The caller must hold the mutex.
+func (s *TypedStateMachine[C]) sendEvent(event EventType, eventCtx C) error {
 	for {
+		previous, current := s.previous, s.current
+
 		// Determine the next state for the event given the machine's
 		// current state.
 		state, err := s.getNextState(event)
@@ -216,43 +252,23 @@ func (s *StateMachine) SendEvent(event EventType, eventCtx EventContext) error {
 			return ErrEventRejected
 		}
 
-		// Notify the state machine's observers.
-		s.observerMutex.Lock()
-		notification := Notification{
-			PreviousState:   s.previous,
-			NextState:       s.current,
-			Event:           event,
-			LastActionError: s.LastActionError,
-		}
-
-		for _, observer := range s.observers {
-			observer.Notify(notification)
-		}
-		s.observerMutex.Unlock()
+		// Persist the transition before executing the action, so that
+		// the action can be replayed if we're interrupted.
+		err = s.recordTransition(event, eventCtx)
+		if err != nil {
+			log.Errorf("unable to record transition from %v to "+
+				"%v: %v", current, s.current, err)
 
-		// Execute the state machines ActionEntryFunc.
-		if s.ActionEntryFunc != nil {
-			s.ActionEntryFunc(notification)
-		}
+			s.stateMutex.Lock()
+			s.previous, s.current = previous, current
+			s.stateMutex.Unlock()
 
-		// Execute the current state's entry function
-		if state.EntryFunc != nil {
-			state.EntryFunc()
+			return err
 		}
 
 		// Execute the next state's action and loop over again if the
 		// event returned is not a no-op.
-		nextEvent := state.Action(eventCtx)
-
-		// Execute the current state's exit function
-		if state.ExitFunc != nil {
-			state.ExitFunc()
-		}
-
-		// Execute the state machines ActionExitFunc.
-		if s.ActionExitFunc != nil {
-			s.ActionExitFunc(nextEvent)
-		}
+		nextEvent := s.executeState(state, event, eventCtx)
 
 		// If the next event is a no-op, we're done.
 		if nextEvent == NoOp {
@@ -263,8 +279,60 @@ func (s *StateMachine) SendEvent(event EventType, eventCtx EventContext) error {
 	}
 }
 
+// executeState notifies the observers about the current state and executes
+// its action together with the entry and exit functions. It returns the event
+// returned by the action.
+func (s *TypedStateMachine[C]) executeState(state TypedState[C],
+	event EventType, eventCtx C) EventType {
+
+	// Notify the state machine's observers.
+	s.observerMutex.Lock()
+	notification := TypedNotification[C]{
+		PreviousState:   s.previous,
+		NextState:       s.current,
+		Event:           event,
+		EventContext:    eventCtx,
+		LastActionError: s.LastActionError,
+	}
+
+	for _, observer := range s.observers {
+		observer.Notify(notification)
+	}
+	s.observerMutex.Unlock()
+
+	// Schedule the timeouts of the state before its action is executed,
+	// which cancels the timeouts of the previous state.
+	s.armTimeouts(state, eventCtx)
+
+	// Execute the state machines ActionEntryFunc.
+	if s.ActionEntryFunc != nil {
+		s.ActionEntryFunc(notification)
+	}
+
+	// Execute the current state's entry function
+	if state.EntryFunc != nil {
+		state.EntryFunc()
+	}
+
+	nextEvent := state.Action(eventCtx)
+
+	// Execute the current state's exit function
+	if state.ExitFunc != nil {
+		state.ExitFunc()
+	}
+
+	// Execute the state machines ActionExitFunc.
+	if s.ActionExitFunc != nil {
+		s.ActionExitFunc(nextEvent)
+	}
+
+	return nextEvent
+}
+
 // RegisterObserver registers an observer with the state machine.
-func (s *StateMachine) RegisterObserver(observer Observer) {
+func (s *TypedStateMachine[C]) RegisterObserver(
+	observer TypedObserver[C]) {
+
 	s.observerMutex.Lock()
 	defer s.observerMutex.Unlock()
 
@@ -275,7 +343,9 @@ func (s *StateMachine) RegisterObserver(observer Observer) {
 
 // RemoveObserver removes an observer from the state machine. It returns true
 // if the observer was removed, false otherwise.
-func (s *StateMachine) RemoveObserver(observer Observer) bool {
+func (s *TypedStateMachine[C]) RemoveObserver(
+	observer TypedObserver[C]) bool {
+
 	s.observerMutex.Lock()
 	defer s.observerMutex.Unlock()
 
@@ -293,15 +363,19 @@ func (s *StateMachine) RemoveObserver(observer Observer) bool {
 
 // HandleError is a helper function that can be used by actions to handle
 // errors.
-func (s *StateMachine) HandleError(err error) EventType {
+func (s *TypedStateMachine[C]) HandleError(err error) EventType {
 	log.Errorf("StateMachine error: %s", err)
+
+	s.stateMutex.Lock()
 	s.LastActionError = err
+	s.stateMutex.Unlock()
+
 	return OnError
 }
 
-// NoOpAction is a no-op action that can be used by states that don't need to
-// execute any action.
-func NoOpAction(_ EventContext) EventType {
+// TypedNoOpAction is a no-op action that can be used by states of typed state
+// machines that don't need to execute any action.
+func TypedNoOpAction[C any](_ C) EventType {
 	return NoOp
 }
 
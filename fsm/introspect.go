@@ -0,0 +1,212 @@
+package fsm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Edge is a transition of the state graph of a state machine.
+type Edge struct {
+	// From is the state the transition starts in.
+	From StateType
+
+	// To is the state the transition leads to.
+	To StateType
+
+	// Event is the event that triggers the transition.
+	Event EventType
+}
+
+// HistoryEntry is a transition that the state machine went through.
+type HistoryEntry struct {
+	// PreviousState is the state the state machine was in before the
+	// event was processed.
+	PreviousState StateType
+
+	// NextState is the state the state machine transitioned into.
+	NextState StateType
+
+	// Event is the event that was processed.
+	Event EventType
+
+	// LastActionError is the error of the last action at the time of the
+	// transition.
+	LastActionError error
+}
+
+// Snapshot is a point in time view of a running state machine.
+type Snapshot struct {
+	// PreviousState is the state the state machine was in before its
+	// current state.
+	PreviousState StateType
+
+	// CurrentState is the state the state machine is in.
+	CurrentState StateType
+
+	// States are all states of the state machine, sorted by name.
+	States []StateType
+
+	// Edges are all transitions of the state machine, sorted by their
+	// source state and event.
+	Edges []Edge
+
+	// History contains the transitions cached by the default observer of
+	// the state machine, oldest first.
+	History []HistoryEntry
+
+	// LastActionError is the error returned by the last action.
+	LastActionError error
+}
+
+// Introspector is implemented by state machines that can be inspected while
+// they are running.
+type Introspector interface {
+	// Snapshot returns the current view of the state machine.
+	Snapshot() *Snapshot
+}
+
+// CurrentState returns the current state of the state machine. It doesn't
+// wait for a running action to complete.
+func (s *TypedStateMachine[C]) CurrentState() StateType {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
+	return s.current
+}
+
+// Snapshot returns the current view of the state machine. It doesn't wait for
+// a running action to complete.
+func (s *TypedStateMachine[C]) Snapshot() *Snapshot {
+	s.stateMutex.RLock()
+	snapshot := &Snapshot{
+		PreviousState:   s.previous,
+		CurrentState:    s.current,
+		LastActionError: s.LastActionError,
+	}
+	s.stateMutex.RUnlock()
+
+	snapshot.States, snapshot.Edges = stateGraph(s.States)
+
+	if s.DefaultObserver != nil {
+		notifications := s.DefaultObserver.GetCachedNotifications()
+		for _, n := range notifications {
+			snapshot.History = append(snapshot.History, HistoryEntry{
+				PreviousState:   n.PreviousState,
+				NextState:       n.NextState,
+				Event:           n.Event,
+				LastActionError: n.LastActionError,
+			})
+		}
+	}
+
+	return snapshot
+}
+
+// stateGraph returns the sorted states and transitions of the states map.
+func stateGraph[C any](states TypedStates[C]) ([]StateType, []Edge) {
+	var (
+		stateList []StateType
+		edges     []Edge
+	)
+	for state, definition := range states {
+		stateList = append(stateList, state)
+
+		for event, next := range definition.Transitions {
+			edges = append(edges, Edge{
+				From:  state,
+				To:    next,
+				Event: event,
+			})
+		}
+	}
+
+	sort.Slice(stateList, func(i, j int) bool {
+		return stateList[i] < stateList[j]
+	})
+
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+
+		return edges[i].Event < edges[j].Event
+	})
+
+	return stateList, edges
+}
+
+// mermaidState returns the mermaid name of a state. The empty state is the
+// start state of the diagram.
+func mermaidState(state StateType) string {
+	if state == EmptyState {
+		return "[*]"
+	}
+
+	return string(state)
+}
+
+// Mermaid renders the state graph as a mermaid state diagram in which the
+// current state is highlighted.
+func (s *Snapshot) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+
+	for _, state := range s.States {
+		if state != EmptyState {
+			fmt.Fprintf(&b, "%s\n", state)
+		}
+	}
+
+	for _, edge := range s.Edges {
+		fmt.Fprintf(&b, "%s --> %s: %s\n", mermaidState(edge.From),
+			mermaidState(edge.To), edge.Event)
+	}
+
+	if s.CurrentState != EmptyState {
+		b.WriteString("classDef current fill:#f96\n")
+		fmt.Fprintf(&b, "class %s current\n", s.CurrentState)
+	}
+
+	return b.String()
+}
+
+// dotState returns the quoted graphviz name of a state.
+func dotState(state StateType) string {
+	if state == EmptyState {
+		return `"[*]"`
+	}
+
+	return fmt.Sprintf("%q", state)
+}
+
+// Dot renders the state graph in the graphviz DOT language. The current
+// state is filled.
+func (s *Snapshot) Dot() string {
+	var b strings.Builder
+	b.WriteString("digraph fsm {\n")
+
+	for _, state := range s.States {
+		switch {
+		case state == s.CurrentState:
+			fmt.Fprintf(&b, "\t%s [style=filled, "+
+				"fillcolor=orange];\n", dotState(state))
+
+		case state == EmptyState:
+			fmt.Fprintf(&b, "\t%s [shape=point];\n",
+				dotState(state))
+
+		default:
+			fmt.Fprintf(&b, "\t%s;\n", dotState(state))
+		}
+	}
+
+	for _, edge := range s.Edges {
+		fmt.Fprintf(&b, "\t%s -> %s [label=%q];\n", dotState(edge.From),
+			dotState(edge.To), edge.Event)
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package fsm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSnapshot tests the snapshot of a running state machine and the
+// rendering of its state graph.
+func TestSnapshot(t *testing.T) {
+	s := NewStateMachine(States{
+		EmptyState: State{
+			Transitions: Transitions{
+				"Start": "Pending",
+			},
+		},
+		"Pending": State{
+			Action: func(EventContext) EventType {
+				return OnError
+			},
+			Transitions: Transitions{
+				OnError: "Failed",
+				"Done":  "Finished",
+			},
+		},
+		"Failed": State{
+			Action: NoOpAction,
+		},
+		"Finished": State{
+			Action: NoOpAction,
+		},
+	}, 10)
+
+	require.NoError(t, s.SendEvent("Start", nil))
+	s.LastActionError = errAction
+
+	snapshot := s.Snapshot()
+	require.Equal(t, &Snapshot{
+		PreviousState: "Pending",
+		CurrentState:  "Failed",
+		States: []StateType{
+			EmptyState, "Failed", "Finished", "Pending",
+		},
+		Edges: []Edge{
+			{From: EmptyState, To: "Pending", Event: "Start"},
+			{From: "Pending", To: "Finished", Event: "Done"},
+			{From: "Pending", To: "Failed", Event: OnError},
+		},
+		History: []HistoryEntry{
+			{
+				PreviousState: EmptyState,
+				NextState:     "Pending",
+				Event:         "Start",
+			},
+			{
+				PreviousState: "Pending",
+				NextState:     "Failed",
+				Event:         OnError,
+			},
+		},
+		LastActionError: errAction,
+	}, snapshot)
+
+	require.Equal(t, "stateDiagram-v2\n"+
+		"Failed\n"+
+		"Finished\n"+
+		"Pending\n"+
+		"[*] --> Pending: Start\n"+
+		"Pending --> Finished: Done\n"+
+		"Pending --> Failed: OnError\n"+
+		"classDef current fill:#f96\n"+
+		"class Failed current\n", snapshot.Mermaid())
+
+	require.Equal(t, "digraph fsm {\n"+
+		"\t\"[*]\" [shape=point];\n"+
+		"\t\"Failed\" [style=filled, fillcolor=orange];\n"+
+		"\t\"Finished\";\n"+
+		"\t\"Pending\";\n"+
+		"\t\"[*]\" -> \"Pending\" [label=\"Start\"];\n"+
+		"\t\"Pending\" -> \"Finished\" [label=\"Done\"];\n"+
+		"\t\"Pending\" -> \"Failed\" [label=\"OnError\"];\n"+
+		"}\n", snapshot.Dot())
+}
@@ -6,21 +6,21 @@ import (
 	"time"
 )
 
-// CachedObserver is an observer that caches all states and transitions of
-// the observed state machine.
-type CachedObserver struct {
-	lastNotification    Notification
-	cachedNotifications *FixedSizeSlice[Notification]
+// TypedCachedObserver is an observer that caches all states and transitions
+// of the observed state machine.
+type TypedCachedObserver[C any] struct {
+	lastNotification    TypedNotification[C]
+	cachedNotifications *FixedSizeSlice[TypedNotification[C]]
 
 	notificationCond *sync.Cond
 	notificationMx   sync.Mutex
 }
 
-// NewCachedObserver creates a new cached observer with the given maximum
-// number of cached notifications.
-func NewCachedObserver(maxElements int) *CachedObserver {
-	fixedSizeSlice := NewFixedSizeSlice[Notification](maxElements)
-	observer := &CachedObserver{
+// NewTypedCachedObserver creates a new typed cached observer with the given
+// maximum number of cached notifications.
+func NewTypedCachedObserver[C any](maxElements int) *TypedCachedObserver[C] {
+	fixedSizeSlice := NewFixedSizeSlice[TypedNotification[C]](maxElements)
+	observer := &TypedCachedObserver[C]{
 		cachedNotifications: fixedSizeSlice,
 	}
 	observer.notificationCond = sync.NewCond(&observer.notificationMx)
@@ -28,8 +28,10 @@ func NewCachedObserver(maxElements int) *CachedObserver {
 	return observer
 }
 
-// Notify implements the Observer interface.
-func (c *CachedObserver) Notify(notification Notification) {
+// Notify implements the TypedObserver interface.
+func (c *TypedCachedObserver[C]) Notify(
+	notification TypedNotification[C]) {
+
 	c.notificationMx.Lock()
 	defer c.notificationMx.Unlock()
 
@@ -39,7 +41,9 @@ func (c *CachedObserver) Notify(notification Notification) {
 }
 
 // GetCachedNotifications returns a copy of the  cached notifications.
-func (c *CachedObserver) GetCachedNotifications() []Notification {
+func (c *TypedCachedObserver[C]) GetCachedNotifications() (
+	notifications []TypedNotification[C]) {
+
 	c.notificationMx.Lock()
 	defer c.notificationMx.Unlock()
 
@@ -100,7 +104,7 @@ func WithAbortEarlyOnErrorOption() WaitForStateOption {
 // the given duration before checking the state. This is useful if the
 // function is called immediately after sending an event to the state machine
 // and the state machine needs some time to process the event.
-func (c *CachedObserver) WaitForState(ctx context.Context,
+func (c *TypedCachedObserver[C]) WaitForState(ctx context.Context,
 	timeout time.Duration, state StateType,
 	opts ...WaitForStateOption) error {
 
@@ -140,8 +144,8 @@ func (c *CachedObserver) WaitForState(ctx context.Context,
 // receive an error if the expected state is reached or an error occurred. If
 // the context is canceled before the expected state is reached, the channel
 // will receive an ErrWaitingForStateTimeout error.
-func (c *CachedObserver) WaitForStateAsync(ctx context.Context, state StateType,
-	abortOnEarlyError bool) chan error {
+func (c *TypedCachedObserver[C]) WaitForStateAsync(ctx context.Context,
+	state StateType, abortOnEarlyError bool) chan error {
 
 	// Channel to notify when the desired state is reached or an error
 	// occurred.
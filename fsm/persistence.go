@@ -0,0 +1,326 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// OnResume is the event that is reported to observers when a persisted
+	// state machine re-executes the action of its current state after a
+	// restart. It is never looked up in a state's transitions.
+	OnResume EventType = "OnResume"
+)
+
+var (
+	// ErrMissingStore is returned when a persistent state machine is
+	// created without a store.
+	ErrMissingStore = errors.New("persistent state machine requires a " +
+		"store")
+
+	// ErrMissingIdentity is returned when a persistent state machine is
+	// created without a kind or id.
+	ErrMissingIdentity = errors.New("persistent state machine requires " +
+		"a kind and an id")
+
+	// ErrMissingContext is returned when a persistent state machine is
+	// created without a context.
+	ErrMissingContext = errors.New("persistent state machine requires " +
+		"a context")
+)
+
+// Transition is a single persisted state transition of a state machine.
+type Transition struct {
+	// Kind identifies the type of state machine, e.g. "instantout".
+	Kind string
+
+	// ID uniquely identifies the state machine within its kind.
+	ID string
+
+	// PreviousState is the state the machine was in before the event was
+	// processed.
+	PreviousState StateType
+
+	// NextState is the state the machine transitioned into.
+	NextState StateType
+
+	// Event is the event that triggered the transition.
+	Event EventType
+
+	// EventContext is the encoded event context that was passed to the
+	// action of the next state. It is nil if no codec is configured.
+	EventContext []byte
+
+	// Timestamp is the time at which the transition was recorded.
+	Timestamp time.Time
+}
+
+// Store is the interface a persistent state machine uses to record its
+// transitions.
+type Store interface {
+	// RecordTransition stores a single transition.
+	RecordTransition(ctx context.Context, transition *Transition) error
+
+	// Transitions returns all transitions of the given machine in the
+	// order they were recorded.
+	Transitions(ctx context.Context, kind, id string) ([]*Transition,
+		error)
+
+	// LastTransitions returns the most recent transition of every machine
+	// of the given kind.
+	LastTransitions(ctx context.Context, kind string) ([]*Transition,
+		error)
+}
+
+// TypedContextCodec serializes the event contexts of a state machine so that
+// they can be handed to the action again when the machine is resumed.
+type TypedContextCodec[C any] interface {
+	// Encode serializes the event context.
+	Encode(eventCtx C) ([]byte, error)
+
+	// Decode deserializes an event context that was passed to the action
+	// of the given state.
+	Decode(state StateType, data []byte) (C, error)
+}
+
+// TypedPersistenceConfig contains the configuration of a persistent state
+// machine.
+type TypedPersistenceConfig[C any] struct {
+	// Kind identifies the type of state machine.
+	Kind string
+
+	// ID uniquely identifies the state machine within its kind.
+	ID string
+
+	// Store is used to record the transitions of the state machine.
+	Store Store
+
+	// Context is the context the state machine runs in. It is used to
+	// record the transitions of the machine.
+	Context context.Context
+
+	// Codec is used to persist event contexts. If it is nil, event
+	// contexts are not persisted and actions are resumed with a nil
+	// context.
+	Codec TypedContextCodec[C]
+
+	// Clock is used to timestamp transitions. It defaults to the system
+	// clock.
+	Clock clock.Clock
+}
+
+// NewTypedPersistentStateMachine creates a new state machine that records
+// every transition in the configured store before executing the action of the
+// next state. This allows the machine to be resumed after a restart, in which
+// case the action of the last recorded state is executed again. Actions of
+// persistent state machines must therefore be idempotent.
+func NewTypedPersistentStateMachine[C any](states TypedStates[C],
+	cfg *TypedPersistenceConfig[C], observerSize int) (*TypedStateMachine[C],
+	error) {
+
+	return NewTypedPersistentStateMachineWithState(
+		states, cfg, EmptyState, observerSize,
+	)
+}
+
+// NewTypedPersistentStateMachineWithState creates a new persistent state
+// machine, see NewTypedPersistentStateMachine, and sets the initial state.
+func NewTypedPersistentStateMachineWithState[C any](states TypedStates[C],
+	cfg *TypedPersistenceConfig[C], current StateType,
+	observerSize int) (*TypedStateMachine[C], error) {
+
+	if cfg == nil || cfg.Store == nil {
+		return nil, ErrMissingStore
+	}
+
+	if cfg.Kind == "" || cfg.ID == "" {
+		return nil, ErrMissingIdentity
+	}
+
+	if cfg.Context == nil {
+		return nil, ErrMissingContext
+	}
+
+	persistence := *cfg
+	if persistence.Clock == nil {
+		persistence.Clock = clock.NewDefaultClock()
+	}
+
+	s := NewTypedStateMachineWithState(states, current, observerSize)
+	s.persistence = &persistence
+
+	return s, nil
+}
+
+// recordTransition persists the transition into the current state. It is a
+// no-op for state machines without persistence.
+func (s *TypedStateMachine[C]) recordTransition(event EventType,
+	eventCtx C) error {
+
+	if s.persistence == nil {
+		return nil
+	}
+
+	var (
+		encodedCtx []byte
+		err        error
+	)
+	if s.persistence.Codec != nil && any(eventCtx) != nil {
+		encodedCtx, err = s.persistence.Codec.Encode(eventCtx)
+		if err != nil {
+			return fmt.Errorf("unable to encode event context: %w",
+				err)
+		}
+	}
+
+	return s.persistence.Store.RecordTransition(
+		s.persistence.Context, &Transition{
+			Kind:          s.persistence.Kind,
+			ID:            s.persistence.ID,
+			PreviousState: s.previous,
+			NextState:     s.current,
+			Event:         event,
+			EventContext:  encodedCtx,
+			Timestamp:     s.persistence.Clock.Now(),
+		},
+	)
+}
+
+// restore sets the state of the machine to the one recorded in the given
+// transition and returns the decoded event context of that transition.
+func (s *TypedStateMachine[C]) restore(transition *Transition) (C, error) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var eventCtx C
+	if _, ok := s.States[transition.NextState]; !ok {
+		return eventCtx, NewErrConfigError(fmt.Sprintf("unknown "+
+			"persisted state %v", transition.NextState))
+	}
+
+	if s.persistence != nil && s.persistence.Codec != nil &&
+		transition.EventContext != nil {
+
+		var err error
+		eventCtx, err = s.persistence.Codec.Decode(
+			transition.NextState, transition.EventContext,
+		)
+		if err != nil {
+			return eventCtx, fmt.Errorf("unable to decode event "+
+				"context: %w", err)
+		}
+	}
+
+	s.stateMutex.Lock()
+	s.previous = transition.PreviousState
+	s.current = transition.NextState
+	s.stateMutex.Unlock()
+
+	return eventCtx, nil
+}
+
+// Resume executes the action of the current state again and keeps processing
+// the events it returns. It is used to continue a state machine whose action
+// may have been interrupted, e.g. by a restart.
+func (s *TypedStateMachine[C]) Resume(eventCtx C) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.States == nil {
+		return NewErrConfigError("state machine config is nil")
+	}
+
+	state, ok := s.States[s.current]
+	if !ok {
+		return NewErrConfigError("current state not found")
+	}
+
+	if state.Action == nil {
+		return NewErrConfigError("current state has no action")
+	}
+
+	nextEvent := s.executeState(state, OnResume, eventCtx)
+	if nextEvent == NoOp {
+		return nil
+	}
+
+	return s.sendEvent(nextEvent, eventCtx)
+}
+
+// TypedMachineFactory creates a persistent state machine for the given
+// recorded transition. The returned machine must be configured with the kind
+// and id of the transition. Observers that need to see the resumed actions
+// have to be registered by the factory, as the machine is resumed right after.
+// The factory returns a nil machine if the machine shouldn't be resumed.
+type TypedMachineFactory[C any] func(transition *Transition) (
+	*TypedStateMachine[C], error)
+
+// ResumeTypedMachines restores all machines of the given kind that haven't
+// reached one of the final states from their last recorded transition and
+// resumes them in the background. The restored machines are returned to the
+// caller.
+func ResumeTypedMachines[C any](ctx context.Context, store Store, kind string,
+	finalStates []StateType, factory TypedMachineFactory[C]) (
+	[]*TypedStateMachine[C], error) {
+
+	transitions, err := store.LastTransitions(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	isFinal := make(map[StateType]struct{}, len(finalStates))
+	for _, state := range finalStates {
+		isFinal[state] = struct{}{}
+	}
+
+	var machines []*TypedStateMachine[C]
+	for _, transition := range transitions {
+		if _, ok := isFinal[transition.NextState]; ok {
+			continue
+		}
+
+		machine, err := factory(transition)
+		if err != nil {
+			return nil, err
+		}
+
+		if machine == nil {
+			continue
+		}
+
+		if machine.persistence == nil ||
+			machine.persistence.Kind != transition.Kind ||
+			machine.persistence.ID != transition.ID {
+
+			return nil, NewErrConfigError(fmt.Sprintf("factory "+
+				"returned a machine not matching %v/%v",
+				transition.Kind, transition.ID))
+		}
+
+		eventCtx, err := machine.restore(transition)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Resuming %v state machine %v in state %v",
+			transition.Kind, transition.ID, transition.NextState)
+
+		go func() {
+			err := machine.Resume(eventCtx)
+			if err != nil {
+				log.Errorf("Unable to resume %v state machine "+
+					"%v: %v", transition.Kind,
+					transition.ID, err)
+			}
+		}()
+
+		machines = append(machines, machine)
+	}
+
+	return machines, nil
+}
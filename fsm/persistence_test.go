@@ -0,0 +1,303 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errRecord = errors.New("store error")
+
+	testTime = time.Unix(1700000000, 0)
+)
+
+// mockTransitionStore is an in-memory implementation of the Store interface.
+type mockTransitionStore struct {
+	sync.Mutex
+
+	transitions []*Transition
+
+	recordErr error
+}
+
+// RecordTransition stores a single transition.
+func (m *mockTransitionStore) RecordTransition(_ context.Context,
+	transition *Transition) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.recordErr != nil {
+		return m.recordErr
+	}
+
+	m.transitions = append(m.transitions, transition)
+
+	return nil
+}
+
+// Transitions returns all transitions of the given machine.
+func (m *mockTransitionStore) Transitions(_ context.Context, kind,
+	id string) ([]*Transition, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	var transitions []*Transition
+	for _, t := range m.transitions {
+		if t.Kind == kind && t.ID == id {
+			transitions = append(transitions, t)
+		}
+	}
+
+	return transitions, nil
+}
+
+// LastTransitions returns the last transition of every machine of the kind.
+func (m *mockTransitionStore) LastTransitions(_ context.Context,
+	kind string) ([]*Transition, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	var (
+		transitions []*Transition
+		index       = make(map[string]int)
+	)
+	for _, t := range m.transitions {
+		if t.Kind != kind {
+			continue
+		}
+
+		if i, ok := index[t.ID]; ok {
+			transitions[i] = t
+			continue
+		}
+
+		index[t.ID] = len(transitions)
+		transitions = append(transitions, t)
+	}
+
+	return transitions, nil
+}
+
+// stringCodec encodes string event contexts.
+type stringCodec struct{}
+
+// Encode serializes the event context.
+func (stringCodec) Encode(eventCtx EventContext) ([]byte, error) {
+	str, ok := eventCtx.(string)
+	if !ok {
+		return nil, ErrInvalidContextType
+	}
+
+	return []byte(str), nil
+}
+
+// Decode deserializes the event context.
+func (stringCodec) Decode(_ StateType, data []byte) (EventContext, error) {
+	return string(data), nil
+}
+
+// countingMachine is a persistent test state machine that counts how often
+// the action of the Pending state is executed.
+type countingMachine struct {
+	*StateMachine
+
+	mu           sync.Mutex
+	pendingCalls int
+	contexts     []EventContext
+	pendingEvent EventType
+}
+
+// newCountingMachine creates a new persistent counting machine.
+func newCountingMachine(t *testing.T, store Store,
+	id string) *countingMachine {
+
+	m := &countingMachine{
+		pendingEvent: NoOp,
+	}
+
+	states := States{
+		EmptyState: State{
+			Action: NoOpAction,
+			Transitions: Transitions{
+				"Start": "Pending",
+			},
+		},
+		"Pending": State{
+			Action: m.pendingAction,
+			Transitions: Transitions{
+				"Done": "Finished",
+			},
+		},
+		"Finished": State{
+			Action: NoOpAction,
+		},
+	}
+
+	sm, err := NewPersistentStateMachine(states, &PersistenceConfig{
+		Kind:    "test",
+		ID:      id,
+		Store:   store,
+		Context: context.Background(),
+		Codec:   stringCodec{},
+		Clock:   clock.NewTestClock(testTime),
+	}, 10)
+	require.NoError(t, err)
+
+	m.StateMachine = sm
+
+	return m
+}
+
+// pendingAction records its invocation and returns the configured event.
+func (m *countingMachine) pendingAction(eventCtx EventContext) EventType {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pendingCalls++
+	m.contexts = append(m.contexts, eventCtx)
+
+	return m.pendingEvent
+}
+
+// TestPersistentStateMachine tests that transitions are recorded before the
+// action of the next state is executed.
+func TestPersistentStateMachine(t *testing.T) {
+	_, err := NewPersistentStateMachine(States{}, nil, 0)
+	require.ErrorIs(t, err, ErrMissingStore)
+
+	_, err = NewPersistentStateMachine(States{}, &PersistenceConfig{
+		Store: &mockTransitionStore{},
+	}, 0)
+	require.ErrorIs(t, err, ErrMissingIdentity)
+
+	_, err = NewPersistentStateMachine(States{}, &PersistenceConfig{
+		Kind:  "test",
+		ID:    "1",
+		Store: &mockTransitionStore{},
+	}, 0)
+	require.ErrorIs(t, err, ErrMissingContext)
+
+	store := &mockTransitionStore{}
+	m := newCountingMachine(t, store, "1")
+	m.pendingEvent = "Done"
+
+	err = m.SendEvent("Start", "ctx")
+	require.NoError(t, err)
+	require.Equal(t, StateType("Finished"), m.current)
+
+	transitions, err := store.Transitions(context.Background(), "test", "1")
+	require.NoError(t, err)
+	require.Equal(t, []*Transition{
+		{
+			Kind:          "test",
+			ID:            "1",
+			PreviousState: EmptyState,
+			NextState:     "Pending",
+			Event:         "Start",
+			EventContext:  []byte("ctx"),
+			Timestamp:     testTime,
+		},
+		{
+			Kind:          "test",
+			ID:            "1",
+			PreviousState: "Pending",
+			NextState:     "Finished",
+			Event:         "Done",
+			EventContext:  []byte("ctx"),
+			Timestamp:     testTime,
+		},
+	}, transitions)
+}
+
+// TestPersistentStateMachineStoreError tests that a transition that can't be
+// recorded is not executed.
+func TestPersistentStateMachineStoreError(t *testing.T) {
+	store := &mockTransitionStore{
+		recordErr: errRecord,
+	}
+	m := newCountingMachine(t, store, "1")
+
+	err := m.SendEvent("Start", "ctx")
+	require.ErrorIs(t, err, errRecord)
+	require.Equal(t, EmptyState, m.current)
+	require.Zero(t, m.pendingCalls)
+}
+
+// TestResumeMachines tests that interrupted machines are restored from their
+// last transition and that their action is replayed.
+func TestResumeMachines(t *testing.T) {
+	store := &mockTransitionStore{}
+
+	// Drive one machine into the pending state and one to completion.
+	pending := newCountingMachine(t, store, "pending")
+	require.NoError(t, pending.SendEvent("Start", "resume-me"))
+	require.Equal(t, 1, pending.pendingCalls)
+
+	finished := newCountingMachine(t, store, "finished")
+	finished.pendingEvent = "Done"
+	require.NoError(t, finished.SendEvent("Start", "ignored"))
+
+	// The factory doesn't create a machine for this one, so it is skipped.
+	skipped := newCountingMachine(t, store, "skipped")
+	require.NoError(t, skipped.SendEvent("Start", "skip-me"))
+
+	// Simulate a restart by creating fresh machines from the store.
+	restored := make(map[string]*countingMachine)
+	factory := func(transition *Transition) (*StateMachine, error) {
+		if transition.ID == "skipped" {
+			return nil, nil
+		}
+
+		m := newCountingMachine(t, store, transition.ID)
+		m.pendingEvent = "Done"
+		restored[transition.ID] = m
+
+		return m.StateMachine, nil
+	}
+
+	machines, err := ResumeMachines(
+		context.Background(), store, "test",
+		[]StateType{"Finished"}, factory,
+	)
+	require.NoError(t, err)
+	require.Len(t, machines, 1)
+	require.Len(t, restored, 1)
+
+	m := restored["pending"]
+	require.NotNil(t, m)
+
+	err = m.DefaultObserver.WaitForState(
+		context.Background(), time.Second, "Finished",
+	)
+	require.NoError(t, err)
+
+	m.mu.Lock()
+	require.Equal(t, 1, m.pendingCalls)
+	require.Equal(t, []EventContext{"resume-me"}, m.contexts)
+	m.mu.Unlock()
+
+	// The resumed action's transition is recorded as well.
+	transitions, err := store.LastTransitions(
+		context.Background(), "test",
+	)
+	require.NoError(t, err)
+	for _, transition := range transitions {
+		if transition.ID == "skipped" {
+			require.Equal(t, StateType("Pending"),
+				transition.NextState)
+
+			continue
+		}
+
+		require.Equal(t, StateType("Finished"), transition.NextState)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"sort"
 
+	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/instantout"
 	"github.com/lightninglabs/loop/instantout/reservation"
@@ -57,16 +58,32 @@ func run() error {
 			return err
 		}
 
+	case "loopout":
+		err = writeMermaidFile(fp, loop.LoopOutStates())
+		if err != nil {
+			return err
+		}
+
+	case "loopin":
+		err = writeMermaidFile(fp, loop.LoopInStates())
+		if err != nil {
+			return err
+		}
+
 	default:
 		fmt.Println("Missing or wrong argument: fsm must be one of:")
 		fmt.Println("\treservations")
 		fmt.Println("\texample")
+		fmt.Println("\tloopout")
+		fmt.Println("\tloopin")
 	}
 
 	return nil
 }
 
-func writeMermaidFile(filename string, states fsm.States) error {
+func writeMermaidFile[C any](filename string,
+	states fsm.TypedStates[C]) error {
+
 	f, err := os.Create(filename)
 	if err != nil {
 		return err
@@ -100,7 +117,7 @@ func writeMermaidFile(filename string, states fsm.States) error {
 	return nil
 }
 
-func sortedKeys(m fsm.States) []string {
+func sortedKeys[C any](m fsm.TypedStates[C]) []string {
 	keys := make([]string, len(m))
 	i := 0
 	for k := range m {
@@ -0,0 +1,99 @@
+package fsm
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+)
+
+// Querier is the interface that contains all the queries generated by sqlc
+// for the fsm_transitions table.
+type Querier interface {
+	// InsertFsmTransition inserts a new state machine transition.
+	InsertFsmTransition(ctx context.Context,
+		arg sqlc.InsertFsmTransitionParams) error
+
+	// GetFsmTransitions fetches all transitions of a state machine.
+	GetFsmTransitions(ctx context.Context,
+		arg sqlc.GetFsmTransitionsParams) ([]sqlc.FsmTransition, error)
+
+	// GetLastFsmTransitions fetches the last transition of every state
+	// machine of the given kind.
+	GetLastFsmTransitions(ctx context.Context,
+		machineKind string) ([]sqlc.FsmTransition, error)
+}
+
+// SQLStore stores the transitions of persistent state machines in the
+// database.
+type SQLStore struct {
+	db Querier
+}
+
+// NewSQLStore creates a new SQLStore.
+func NewSQLStore(db Querier) *SQLStore {
+	return &SQLStore{
+		db: db,
+	}
+}
+
+// RecordTransition stores a single transition.
+func (s *SQLStore) RecordTransition(ctx context.Context,
+	transition *Transition) error {
+
+	return s.db.InsertFsmTransition(ctx, sqlc.InsertFsmTransitionParams{
+		MachineKind:         transition.Kind,
+		MachineID:           transition.ID,
+		PreviousState:       string(transition.PreviousState),
+		NextState:           string(transition.NextState),
+		Event:               string(transition.Event),
+		EventContext:        transition.EventContext,
+		TransitionTimestamp: transition.Timestamp.UTC(),
+	})
+}
+
+// Transitions returns all transitions of the given machine in the order they
+// were recorded.
+func (s *SQLStore) Transitions(ctx context.Context, kind,
+	id string) ([]*Transition, error) {
+
+	rows, err := s.db.GetFsmTransitions(ctx, sqlc.GetFsmTransitionsParams{
+		MachineKind: kind,
+		MachineID:   id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlTransitionsToTransitions(rows), nil
+}
+
+// LastTransitions returns the most recent transition of every machine of the
+// given kind.
+func (s *SQLStore) LastTransitions(ctx context.Context,
+	kind string) ([]*Transition, error) {
+
+	rows, err := s.db.GetLastFsmTransitions(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlTransitionsToTransitions(rows), nil
+}
+
+// sqlTransitionsToTransitions converts the database rows to transitions.
+func sqlTransitionsToTransitions(rows []sqlc.FsmTransition) []*Transition {
+	transitions := make([]*Transition, 0, len(rows))
+	for _, row := range rows {
+		transitions = append(transitions, &Transition{
+			Kind:          row.MachineKind,
+			ID:            row.MachineID,
+			PreviousState: StateType(row.PreviousState),
+			NextState:     StateType(row.NextState),
+			Event:         EventType(row.Event),
+			EventContext:  row.EventContext,
+			Timestamp:     row.TransitionTimestamp,
+		})
+	}
+
+	return transitions
+}
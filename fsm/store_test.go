@@ -0,0 +1,72 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSQLStore tests that transitions are stored and fetched from the
+// database.
+func TestSQLStore(t *testing.T) {
+	ctxb := context.Background()
+	db := loopdb.NewTestDB(t)
+	store := NewSQLStore(loopdb.NewTypedStore[Querier](db))
+
+	now := time.Unix(1700000000, 0).UTC()
+	newTransition := func(id string, prev, next StateType,
+		eventCtx []byte) *Transition {
+
+		return &Transition{
+			Kind:          "test",
+			ID:            id,
+			PreviousState: prev,
+			NextState:     next,
+			Event:         "Event",
+			EventContext:  eventCtx,
+			Timestamp:     now,
+		}
+	}
+
+	transitions := []*Transition{
+		newTransition("a", EmptyState, "State1", []byte{1}),
+		newTransition("b", EmptyState, "State1", nil),
+		newTransition("a", "State1", "State2", []byte{2}),
+		{
+			Kind:          "other",
+			ID:            "a",
+			PreviousState: EmptyState,
+			NextState:     "State1",
+			Event:         "Event",
+			Timestamp:     now,
+		},
+	}
+	for _, transition := range transitions {
+		require.NoError(t, store.RecordTransition(ctxb, transition))
+	}
+
+	stored, err := store.Transitions(ctxb, "test", "a")
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+	for i, transition := range stored {
+		transition.Timestamp = transition.Timestamp.UTC()
+		require.Equal(t, transitions[i*2], transition)
+	}
+
+	last, err := store.LastTransitions(ctxb, "test")
+	require.NoError(t, err)
+	require.Len(t, last, 2)
+	require.Equal(t, "b", last[0].ID)
+	require.Equal(t, StateType("State1"), last[0].NextState)
+	require.Nil(t, last[0].EventContext)
+	require.Equal(t, "a", last[1].ID)
+	require.Equal(t, StateType("State2"), last[1].NextState)
+	require.Equal(t, []byte{2}, last[1].EventContext)
+
+	last, err = store.LastTransitions(ctxb, "unknown")
+	require.NoError(t, err)
+	require.Empty(t, last)
+}
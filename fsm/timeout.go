@@ -0,0 +1,209 @@
+package fsm
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+// Timeout describes an event that is sent to the state machine if it is still
+// in the state that declares the timeout after a duration has passed or once
+// a block height has been reached. If both a duration and a height are set,
+// whichever is reached first triggers the event.
+type Timeout struct {
+	// Duration is the time after entering the state at which the event is
+	// sent. It is ignored if zero.
+	Duration time.Duration
+
+	// Height returns the block height at which the event is sent. It is
+	// evaluated when the state is entered and ignored if nil or if it
+	// returns zero.
+	Height func() int32
+
+	// Event is the event that is sent to the state machine. It must be
+	// one of the transitions of the state.
+	Event EventType
+}
+
+// timeoutState tracks the timeouts of the state the machine is currently in.
+type timeoutState[C any] struct {
+	// clock is used to schedule the duration based timeouts.
+	clock clock.Clock
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// entry is incremented every time the state machine enters a state,
+	// so that timeouts of a previous visit of the same state are ignored.
+	entry uint64
+
+	// quit is closed when the state that armed the timers is left.
+	quit chan struct{}
+
+	// bestHeight is the last block height passed to NotifyHeight.
+	bestHeight int32
+
+	// heights are the pending block height timeouts of the current state.
+	heights []heightTimeout
+
+	// eventCtx is the event context the current state was entered with.
+	eventCtx C
+}
+
+// heightTimeout is a pending block height timeout.
+type heightTimeout struct {
+	height int32
+	event  EventType
+}
+
+// SetClock sets the clock that is used to schedule the duration based
+// timeouts of the states. It must be called before the first event is sent.
+// The system clock is used by default.
+func (s *TypedStateMachine[C]) SetClock(clock clock.Clock) {
+	s.timeouts.mu.Lock()
+	defer s.timeouts.mu.Unlock()
+
+	s.timeouts.clock = clock
+}
+
+// NotifyHeight informs the state machine about a new block height. If the
+// current state has a height timeout at or below the height, its event is
+// sent to the state machine.
+func (s *TypedStateMachine[C]) NotifyHeight(height int32) {
+	s.timeouts.mu.Lock()
+	defer s.timeouts.mu.Unlock()
+
+	if height <= s.timeouts.bestHeight {
+		return
+	}
+	s.timeouts.bestHeight = height
+
+	s.fireHeightTimeouts()
+}
+
+// fireHeightTimeouts sends the events of all pending height timeouts that
+// have been reached. The caller must hold the timeout mutex.
+func (s *TypedStateMachine[C]) fireHeightTimeouts() {
+	t := &s.timeouts
+
+	var pending []heightTimeout
+	for _, timeout := range t.heights {
+		if t.bestHeight < timeout.height {
+			pending = append(pending, timeout)
+			continue
+		}
+
+		go s.sendTimeoutEvent(t.entry, timeout.event, t.eventCtx)
+	}
+
+	t.heights = pending
+}
+
+// armTimeouts cancels the timeouts of the previous state and schedules the
+// timeouts of the given state. The caller must hold the state machine mutex.
+func (s *TypedStateMachine[C]) armTimeouts(state TypedState[C], eventCtx C) {
+	t := &s.timeouts
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entry++
+	if t.quit != nil {
+		close(t.quit)
+		t.quit = nil
+	}
+	t.heights = nil
+	t.eventCtx = eventCtx
+
+	if len(state.Timeouts) == 0 {
+		return
+	}
+
+	if t.clock == nil {
+		t.clock = clock.NewDefaultClock()
+	}
+
+	t.quit = make(chan struct{})
+	for _, timeout := range state.Timeouts {
+		if timeout.Duration > 0 {
+			go s.waitForTimeout(
+				t.clock.TickAfter(timeout.Duration), t.quit,
+				t.entry, timeout.Event, eventCtx,
+			)
+		}
+
+		if timeout.Height == nil {
+			continue
+		}
+
+		height := timeout.Height()
+		if height == 0 {
+			continue
+		}
+
+		t.heights = append(t.heights, heightTimeout{
+			height: height,
+			event:  timeout.Event,
+		})
+	}
+
+	// Heights that have already been reached fire right away.
+	if t.bestHeight > 0 {
+		s.fireHeightTimeouts()
+	}
+}
+
+// StopTimeouts cancels all pending timeouts of the current state.
+func (s *TypedStateMachine[C]) StopTimeouts() {
+	t := &s.timeouts
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entry++
+	if t.quit != nil {
+		close(t.quit)
+		t.quit = nil
+	}
+	t.heights = nil
+}
+
+// waitForTimeout sends the event once the timer fires, unless the state that
+// armed the timer is left before.
+func (s *TypedStateMachine[C]) waitForTimeout(timer <-chan time.Time,
+	quit <-chan struct{}, entry uint64, event EventType,
+	eventCtx C) {
+
+	select {
+	case <-timer:
+		s.sendTimeoutEvent(entry, event, eventCtx)
+
+	case <-quit:
+	}
+}
+
+// sendTimeoutEvent sends the timeout event if the state machine is still in
+// the state visit that scheduled the timeout.
+func (s *TypedStateMachine[C]) sendTimeoutEvent(entry uint64, event EventType,
+	eventCtx C) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.timeouts.mu.Lock()
+	current := s.timeouts.entry
+	s.timeouts.mu.Unlock()
+
+	if current != entry {
+		return
+	}
+
+	log.Debugf("Timeout in state %v, sending event %v", s.current, event)
+
+	err := s.sendEvent(event, eventCtx)
+	if err != nil {
+		log.Errorf("Unable to send timeout event %v in state %v: %v",
+			event, s.current, err)
+	}
+}
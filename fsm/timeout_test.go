@@ -0,0 +1,155 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	waitingState = StateType("Waiting")
+	expiredState = StateType("Expired")
+	doneState    = StateType("Done")
+
+	startEvent  = EventType("Start")
+	expireEvent = EventType("Expire")
+	doneEvent   = EventType("Done")
+)
+
+// newTimeoutMachine creates a state machine whose Waiting state expires after
+// a minute or at the given height.
+func newTimeoutMachine(testClock clock.Clock,
+	expiryHeight int32) *StateMachine {
+
+	states := States{
+		EmptyState: State{
+			Action: NoOpAction,
+			Transitions: Transitions{
+				startEvent: waitingState,
+			},
+		},
+		waitingState: State{
+			Action: NoOpAction,
+			Transitions: Transitions{
+				expireEvent: expiredState,
+				doneEvent:   doneState,
+			},
+			Timeouts: []Timeout{
+				{
+					Duration: time.Minute,
+					Height: func() int32 {
+						return expiryHeight
+					},
+					Event: expireEvent,
+				},
+			},
+		},
+		expiredState: State{
+			Action: NoOpAction,
+		},
+		doneState: State{
+			Action: NoOpAction,
+		},
+	}
+
+	s := NewStateMachine(states, 10)
+	s.SetClock(testClock)
+
+	return s
+}
+
+// requireState asserts that the state machine stays in the given state for a
+// short while.
+func requireState(t *testing.T, s *StateMachine, state StateType) {
+	t.Helper()
+
+	// Give a wrongly fired timeout the chance to be processed.
+	time.Sleep(50 * time.Millisecond)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	require.Equal(t, state, s.current)
+}
+
+// TestDurationTimeout tests that the timeout event is sent once the clock
+// passes the timeout duration.
+func TestDurationTimeout(t *testing.T) {
+	now := time.Now()
+	testClock := clock.NewTestClock(now)
+
+	s := newTimeoutMachine(testClock, 0)
+	require.NoError(t, s.SendEvent(startEvent, nil))
+
+	testClock.SetTime(now.Add(30 * time.Second))
+	requireState(t, s, waitingState)
+
+	testClock.SetTime(now.Add(time.Minute))
+	err := s.DefaultObserver.WaitForState(
+		context.Background(), time.Second, expiredState,
+	)
+	require.NoError(t, err)
+}
+
+// TestTimeoutCancelled tests that the timeouts of a state are cancelled once
+// the state is left.
+func TestTimeoutCancelled(t *testing.T) {
+	now := time.Now()
+	testClock := clock.NewTestClock(now)
+
+	s := newTimeoutMachine(testClock, 100)
+	require.NoError(t, s.SendEvent(startEvent, nil))
+	require.NoError(t, s.SendEvent(doneEvent, nil))
+
+	testClock.SetTime(now.Add(time.Hour))
+	s.NotifyHeight(100)
+	requireState(t, s, doneState)
+}
+
+// TestHeightTimeout tests that the timeout event is sent once the expiry
+// height is reached.
+func TestHeightTimeout(t *testing.T) {
+	testClock := clock.NewTestClock(time.Now())
+
+	s := newTimeoutMachine(testClock, 100)
+	s.NotifyHeight(98)
+	require.NoError(t, s.SendEvent(startEvent, nil))
+
+	s.NotifyHeight(99)
+	requireState(t, s, waitingState)
+
+	s.NotifyHeight(100)
+	err := s.DefaultObserver.WaitForState(
+		context.Background(), time.Second, expiredState,
+	)
+	require.NoError(t, err)
+
+	// A machine that enters the state after the expiry height expires
+	// right away.
+	s = newTimeoutMachine(testClock, 100)
+	s.NotifyHeight(150)
+	require.NoError(t, s.SendEvent(startEvent, nil))
+
+	err = s.DefaultObserver.WaitForState(
+		context.Background(), time.Second, expiredState,
+	)
+	require.NoError(t, err)
+}
+
+// TestStopTimeouts tests that stopped timeouts don't fire.
+func TestStopTimeouts(t *testing.T) {
+	now := time.Now()
+	testClock := clock.NewTestClock(now)
+
+	s := newTimeoutMachine(testClock, 100)
+	require.NoError(t, s.SendEvent(startEvent, nil))
+
+	s.StopTimeouts()
+
+	testClock.SetTime(now.Add(time.Hour))
+	s.NotifyHeight(100)
+	requireState(t, s, waitingState)
+}
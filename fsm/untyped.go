@@ -0,0 +1,86 @@
+package fsm
+
+import "context"
+
+// EventContext represents the context to be passed to the action
+// implementation of an untyped state machine.
+type EventContext interface{}
+
+// Action represents the action to be executed in a given state.
+type Action = TypedAction[EventContext]
+
+// State binds a state with an action and a set of events it can handle.
+type State = TypedState[EventContext]
+
+// States represents a mapping of states and their implementations.
+type States = TypedStates[EventContext]
+
+// Notification represents a notification sent to the state machine's
+// observers.
+type Notification = TypedNotification[EventContext]
+
+// Observer is an interface that can be implemented by types that want to
+// observe the state machine.
+type Observer = TypedObserver[EventContext]
+
+// StateMachine represents a state machine whose actions receive untyped event
+// contexts. Actions have to assert the type of their context, so new state
+// machines should prefer TypedStateMachine.
+type StateMachine = TypedStateMachine[EventContext]
+
+// CachedObserver is an observer that caches all states and transitions of
+// the observed state machine.
+type CachedObserver = TypedCachedObserver[EventContext]
+
+// ContextCodec serializes the event contexts of a state machine.
+type ContextCodec = TypedContextCodec[EventContext]
+
+// PersistenceConfig contains the configuration of a persistent state
+// machine.
+type PersistenceConfig = TypedPersistenceConfig[EventContext]
+
+// MachineFactory creates a persistent state machine for the given recorded
+// transition.
+type MachineFactory = TypedMachineFactory[EventContext]
+
+// NewStateMachine creates a new state machine.
+func NewStateMachine(states States, observerSize int) *StateMachine {
+	return NewTypedStateMachine(states, observerSize)
+}
+
+// NewStateMachineWithState creates a new state machine and sets the initial
+// state.
+func NewStateMachineWithState(states States, current StateType,
+	observerSize int) *StateMachine {
+
+	return NewTypedStateMachineWithState(states, current, observerSize)
+}
+
+// NewCachedObserver creates a new cached observer with the given maximum
+// number of cached notifications.
+func NewCachedObserver(maxElements int) *CachedObserver {
+	return NewTypedCachedObserver[EventContext](maxElements)
+}
+
+// NewPersistentStateMachine creates a new persistent state machine, see
+// NewTypedPersistentStateMachine.
+func NewPersistentStateMachine(states States, cfg *PersistenceConfig,
+	observerSize int) (*StateMachine, error) {
+
+	return NewTypedPersistentStateMachine(states, cfg, observerSize)
+}
+
+// ResumeMachines restores and resumes all unfinished machines of the given
+// kind, see ResumeTypedMachines.
+func ResumeMachines(ctx context.Context, store Store, kind string,
+	finalStates []StateType, factory MachineFactory) ([]*StateMachine,
+	error) {
+
+	return ResumeTypedMachines(ctx, store, kind, finalStates, factory)
+}
+
+// NoOpAction is a no-op action that can be used by states that don't need to
+// execute any action.
+func NoOpAction(_ EventContext) EventType {
+	return NoOp
+}
@@ -0,0 +1,379 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/sweep"
+	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// htlcLookupTimeout is the maximum time we wait for lnd to find the
+	// confirmed htlc of a swap that is recovered.
+	htlcLookupTimeout = time.Minute
+)
+
+var (
+	// ErrSwapNotFound is returned if no swap with the given hash exists in
+	// the store.
+	ErrSwapNotFound = errors.New("swap not found")
+
+	// ErrHtlcNotConfirmed is returned if the htlc of a swap that is
+	// recovered couldn't be found on chain.
+	ErrHtlcNotConfirmed = errors.New("htlc not confirmed")
+
+	// ErrHtlcNotExpired is returned if the timeout transaction of a loop in
+	// htlc is published before the htlc expired.
+	ErrHtlcNotExpired = errors.New("htlc not expired yet")
+
+	// ErrHtlcValueTooLow is returned if the htlc value doesn't cover the
+	// fee of the transaction spending it.
+	ErrHtlcValueTooLow = errors.New("htlc value too low to pay fee")
+)
+
+// clientHtlc holds everything that is needed to spend the htlc of a swap on
+// the client's side.
+type clientHtlc struct {
+	// htlc is the htlc of the swap.
+	htlc *swap.Htlc
+
+	// keyDesc describes the client's script key.
+	keyDesc keychain.KeyDescriptor
+
+	// isLoopIn indicates whether the htlc belongs to a loop in swap and
+	// is spent with the timeout path. The htlc of a loop out swap is
+	// spent with the success path.
+	isLoopIn bool
+
+	// contract is the contract of the swap.
+	contract *loopdb.SwapContract
+
+	// htlcTxHash is the hash of the htlc transaction if it is known.
+	htlcTxHash *chainhash.Hash
+
+	// label is the lnd label of the spending transaction.
+	label string
+}
+
+// RecoverHtlc creates and signs a transaction that spends the confirmed htlc
+// of a swap to the client's wallet or the given address. The htlc of a loop
+// in swap is spent with the timeout path, the htlc of a loop out swap with
+// the success path which reveals the preimage of the swap. This is meant as
+// a last resort if the swap can't be completed by loopd anymore.
+func (s *Client) RecoverHtlc(ctx context.Context,
+	req *RecoverHtlcRequest) (*RecoverHtlcResponse, error) {
+
+	if req.SatPerVbyte == 0 {
+		return nil, errors.New("fee rate required")
+	}
+
+	recovered, err := s.recoverHtlc(ctx, req.SwapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	destAddr := req.DestAddr
+	if destAddr == nil {
+		destAddr, err = s.lndServices.WalletKit.NextAddr(
+			ctx, "", walletrpc.AddressType_WITNESS_PUBKEY_HASH,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	info, err := s.lndServices.Client.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	height := int32(info.BlockHeight)
+
+	outpoint, value, err := s.findHtlcOutput(ctx, recovered)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := htlcSpendFee(recovered, destAddr, req.SatPerVbyte)
+	if err != nil {
+		return nil, err
+	}
+	if fee >= value {
+		return nil, ErrHtlcValueTooLow
+	}
+
+	tx, err := createHtlcSpendTx(
+		ctx, s.lndServices.Signer, recovered, *outpoint, value, fee,
+		destAddr, height,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &RecoverHtlcResponse{
+		Tx:  tx,
+		Fee: fee,
+	}
+
+	if !req.Publish {
+		return resp, nil
+	}
+
+	if recovered.isLoopIn && height < recovered.contract.CltvExpiry {
+		return nil, fmt.Errorf("%w: expiry height %v, current height "+
+			"%v", ErrHtlcNotExpired, recovered.contract.CltvExpiry,
+			height)
+	}
+
+	log.Infof("Publishing htlc recovery tx %v of swap %v with fee %v to "+
+		"addr %v", tx.TxHash(), req.SwapHash, fee, destAddr)
+
+	err = s.lndServices.WalletKit.PublishTransaction(
+		ctx, tx, recovered.label,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp.Published = true
+
+	return resp, nil
+}
+
+// recoverHtlc looks up the swap with the given hash and rebuilds its htlc and
+// the client's script key.
+func (s *Client) recoverHtlc(ctx context.Context,
+	hash lntypes.Hash) (*clientHtlc, error) {
+
+	recovered, err := s.findSwapContract(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	recovered.htlc, err = utils.GetHtlc(
+		hash, recovered.contract, s.lndServices.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The client is the sender of a loop in htlc and the receiver of a
+	// loop out htlc.
+	keys := recovered.contract.HtlcKeys
+	scriptKey := keys.ReceiverScriptKey
+	if recovered.isLoopIn {
+		scriptKey = keys.SenderScriptKey
+	}
+
+	keyDesc, err := s.lndServices.WalletKit.DeriveKey(
+		ctx, &keys.ClientScriptKeyLocator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var derivedKey [33]byte
+	copy(derivedKey[:], keyDesc.PubKey.SerializeCompressed())
+	if derivedKey != scriptKey {
+		return nil, fmt.Errorf("derived key %x doesn't match script "+
+			"key %x of the swap", derivedKey, scriptKey)
+	}
+	recovered.keyDesc = *keyDesc
+
+	return recovered, nil
+}
+
+// findSwapContract returns the contract of the loop out or loop in swap with
+// the given hash.
+func (s *Client) findSwapContract(ctx context.Context,
+	hash lntypes.Hash) (*clientHtlc, error) {
+
+	loopOuts, err := s.Store.FetchLoopOutSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, loopOut := range loopOuts {
+		if loopOut.Hash != hash {
+			continue
+		}
+
+		return &clientHtlc{
+			contract:   &loopOut.Contract.SwapContract,
+			htlcTxHash: loopOut.State().HtlcTxHash,
+			label: labels.LoopOutSweepSuccess(
+				swap.ShortHash(&hash),
+			),
+		}, nil
+	}
+
+	loopIns, err := s.Store.FetchLoopInSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, loopIn := range loopIns {
+		if loopIn.Hash != hash {
+			continue
+		}
+
+		return &clientHtlc{
+			isLoopIn:   true,
+			contract:   &loopIn.Contract.SwapContract,
+			htlcTxHash: loopIn.State().HtlcTxHash,
+			label: labels.LoopInSweepTimeout(
+				swap.ShortHash(&hash),
+			),
+		}, nil
+	}
+
+	return nil, ErrSwapNotFound
+}
+
+// findHtlcOutput asks lnd for the confirmed htlc of a swap and returns its
+// outpoint and value.
+func (s *Client) findHtlcOutput(ctx context.Context,
+	recovered *clientHtlc) (*wire.OutPoint, btcutil.Amount, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, htlcLookupTimeout)
+	defer cancel()
+
+	confChan, errChan, err :=
+		s.lndServices.ChainNotifier.RegisterConfirmationsNtfn(
+			ctx, recovered.htlcTxHash, recovered.htlc.PkScript, 1,
+			recovered.contract.InitiationHeight,
+		)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	select {
+	case conf := <-confChan:
+		return swap.GetScriptOutput(conf.Tx, recovered.htlc.PkScript)
+
+	case err := <-errChan:
+		return nil, 0, err
+
+	case <-ctx.Done():
+		return nil, 0, ErrHtlcNotConfirmed
+	}
+}
+
+// htlcSpendFee returns the fee of a transaction that spends the htlc to the
+// given address at the given fee rate.
+func htlcSpendFee(info *clientHtlc, destAddr btcutil.Address,
+	satPerVbyte uint64) (btcutil.Amount, error) {
+
+	var estimator input.TxWeightEstimator
+	err := sweep.AddOutputEstimate(&estimator, destAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	if info.isLoopIn {
+		err = info.htlc.AddTimeoutToEstimator(&estimator)
+	} else {
+		err = info.htlc.AddSuccessToEstimator(&estimator)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	feeRate := chainfee.SatPerKVByte(satPerVbyte * 1000).FeePerKWeight()
+
+	return feeRate.FeeForWeight(estimator.Weight()), nil
+}
+
+// createHtlcSpendTx creates and signs a transaction that spends the htlc of a
+// loop in swap with the timeout path or the htlc of a loop out swap with the
+// success path. The height is only used as lock time of success spends, the
+// lock time of timeout spends is the expiry height of the htlc.
+func createHtlcSpendTx(ctx context.Context, signer lndclient.SignerClient,
+	info *clientHtlc, outpoint wire.OutPoint, value,
+	fee btcutil.Amount, destAddr btcutil.Address,
+	height int32) (*wire.MsgTx, error) {
+
+	htlc := info.htlc
+
+	// The timeout path can only be spent with a lock time at or after
+	// the expiry of the htlc while the success path requires the csv
+	// delay of the htlc.
+	lockTime := uint32(height)
+	sequence := htlc.SuccessSequence()
+	witnessScript := htlc.SuccessScript()
+	witnessFunc := func(sig []byte) (wire.TxWitness, error) {
+		return htlc.GenSuccessWitness(
+			sig, info.contract.Preimage,
+		)
+	}
+
+	if info.isLoopIn {
+		lockTime = uint32(info.contract.CltvExpiry)
+		sequence = 0
+		witnessScript = htlc.TimeoutScript()
+		witnessFunc = htlc.GenTimeoutWitness
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.LockTime = lockTime
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: outpoint,
+		SignatureScript:  htlc.SigScript,
+		Sequence:         sequence,
+	})
+
+	pkScript, err := txscript.PayToAddrScript(destAddr)
+	if err != nil {
+		return nil, err
+	}
+	tx.AddTxOut(&wire.TxOut{
+		PkScript: pkScript,
+		Value:    int64(value - fee),
+	})
+
+	prevOut := &wire.TxOut{
+		Value:    int64(value),
+		PkScript: htlc.PkScript,
+	}
+
+	signDesc := &lndclient.SignDescriptor{
+		WitnessScript: witnessScript,
+		Output:        prevOut,
+		HashType:      htlc.SigHash(),
+		InputIndex:    0,
+		KeyDesc:       info.keyDesc,
+	}
+	if htlc.Version == swap.HtlcV3 {
+		signDesc.SignMethod = input.TaprootScriptSpendSignMethod
+	}
+
+	sigs, err := signer.SignOutputRaw(
+		ctx, tx, []*lndclient.SignDescriptor{signDesc},
+		[]*wire.TxOut{prevOut},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("signing: %w", err)
+	}
+
+	tx.TxIn[0].Witness, err = witnessFunc(sigs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,247 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// newHtlcRecoveryTest returns a client with a mock store that contains a loop
+// in swap with a v3 htlc and a loop out swap with a v2 htlc. The client's
+// script key of both swaps is derived from the given key index.
+func newHtlcRecoveryTest(t *testing.T, keyIndex uint32) (*Client,
+	*test.LndMockServices, *loopdb.StoreMock) {
+
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+
+	_, senderPubKey := test.CreateKey(1)
+	_, receiverPubKey := test.CreateKey(2)
+
+	var senderKey, receiverKey [33]byte
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+
+	htlcKeys := loopdb.HtlcKeys{
+		SenderScriptKey:        senderKey,
+		SenderInternalPubKey:   senderKey,
+		ReceiverScriptKey:      receiverKey,
+		ReceiverInternalPubKey: receiverKey,
+		ClientScriptKeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(swap.KeyFamily),
+			Index:  keyIndex,
+		},
+	}
+
+	loopInHash := testPreimage.Hash()
+	store.LoopInSwaps[loopInHash] = &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			Preimage:         testPreimage,
+			AmountRequested:  100000,
+			CltvExpiry:       744,
+			HtlcKeys:         htlcKeys,
+			InitiationHeight: 500,
+			ProtocolVersion:  loopdb.ProtocolVersionMuSig2,
+		},
+	}
+	store.LoopInUpdates[loopInHash] = []loopdb.SwapStateData{{
+		State:      loopdb.StateHtlcPublished,
+		HtlcTxHash: &chainhash.Hash{1},
+	}}
+
+	loopOutPreimage := testPreimage
+	loopOutPreimage[0]++
+	store.LoopOutSwaps[loopOutPreimage.Hash()] = &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			Preimage:         loopOutPreimage,
+			AmountRequested:  100000,
+			CltvExpiry:       744,
+			HtlcKeys:         htlcKeys,
+			InitiationHeight: 500,
+			ProtocolVersion:  loopdb.ProtocolVersionUnrecorded,
+		},
+	}
+
+	client := &Client{
+		clientConfig: clientConfig{
+			LndServices: &lnd.LndServices,
+			Store:       store,
+		},
+		lndServices: &lnd.LndServices,
+	}
+
+	return client, lnd, store
+}
+
+// confirmHtlc waits for the htlc confirmation registration of a recovery and
+// confirms an htlc transaction of the given value.
+func confirmHtlc(t *testing.T, lnd *test.LndMockServices, htlc *swap.Htlc,
+	value int64) *wire.MsgTx {
+
+	reg := <-lnd.RegisterConfChannel
+	require.Equal(t, htlc.PkScript, reg.PkScript)
+	require.EqualValues(t, 500, reg.HeightHint)
+
+	htlcTx := wire.NewMsgTx(2)
+	htlcTx.AddTxOut(&wire.TxOut{
+		Value:    value,
+		PkScript: htlc.PkScript,
+	})
+	lnd.ConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: htlcTx,
+	}
+
+	return htlcTx
+}
+
+// TestRecoverHtlc tests that the htlcs of loop in and loop out swaps are spent
+// with the timeout and success path respectively.
+func TestRecoverHtlc(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctxb := context.Background()
+
+	type result struct {
+		resp *RecoverHtlcResponse
+		err  error
+	}
+
+	recoverAsync := func(client *Client,
+		req *RecoverHtlcRequest) chan result {
+
+		resultChan := make(chan result, 1)
+		go func() {
+			resp, err := client.RecoverHtlc(ctxb, req)
+			resultChan <- result{resp, err}
+		}()
+
+		return resultChan
+	}
+
+	t.Run("loop in timeout", func(t *testing.T) {
+		client, lnd, store := newHtlcRecoveryTest(t, 1)
+
+		hash := testPreimage.Hash()
+		htlc, err := utils.GetHtlc(
+			hash, &store.LoopInSwaps[hash].SwapContract,
+			lnd.ChainParams,
+		)
+		require.NoError(t, err)
+		require.Equal(t, swap.HtlcV3, htlc.Version)
+
+		resultChan := recoverAsync(client, &RecoverHtlcRequest{
+			SwapHash:    hash,
+			SatPerVbyte: 10,
+		})
+
+		htlcTx := confirmHtlc(t, lnd, htlc, 100000)
+
+		signReq := <-lnd.SignOutputRawChannel
+		signDesc := signReq.SignDescriptors[0]
+		require.Equal(t, htlc.TimeoutScript(), signDesc.WitnessScript)
+		require.Equal(
+			t, input.TaprootScriptSpendSignMethod,
+			signDesc.SignMethod,
+		)
+		require.EqualValues(t, 1, signDesc.KeyDesc.Index)
+
+		res := <-resultChan
+		require.NoError(t, res.err)
+		require.False(t, res.resp.Published)
+
+		tx := res.resp.Tx
+		require.EqualValues(t, 744, tx.LockTime)
+		require.Zero(t, tx.TxIn[0].Sequence)
+		require.Equal(
+			t, htlcTx.TxHash(), tx.TxIn[0].PreviousOutPoint.Hash,
+		)
+		require.EqualValues(
+			t, 100000-res.resp.Fee, tx.TxOut[0].Value,
+		)
+		require.Positive(t, res.resp.Fee)
+
+		// The timeout tx can't be published before the htlc expired.
+		resultChan = recoverAsync(client, &RecoverHtlcRequest{
+			SwapHash:    hash,
+			SatPerVbyte: 10,
+			Publish:     true,
+		})
+
+		confirmHtlc(t, lnd, htlc, 100000)
+		<-lnd.SignOutputRawChannel
+
+		res = <-resultChan
+		require.ErrorIs(t, res.err, ErrHtlcNotExpired)
+	})
+
+	t.Run("loop out success", func(t *testing.T) {
+		client, lnd, store := newHtlcRecoveryTest(t, 2)
+
+		preimage := testPreimage
+		preimage[0]++
+		hash := preimage.Hash()
+		htlc, err := utils.GetHtlc(
+			hash, &store.LoopOutSwaps[hash].SwapContract,
+			lnd.ChainParams,
+		)
+		require.NoError(t, err)
+		require.Equal(t, swap.HtlcV2, htlc.Version)
+
+		resultChan := recoverAsync(client, &RecoverHtlcRequest{
+			SwapHash:    hash,
+			SatPerVbyte: 10,
+			Publish:     true,
+		})
+
+		confirmHtlc(t, lnd, htlc, 100000)
+
+		signReq := <-lnd.SignOutputRawChannel
+		require.Equal(
+			t, htlc.SuccessScript(),
+			signReq.SignDescriptors[0].WitnessScript,
+		)
+
+		published := <-lnd.TxPublishChannel
+
+		res := <-resultChan
+		require.NoError(t, res.err)
+		require.True(t, res.resp.Published)
+		require.Equal(t, published.TxHash(), res.resp.Tx.TxHash())
+		require.EqualValues(t, 600, published.LockTime)
+		require.Equal(
+			t, htlc.SuccessSequence(), published.TxIn[0].Sequence,
+		)
+
+		// The preimage is revealed in the witness.
+		require.Contains(t, published.TxIn[0].Witness, preimage[:])
+	})
+
+	t.Run("key mismatch", func(t *testing.T) {
+		client, _, _ := newHtlcRecoveryTest(t, 3)
+
+		_, err := client.RecoverHtlc(ctxb, &RecoverHtlcRequest{
+			SwapHash:    testPreimage.Hash(),
+			SatPerVbyte: 10,
+		})
+		require.ErrorContains(t, err, "doesn't match script key")
+	})
+
+	t.Run("unknown swap", func(t *testing.T) {
+		client, _, _ := newHtlcRecoveryTest(t, 1)
+
+		_, err := client.RecoverHtlc(ctxb, &RecoverHtlcRequest{
+			SatPerVbyte: 10,
+		})
+		require.ErrorIs(t, err, ErrSwapNotFound)
+	})
+}
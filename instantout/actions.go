@@ -16,6 +16,8 @@ import (
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/swapserverrpc"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -51,16 +53,24 @@ const (
 	// htlcExpiryDelta is the delta in blocks we require between the htlc
 	// expiry and reservation expiry.
 	htlcExpiryDelta = int32(40)
+
+	// sweeplessSweepDelta is the number of blocks before the htlc expiry
+	// at which we stop waiting for the sweepless sweep to confirm and
+	// publish the htlc instead, so that we can sweep it with the preimage
+	// before the server can time it out.
+	sweeplessSweepDelta = int32(20)
 )
 
 // InitInstantOutCtx contains the context for the InitInstantOutAction.
 type InitInstantOutCtx struct {
 	cltvExpiry      int32
 	reservations    []reservation.ID
+	amount          btcutil.Amount
 	initationHeight int32
 	outgoingChanSet loopdb.ChannelSet
 	protocolVersion ProtocolVersion
 	sweepAddress    btcutil.Address
+	sweepOutputs    []SweepOutput
 }
 
 // InitInstantOutAction is the first action that is executed when the instant
@@ -83,7 +93,6 @@ func (f *FSM) InitInstantOutAction(eventCtx fsm.EventContext) fsm.EventType {
 		)
 	)
 
-	// The requested amount needs to be full reservation amounts.
 	for _, reservationId := range initCtx.reservations {
 		resId := reservationId
 		res, err := f.cfg.ReservationManager.GetReservation(
@@ -113,6 +122,62 @@ func (f *FSM) InitInstantOutAction(eventCtx fsm.EventContext) fsm.EventType {
 		}
 	}
 
+	// If no amount is requested, the full value of the reservations is
+	// swapped. Otherwise the remainder goes into a change reservation.
+	swapAmt := initCtx.amount
+	if swapAmt == 0 {
+		swapAmt = btcutil.Amount(reservationAmt)
+	}
+
+	if swapAmt > btcutil.Amount(reservationAmt) {
+		return f.HandleError(fmt.Errorf("amount %v exceeds the "+
+			"reservation value %v", swapAmt, reservationAmt))
+	}
+
+	// The fixed sweep outputs are paid from the swap amount, so they need
+	// to leave a remainder for the sweep address.
+	var sweepOutputsAmt btcutil.Amount
+	for _, output := range initCtx.sweepOutputs {
+		pkScript, err := txscript.PayToAddrScript(output.Address)
+		if err != nil {
+			return f.HandleError(err)
+		}
+
+		dustLimit := lnwallet.DustLimitForSize(len(pkScript))
+		if output.Value < dustLimit {
+			return f.HandleError(fmt.Errorf("sweep output amount "+
+				"%v to %v is below the dust limit %v",
+				output.Value, output.Address, dustLimit))
+		}
+
+		sweepOutputsAmt += output.Value
+	}
+
+	if sweepOutputsAmt >= swapAmt {
+		return f.HandleError(fmt.Errorf("sweep outputs amount %v "+
+			"exceeds the swap amount %v", sweepOutputsAmt, swapAmt))
+	}
+
+	changeAmt := btcutil.Amount(reservationAmt) - swapAmt
+	changeDustLimit := lnwallet.DustLimitForSize(input.P2TRSize)
+	if changeAmt > 0 && changeAmt < changeDustLimit {
+		return f.HandleError(fmt.Errorf("change amount %v is below "+
+			"the dust limit %v", changeAmt, changeDustLimit))
+	}
+
+	// The change reservation is a 2-of-2 between the server and a fresh
+	// reservation key of ours.
+	var changeKeyDesc *keychain.KeyDescriptor
+	if changeAmt > 0 {
+		var err error
+		changeKeyDesc, err = f.cfg.Wallet.DeriveNextKey(
+			f.ctx, reservation.KeyFamily,
+		)
+		if err != nil {
+			return f.HandleError(err)
+		}
+	}
+
 	// Create the preimage for the swap.
 	var preimage lntypes.Preimage
 	if _, err := rand.Read(preimage[:]); err != nil {
@@ -134,17 +199,29 @@ func (f *FSM) InitInstantOutAction(eventCtx fsm.EventContext) fsm.EventType {
 		return f.HandleError(err)
 	}
 
+	protocolVersion := requiredProtocolVersion(
+		changeAmt > 0, initCtx.sweepOutputs,
+	)
+
+	request := &swapserverrpc.InstantLoopOutRequest{
+		ReceiverKey:    keyRes.PubKey.SerializeCompressed(),
+		SwapHash:       swapHash[:],
+		Expiry:         initCtx.cltvExpiry,
+		HtlcFeeRate:    uint64(feeRate),
+		ReservationIds: reservationIds,
+		ProtocolVersion: swapserverrpc.InstantOutProtocolVersion(
+			protocolVersion,
+		),
+		Amount: uint64(swapAmt),
+	}
+	if changeKeyDesc != nil {
+		request.ChangeClientKey = changeKeyDesc.PubKey.
+			SerializeCompressed()
+	}
+
 	// Send the instantout request to the server.
 	instantOutResponse, err := f.cfg.InstantOutClient.RequestInstantLoopOut(
-		f.ctx,
-		&swapserverrpc.InstantLoopOutRequest{
-			ReceiverKey:     keyRes.PubKey.SerializeCompressed(),
-			SwapHash:        swapHash[:],
-			Expiry:          initCtx.cltvExpiry,
-			HtlcFeeRate:     uint64(feeRate),
-			ReservationIds:  reservationIds,
-			ProtocolVersion: CurrentRpcProtocolVersion(),
-		},
+		f.ctx, request,
 	)
 	if err != nil {
 		return f.HandleError(err)
@@ -166,6 +243,20 @@ func (f *FSM) InitInstantOutAction(eventCtx fsm.EventContext) fsm.EventType {
 		return f.HandleError(err)
 	}
 
+	// If we requested a change reservation, the server needs to tell us
+	// about its side of it.
+	var changeReservation *reservation.Reservation
+	if changeKeyDesc != nil {
+		changeReservation, err = parseChangeReservation(
+			instantOutResponse, changeKeyDesc, changeAmt,
+			initCtx.initationHeight,
+			uint32(initCtx.cltvExpiry+htlcExpiryDelta),
+		)
+		if err != nil {
+			return f.HandleError(err)
+		}
+	}
+
 	// Create the address that we'll send the funds to.
 	sweepAddress := initCtx.sweepAddress
 	if sweepAddress == nil {
@@ -180,20 +271,22 @@ func (f *FSM) InitInstantOutAction(eventCtx fsm.EventContext) fsm.EventType {
 
 	// Now we can create the instant out.
 	instantOut := &InstantOut{
-		SwapHash:         swapHash,
-		swapPreimage:     preimage,
-		protocolVersion:  ProtocolVersionFullReservation,
-		initiationHeight: initCtx.initationHeight,
-		outgoingChanSet:  initCtx.outgoingChanSet,
-		CltvExpiry:       initCtx.cltvExpiry,
-		clientPubkey:     keyRes.PubKey,
-		serverPubkey:     serverPubkey,
-		Value:            btcutil.Amount(reservationAmt),
-		htlcFeeRate:      feeRate,
-		swapInvoice:      instantOutResponse.SwapInvoice,
-		Reservations:     reservations,
-		keyLocator:       keyRes.KeyLocator,
-		sweepAddress:     sweepAddress,
+		SwapHash:          swapHash,
+		swapPreimage:      preimage,
+		protocolVersion:   protocolVersion,
+		initiationHeight:  initCtx.initationHeight,
+		outgoingChanSet:   initCtx.outgoingChanSet,
+		CltvExpiry:        initCtx.cltvExpiry,
+		clientPubkey:      keyRes.PubKey,
+		serverPubkey:      serverPubkey,
+		Value:             swapAmt,
+		htlcFeeRate:       feeRate,
+		swapInvoice:       instantOutResponse.SwapInvoice,
+		Reservations:      reservations,
+		ChangeReservation: changeReservation,
+		keyLocator:        keyRes.KeyLocator,
+		sweepAddress:      sweepAddress,
+		sweepOutputs:      initCtx.sweepOutputs,
 	}
 
 	err = f.cfg.Store.CreateInstantLoopOut(f.ctx, instantOut)
@@ -206,9 +299,71 @@ func (f *FSM) InitInstantOutAction(eventCtx fsm.EventContext) fsm.EventType {
 	return OnInit
 }
 
+// toRpcSweepOutputs converts the sweep outputs of an instant out to their rpc
+// representation.
+func toRpcSweepOutputs(
+	outputs []SweepOutput) []*swapserverrpc.InstantOutSweepOutput {
+
+	rpcOutputs := make(
+		[]*swapserverrpc.InstantOutSweepOutput, 0, len(outputs),
+	)
+	for _, output := range outputs {
+		rpcOutputs = append(
+			rpcOutputs, &swapserverrpc.InstantOutSweepOutput{
+				Address: output.Address.String(),
+				Amount:  uint64(output.Value),
+			},
+		)
+	}
+
+	return rpcOutputs
+}
+
+// parseChangeReservation creates the change reservation of an instant out from
+// the server's response. Just like the reservations that are swapped, the
+// change reservation needs to expire at least at minExpiry and must not lock
+// our funds up for longer than any other reservation.
+func parseChangeReservation(res *swapserverrpc.InstantLoopOutResponse,
+	clientKeyDesc *keychain.KeyDescriptor, value btcutil.Amount,
+	heightHint int32, minExpiry uint32) (*reservation.Reservation, error) {
+
+	if len(res.ChangeReservationId) == 0 {
+		return nil, errors.New("server didn't provide a change " +
+			"reservation")
+	}
+
+	err := reservation.ValidateExpiry(
+		res.ChangeExpiry, minExpiry, heightHint,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid change reservation: %w", err)
+	}
+
+	var id reservation.ID
+	err = id.FromByteSlice(res.ChangeReservationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverKey, err := btcec.ParsePubKey(res.ChangeServerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation.NewReservation(
+		id, serverKey, clientKeyDesc.PubKey, value, res.ChangeExpiry,
+		uint32(heightHint), clientKeyDesc.KeyLocator,
+	)
+}
+
 // PollPaymentAcceptedAction locks the reservations, sends the payment to the
 // server and polls the server for the payment status.
 func (f *FSM) PollPaymentAcceptedAction(_ fsm.EventContext) fsm.EventType {
+	// The swap might have been cancelled while it was initialized.
+	if f.isCancelRequested() {
+		return f.cancelInstantOut()
+	}
+
 	// Now that we're doing the swap, we first lock the reservations
 	// so that they can't be used for other swaps.
 	for _, reservation := range f.InstantOut.Reservations {
@@ -220,9 +375,14 @@ func (f *FSM) PollPaymentAcceptedAction(_ fsm.EventContext) fsm.EventType {
 		}
 	}
 
-	// Now we send the payment to the server.
+	// Now we send the payment to the server. The payment is tracked with
+	// its own context, so that we stop tracking it once we leave this
+	// action, for example because the swap was cancelled.
+	payCtx, payCancel := context.WithCancel(f.ctx)
+	defer payCancel()
+
 	payChan, paymentErrChan, err := f.cfg.RouterClient.SendPayment(
-		f.ctx,
+		payCtx,
 		lndclient.SendPaymentRequest{
 			Invoice:  f.InstantOut.swapInvoice,
 			Timeout:  defaultSendpaymentTimeout,
@@ -257,6 +417,13 @@ func (f *FSM) PollPaymentAcceptedAction(_ fsm.EventContext) fsm.EventType {
 		case <-f.ctx.Done():
 			return f.handleErrorAndUnlockReservations(nil)
 
+		case <-f.cancelChan:
+			// Stop tracking the payment before the server fails
+			// it.
+			payCancel()
+
+			return f.cancelInstantOut()
+
 		case <-timer.C:
 			res, err := f.cfg.InstantOutClient.PollPaymentAccepted(
 				f.ctx,
@@ -281,6 +448,10 @@ func (f *FSM) PollPaymentAcceptedAction(_ fsm.EventContext) fsm.EventType {
 // BuildHTLCAction creates the htlc transaction, exchanges nonces with
 // the server and sends the htlc signatures to the server.
 func (f *FSM) BuildHTLCAction(eventCtx fsm.EventContext) fsm.EventType {
+	if f.isCancelRequested() {
+		return f.cancelInstantOut()
+	}
+
 	htlcSessions, htlcClientNonces, err := f.InstantOut.createMusig2Session(
 		f.ctx, f.cfg.Signer,
 	)
@@ -329,6 +500,13 @@ func (f *FSM) BuildHTLCAction(eventCtx fsm.EventContext) fsm.EventType {
 		return f.handleErrorAndUnlockReservations(err)
 	}
 
+	// Once the server has our htlc signatures, it can publish the htlc
+	// tx, so the swap can no longer be cancelled. A cancellation that was
+	// requested in the meantime is still honored.
+	if !f.disableCancel() {
+		return f.cancelInstantOut()
+	}
+
 	// Send the server the htlc signatures.
 	htlcRes, err := f.cfg.InstantOutClient.PushHtlcSig(
 		f.ctx,
@@ -360,6 +538,18 @@ func (f *FSM) BuildHTLCAction(eventCtx fsm.EventContext) fsm.EventType {
 // it publishes the sweepless sweep transaction. If any of the steps after
 // pushing the preimage fail, the htlc timeout transaction will be published.
 func (f *FSM) PushPreimageAction(eventCtx fsm.EventContext) fsm.EventType {
+	// From here on either the sweepless sweep or the htlc tx will be
+	// published, so we start tracking the change reservation that both of
+	// them create.
+	if f.InstantOut.ChangeReservation != nil {
+		err := f.cfg.ReservationManager.AddChangeReservation(
+			f.ctx, f.InstantOut.ChangeReservation,
+		)
+		if err != nil {
+			return f.handleErrorAndUnlockReservations(err)
+		}
+	}
+
 	// First we'll create the musig2 context.
 	coopSessions, coopClientNonces, err := f.InstantOut.createMusig2Session(
 		f.ctx, f.cfg.Signer,
@@ -383,6 +573,9 @@ func (f *FSM) PushPreimageAction(eventCtx fsm.EventContext) fsm.EventType {
 			ClientNonces:    coopClientNonces,
 			ClientSweepAddr: f.InstantOut.sweepAddress.String(),
 			MusigTxFeeRate:  uint64(feeRate),
+			ClientSweepOutputs: toRpcSweepOutputs(
+				f.InstantOut.sweepOutputs,
+			),
 		},
 	)
 	// Now that we have revealed the preimage, if any following step fail,
@@ -445,7 +638,8 @@ func (f *FSM) PushPreimageAction(eventCtx fsm.EventContext) fsm.EventType {
 }
 
 // WaitForSweeplessSweepConfirmedAction waits for the sweepless sweep
-// transaction to be confirmed.
+// transaction to be confirmed. This is non-blocking, so that the timeout of
+// the state can publish the htlc if the sweep doesn't confirm in time.
 func (f *FSM) WaitForSweeplessSweepConfirmedAction(
 	eventCtx fsm.EventContext) fsm.EventType {
 
@@ -463,22 +657,39 @@ func (f *FSM) WaitForSweeplessSweepConfirmedAction(
 		return f.HandleError(err)
 	}
 
-	for {
+	go func() {
+		var event fsm.EventType
 		select {
 		case spendErr := <-confErrChan:
 			f.LastActionError = spendErr
 			f.Errorf("error listening for sweepless sweep "+
 				"confirmation: %v", spendErr)
 
-			return OnErrorPublishHtlc
+			event = OnErrorPublishHtlc
 
 		case conf := <-confChan:
 			f.InstantOut.
 				sweepConfirmationHeight = conf.BlockHeight
 
-			return OnSweeplessSweepConfirmed
+			event = OnSweeplessSweepConfirmed
+
+		case <-f.ctx.Done():
+			return
 		}
-	}
+
+		err := f.SendEvent(event, nil)
+		if err != nil {
+			f.Errorf("Error sending %s event: %v", event, err)
+		}
+	}()
+
+	return fsm.NoOp
+}
+
+// sweeplessSweepDeadline returns the height at which we stop waiting for the
+// sweepless sweep to confirm.
+func (f *FSM) sweeplessSweepDeadline() int32 {
+	return f.InstantOut.CltvExpiry - sweeplessSweepDelta
 }
 
 // PublishHtlcAction publishes the htlc transaction and the htlc sweep
@@ -513,6 +724,9 @@ func (f *FSM) PublishHtlcAction(eventCtx fsm.EventContext) fsm.EventType {
 
 		case <-confChan:
 			return OnHtlcPublished
+
+		case <-f.ctx.Done():
+			return fsm.NoOp
 		}
 	}
 }
@@ -630,6 +844,41 @@ func (f *FSM) handleErrorAndUnlockReservations(err error) fsm.EventType {
 	return f.HandleError(err)
 }
 
+// cancelInstantOut cancels the swap with the server, which fails the pending
+// swap payment and releases the reservations on the server side. It then
+// unlocks the reservations on our side.
+func (f *FSM) cancelInstantOut() fsm.EventType {
+	f.Infof("cancelling instant out")
+
+	ctx, cancel := context.WithTimeout(f.ctx, time.Second*30)
+	defer cancel()
+
+	_, err := f.cfg.InstantOutClient.CancelInstantSwap(
+		ctx, &swapserverrpc.CancelInstantSwapRequest{
+			SwapHash: f.InstantOut.SwapHash[:],
+		},
+	)
+	if err != nil {
+		// The preimage was never revealed, so the server can't claim
+		// the payment anyway.
+		f.Errorf("error sending cancel message: %v", err)
+	}
+
+	// Unlock the reservations. Depending on when the swap was cancelled,
+	// they might not have been locked yet.
+	for _, reservation := range f.InstantOut.Reservations {
+		err := f.cfg.ReservationManager.UnlockReservation(
+			ctx, reservation.ID,
+		)
+		if err != nil {
+			f.Debugf("error unlocking reservation %x: %v",
+				reservation.ID, err)
+		}
+	}
+
+	return OnCancel
+}
+
 func getMaxRoutingFee(amt btcutil.Amount) btcutil.Amount {
 	return swap.CalcFee(amt, maxRoutingFeeBase, maxRoutingFeeRate)
 }
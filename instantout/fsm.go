@@ -3,6 +3,7 @@ package instantout
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightninglabs/lndclient"
@@ -20,11 +21,38 @@ const (
 	// ProtocolVersionFullReservation is the protocol version that uses
 	// the full reservation amount without change.
 	ProtocolVersionFullReservation ProtocolVersion = 1
+
+	// ProtocolVersionChangeReservation is the protocol version that allows
+	// swapping a part of the reservation amount. The remainder is returned
+	// into a new change reservation.
+	ProtocolVersionChangeReservation ProtocolVersion = 2
+
+	// ProtocolVersionMultiSweepOutputs is the protocol version that allows
+	// the sweep of the swap to pay fixed amounts to additional outputs.
+	ProtocolVersionMultiSweepOutputs ProtocolVersion = 3
 )
 
 // CurrentProtocolVersion returns the current protocol version.
 func CurrentProtocolVersion() ProtocolVersion {
-	return ProtocolVersionFullReservation
+	return ProtocolVersionMultiSweepOutputs
+}
+
+// requiredProtocolVersion returns the lowest protocol version that supports
+// an instant out with the given properties, so that the swap doesn't claim
+// features it doesn't use.
+func requiredProtocolVersion(hasChange bool,
+	sweepOutputs []SweepOutput) ProtocolVersion {
+
+	switch {
+	case len(sweepOutputs) > 0:
+		return ProtocolVersionMultiSweepOutputs
+
+	case hasChange:
+		return ProtocolVersionChangeReservation
+
+	default:
+		return ProtocolVersionFullReservation
+	}
 }
 
 // CurrentRpcProtocolVersion returns the current rpc protocol version.
@@ -41,6 +69,11 @@ var (
 	ErrProtocolVersionNotSupported = errors.New(
 		"protocol version not supported",
 	)
+
+	// ErrNotCancellable is returned when the cancellation of an instant
+	// out is requested after the preimage was pushed to the server.
+	ErrNotCancellable = errors.New("instant out can no longer be " +
+		"cancelled")
 )
 
 // States.
@@ -87,6 +120,10 @@ var (
 
 	// Failed is the state where the swap failed.
 	Failed = fsm.StateType("InstantOutFailed")
+
+	// Cancelled is the state where the swap was cancelled by the client
+	// before the preimage was pushed to the server.
+	Cancelled = fsm.StateType("InstantOutCancelled")
 )
 
 // Events.
@@ -140,6 +177,10 @@ var (
 	// OnRecover is the event that is triggered when the FSM recovers from
 	// a restart.
 	OnRecover = fsm.EventType("OnRecover")
+
+	// OnCancel is the event that is triggered when the swap has been
+	// cancelled on request of the client.
+	OnCancel = fsm.EventType("OnCancel")
 )
 
 // Config contains the services required for the instant out FSM.
@@ -192,6 +233,20 @@ type FSM struct {
 	// sweeplessSweepSessions contains all the reservations input musig2
 	// sessions that will be used for the sweepless sweep transaction.
 	sweeplessSweepSessions []*input.MuSig2SessionInfo
+
+	// cancellable is true as long as the swap can be cancelled, which is
+	// until the htlc signatures are sent to the server.
+	cancellable bool
+
+	// cancelRequested is true once the client requested to cancel the
+	// swap.
+	cancelRequested bool
+
+	// cancelChan is closed once the client requested to cancel the swap.
+	cancelChan chan struct{}
+
+	// cancelMtx guards cancellable and cancelRequested.
+	cancelMtx sync.Mutex
 }
 
 // NewFSM creates a new instant out FSM.
@@ -212,12 +267,16 @@ func NewFSMFromInstantOut(ctx context.Context, cfg *Config,
 	instantOut *InstantOut) (*FSM, error) {
 
 	instantOutFSM := &FSM{
-		ctx:        ctx,
-		cfg:        cfg,
-		InstantOut: instantOut,
+		ctx:         ctx,
+		cfg:         cfg,
+		InstantOut:  instantOut,
+		cancellable: isCancellableState(instantOut.State),
+		cancelChan:  make(chan struct{}),
 	}
 	switch instantOut.protocolVersion {
-	case ProtocolVersionFullReservation:
+	case ProtocolVersionFullReservation, ProtocolVersionChangeReservation,
+		ProtocolVersionMultiSweepOutputs:
+
 		instantOutFSM.StateMachine = fsm.NewStateMachineWithState(
 			instantOutFSM.GetV1ReservationStates(),
 			instantOut.State, defaultObserverSize,
@@ -254,6 +313,7 @@ func (f *FSM) GetV1ReservationStates() fsm.States {
 				OnPaymentAccepted: BuildHtlc,
 				fsm.OnError:       Failed,
 				OnRecover:         Failed,
+				OnCancel:          Cancelled,
 			},
 			Action: f.PollPaymentAcceptedAction,
 		},
@@ -262,6 +322,7 @@ func (f *FSM) GetV1ReservationStates() fsm.States {
 				OnHtlcSigReceived: PushPreimage,
 				fsm.OnError:       Failed,
 				OnRecover:         Failed,
+				OnCancel:          Cancelled,
 			},
 			Action: f.BuildHTLCAction,
 		},
@@ -271,6 +332,7 @@ func (f *FSM) GetV1ReservationStates() fsm.States {
 				fsm.OnError:               Failed,
 				OnErrorPublishHtlc:        PublishHtlc,
 				OnRecover:                 PushPreimage,
+				OnCancel:                  Cancelled,
 			},
 			Action: f.PushPreimageAction,
 		},
@@ -278,8 +340,13 @@ func (f *FSM) GetV1ReservationStates() fsm.States {
 			Transitions: fsm.Transitions{
 				OnSweeplessSweepConfirmed: FinishedSweeplessSweep,
 				OnRecover:                 WaitForSweeplessSweepConfirmed,
+				OnErrorPublishHtlc:        PublishHtlc,
 				fsm.OnError:               PublishHtlc,
 			},
+			Timeouts: []fsm.Timeout{{
+				Height: f.sweeplessSweepDeadline,
+				Event:  OnErrorPublishHtlc,
+			}},
 			Action: f.WaitForSweeplessSweepConfirmedAction,
 		},
 		FinishedSweeplessSweep: fsm.State{
@@ -323,6 +390,9 @@ func (f *FSM) GetV1ReservationStates() fsm.States {
 		Failed: fsm.State{
 			Action: fsm.NoOpAction,
 		},
+		Cancelled: fsm.State{
+			Action: fsm.NoOpAction,
+		},
 	}
 }
 
@@ -339,6 +409,11 @@ func (f *FSM) updateInstantOut(notification fsm.Notification) {
 
 	f.InstantOut.State = notification.NextState
 
+	// Once the htlc is signed, the swap can no longer be cancelled.
+	f.cancelMtx.Lock()
+	f.cancellable = isCancellableState(notification.NextState)
+	f.cancelMtx.Unlock()
+
 	// If we're in the early stages we don't have created the reservation
 	// in the store yet and won't need to update it.
 	if f.InstantOut.State == Init ||
@@ -393,9 +468,63 @@ func (f *FSM) Errorf(format string, args ...interface{}) {
 func isFinalState(state fsm.StateType) bool {
 	switch state {
 	case Failed, FinishedHtlcPreimageSweep,
-		FinishedSweeplessSweep:
+		FinishedSweeplessSweep, Cancelled:
+
+		return true
+	}
+	return false
+}
 
+// isCancellableState returns true if a swap in the given state can still be
+// cancelled. A swap in the BuildHtlc state can only be cancelled until its
+// htlc signatures are sent to the server, see disableCancel.
+func isCancellableState(state fsm.StateType) bool {
+	switch state {
+	case fsm.EmptyState, Init, SendPaymentAndPollAccepted, BuildHtlc:
 		return true
 	}
+
 	return false
 }
+
+// requestCancel requests the cancellation of the swap. The running action
+// picks up the request and cancels the swap before the preimage is pushed.
+func (f *FSM) requestCancel() error {
+	f.cancelMtx.Lock()
+	defer f.cancelMtx.Unlock()
+
+	if !f.cancellable {
+		return ErrNotCancellable
+	}
+
+	if !f.cancelRequested {
+		f.cancelRequested = true
+		close(f.cancelChan)
+	}
+
+	return nil
+}
+
+// disableCancel prevents any further cancellation of the swap. It returns
+// false if the cancellation of the swap was already requested, in which case
+// the swap needs to be cancelled instead.
+func (f *FSM) disableCancel() bool {
+	f.cancelMtx.Lock()
+	defer f.cancelMtx.Unlock()
+
+	if f.cancelRequested {
+		return false
+	}
+
+	f.cancellable = false
+
+	return true
+}
+
+// isCancelRequested returns true if the client requested to cancel the swap.
+func (f *FSM) isCancelRequested() bool {
+	f.cancelMtx.Lock()
+	defer f.cancelMtx.Unlock()
+
+	return f.cancelRequested
+}
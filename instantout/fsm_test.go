@@ -0,0 +1,296 @@
+package instantout
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
+	"github.com/lightninglabs/loop/instantout/reservation"
+	"github.com/lightninglabs/loop/swapserverrpc"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// mockInstantOutStore is a store that accepts all updates.
+type mockInstantOutStore struct {
+	InstantLoopOutStore
+}
+
+// UpdateInstantLoopOut implements the InstantLoopOutStore interface.
+func (m *mockInstantOutStore) UpdateInstantLoopOut(_ context.Context,
+	_ *InstantOut) error {
+
+	return nil
+}
+
+// mockInstantOutClient records the cancelled swaps.
+type mockInstantOutClient struct {
+	swapserverrpc.InstantSwapServerClient
+
+	cancelled []lntypes.Hash
+	sync.Mutex
+}
+
+// CancelInstantSwap implements the InstantSwapServerClient interface.
+func (m *mockInstantOutClient) CancelInstantSwap(_ context.Context,
+	req *swapserverrpc.CancelInstantSwapRequest, _ ...grpc.CallOption) (
+	*swapserverrpc.CancelInstantSwapResponse, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	hash, err := lntypes.MakeHash(req.SwapHash)
+	if err != nil {
+		return nil, err
+	}
+	m.cancelled = append(m.cancelled, hash)
+
+	return &swapserverrpc.CancelInstantSwapResponse{}, nil
+}
+
+// GetInstantOutQuote implements the InstantSwapServerClient interface.
+func (m *mockInstantOutClient) GetInstantOutQuote(_ context.Context,
+	_ *swapserverrpc.GetInstantOutQuoteRequest, _ ...grpc.CallOption) (
+	*swapserverrpc.GetInstantOutQuoteResponse, error) {
+
+	return &swapserverrpc.GetInstantOutQuoteResponse{SwapFee: 1000}, nil
+}
+
+// mockReservationManager records the unlocked reservations.
+type mockReservationManager struct {
+	ReservationManager
+
+	unlocked []reservation.ID
+	sync.Mutex
+}
+
+// LockReservation implements the ReservationManager interface.
+func (m *mockReservationManager) LockReservation(_ context.Context,
+	_ reservation.ID) error {
+
+	return nil
+}
+
+// mockRouterClient hands out the context of the payment that is sent.
+type mockRouterClient struct {
+	lndclient.RouterClient
+
+	payCtxChan chan context.Context
+}
+
+// SendPayment implements the RouterClient interface.
+func (m *mockRouterClient) SendPayment(ctx context.Context,
+	_ lndclient.SendPaymentRequest) (chan lndclient.PaymentStatus,
+	chan error, error) {
+
+	m.payCtxChan <- ctx
+
+	return make(chan lndclient.PaymentStatus), make(chan error), nil
+}
+
+// UnlockReservation implements the ReservationManager interface.
+func (m *mockReservationManager) UnlockReservation(_ context.Context,
+	id reservation.ID) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.unlocked = append(m.unlocked, id)
+
+	return nil
+}
+
+// TestCancelInstantOut tests that an instant out can be cancelled until its
+// htlc signatures are sent to the server and that a cancelled swap is cancelled with
+// the server and unlocks its reservations.
+func TestCancelInstantOut(t *testing.T) {
+	swapHash := lntypes.Hash{1}
+	newTestFSM := func(state fsm.StateType) (*FSM, *mockInstantOutClient,
+		*mockReservationManager) {
+
+		instantOutClient := &mockInstantOutClient{}
+		reservationManager := &mockReservationManager{}
+		cfg := &Config{
+			Store:              &mockInstantOutStore{},
+			InstantOutClient:   instantOutClient,
+			ReservationManager: reservationManager,
+			RouterClient: &mockRouterClient{
+				payCtxChan: make(chan context.Context, 1),
+			},
+		}
+
+		instantOut := &InstantOut{
+			SwapHash: swapHash,
+			State:    state,
+			Reservations: []*reservation.Reservation{
+				newTestReservation(t, 1, 100_000),
+				newTestReservation(t, 2, 100_000),
+			},
+			protocolVersion: CurrentProtocolVersion(),
+		}
+
+		instantOutFSM, err := NewFSMFromInstantOut(
+			context.Background(), cfg, instantOut,
+		)
+		require.NoError(t, err)
+
+		return instantOutFSM, instantOutClient, reservationManager
+	}
+
+	// A swap whose payment was accepted is cancelled before the htlc is
+	// built.
+	instantOutFSM, client, reservations := newTestFSM(SendPaymentAndPollAccepted)
+	require.NoError(t, instantOutFSM.requestCancel())
+
+	err := instantOutFSM.SendEvent(OnPaymentAccepted, nil)
+	require.NoError(t, err)
+	require.Equal(t, Cancelled, instantOutFSM.InstantOut.State)
+	require.Equal(t, []lntypes.Hash{swapHash}, client.cancelled)
+	require.Equal(t, []reservation.ID{{1}, {2}}, reservations.unlocked)
+
+	// A cancelled swap can't be cancelled again.
+	require.ErrorIs(t, instantOutFSM.requestCancel(), ErrNotCancellable)
+
+	// Cancelling a swap while its payment is in flight stops tracking
+	// the payment.
+	instantOutFSM, client, _ = newTestFSM(SendPaymentAndPollAccepted)
+	eventChan := make(chan fsm.EventType, 1)
+	go func() {
+		eventChan <- instantOutFSM.PollPaymentAcceptedAction(nil)
+	}()
+
+	payCtx := <-instantOutFSM.cfg.RouterClient.(*mockRouterClient).payCtxChan
+	require.NoError(t, payCtx.Err())
+	require.NoError(t, instantOutFSM.requestCancel())
+	require.Equal(t, OnCancel, <-eventChan)
+	require.ErrorIs(t, payCtx.Err(), context.Canceled)
+	require.Equal(t, []lntypes.Hash{swapHash}, client.cancelled)
+
+	// While the htlc is built, the swap can only be cancelled until the
+	// htlc signatures are sent to the server.
+	instantOutFSM, _, _ = newTestFSM(BuildHtlc)
+	require.True(t, instantOutFSM.disableCancel())
+	require.ErrorIs(t, instantOutFSM.requestCancel(), ErrNotCancellable)
+
+	instantOutFSM, _, _ = newTestFSM(BuildHtlc)
+	require.NoError(t, instantOutFSM.requestCancel())
+	require.False(t, instantOutFSM.disableCancel())
+
+	// Once the htlc is signed, the swap can no longer be cancelled.
+	instantOutFSM, _, _ = newTestFSM(PushPreimage)
+	require.ErrorIs(t, instantOutFSM.requestCancel(), ErrNotCancellable)
+
+	instantOutFSM, _, _ = newTestFSM(WaitForSweeplessSweepConfirmed)
+	require.ErrorIs(t, instantOutFSM.requestCancel(), ErrNotCancellable)
+}
+
+// TestSweeplessSweepTimeout tests that the htlc is published if the sweepless
+// sweep doesn't confirm before the deadline ahead of the htlc expiry.
+func TestSweeplessSweepTimeout(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockLnd := test.NewMockLnd()
+	cfg := &Config{
+		Store:         &mockInstantOutStore{},
+		ChainNotifier: mockLnd.ChainNotifier,
+		Wallet:        mockLnd.WalletKit,
+	}
+
+	sweepAddr, err := btcutil.NewAddressTaproot(
+		make([]byte, 32), &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+
+	htlcTx := &wire.MsgTx{
+		TxOut: []*wire.TxOut{{
+			Value:    100_000,
+			PkScript: []byte{txscript.OP_TRUE},
+		}},
+	}
+	sweepTxHash := chainhash.Hash{1}
+
+	const cltvExpiry = 700
+	instantOut := &InstantOut{
+		SwapHash:        lntypes.Hash{1},
+		State:           WaitForSweeplessSweepConfirmed,
+		CltvExpiry:      cltvExpiry,
+		SweepTxHash:     &sweepTxHash,
+		sweepAddress:    sweepAddr,
+		finalizedHtlcTx: htlcTx,
+		protocolVersion: CurrentProtocolVersion(),
+	}
+
+	instantOutFSM, err := NewFSMFromInstantOut(ctx, cfg, instantOut)
+	require.NoError(t, err)
+
+	go func() {
+		err := instantOutFSM.SendEvent(OnRecover, nil)
+		require.NoError(t, err)
+	}()
+
+	confReg := <-mockLnd.RegisterConfChannel
+	require.Equal(t, sweepTxHash, *confReg.TxID)
+
+	// Before the deadline, we keep waiting for the sweepless sweep.
+	instantOutFSM.NotifyHeight(cltvExpiry - sweeplessSweepDelta - 1)
+	require.Equal(
+		t, WaitForSweeplessSweepConfirmed,
+		instantOutFSM.CurrentState(),
+	)
+
+	// Once the deadline is reached, the htlc is published.
+	instantOutFSM.NotifyHeight(cltvExpiry - sweeplessSweepDelta)
+
+	publishedTx := <-mockLnd.TxPublishChannel
+	require.Equal(t, htlcTx.TxHash(), publishedTx.TxHash())
+
+	confReg = <-mockLnd.RegisterConfChannel
+	require.Equal(t, htlcTx.TxHash(), *confReg.TxID)
+	require.Equal(t, PublishHtlc, instantOutFSM.CurrentState())
+}
+
+// TestCheckV1ReservationStates statically checks the instant out states.
+func TestCheckV1ReservationStates(t *testing.T) {
+	issues := fsm.CheckStates(
+		(&FSM{}).GetV1ReservationStates(), &fsm.CheckConfig{
+			FinalStates: []fsm.StateType{
+				Failed, FinishedHtlcPreimageSweep,
+				FinishedSweeplessSweep, Cancelled,
+			},
+			Events: []fsm.EventType{
+				OnStart, OnInit, OnPaymentAccepted,
+				OnHtlcSigReceived, OnPreimagePushed,
+				OnSweeplessSweepPublished,
+				OnSweeplessSweepConfirmed, OnErrorPublishHtlc,
+				OnInvalidCoopSweep, OnHtlcPublished,
+				OnHtlcSweepPublished, OnHtlcSwept, OnRecover,
+				OnCancel,
+			},
+		},
+	)
+
+	// The known issues are pinned so that new ones are caught. The no-op
+	// action of a failed htlc sweep can't fail, and the unhandled events
+	// are declared but not used by the v1 protocol.
+	require.Equal(t, []fsm.Issue{
+		{
+			Type:  fsm.IssueMissingErrorTransition,
+			State: FailedHtlcSweep,
+			Event: fsm.OnError,
+		},
+		{Type: fsm.IssueUnhandledEvent, Event: OnInvalidCoopSweep},
+		{Type: fsm.IssueUnhandledEvent, Event: OnPreimagePushed},
+	}, issues)
+}
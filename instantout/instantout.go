@@ -21,9 +21,21 @@ import (
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
+// SweepOutput is an output of the sweep of an instant out that pays a fixed
+// amount to an address. The remainder of the swap is sent to the sweep address
+// of the swap.
+type SweepOutput struct {
+	// Address is the address the output pays to.
+	Address btcutil.Address
+
+	// Value is the amount the output pays.
+	Value btcutil.Amount
+}
+
 // InstantOut holds the necessary information to execute an instant out swap.
 type InstantOut struct {
 	// SwapHash is the hash of the swap.
@@ -46,6 +58,11 @@ type InstantOut struct {
 	// instant out swap.
 	Reservations []*reservation.Reservation
 
+	// ChangeReservation is the reservation that receives the part of the
+	// input reservations that isn't swapped. It is nil if the full value
+	// of the reservations is swapped.
+	ChangeReservation *reservation.Reservation
+
 	// protocolVersion is the version of the protocol that is used for the
 	// swap.
 	protocolVersion ProtocolVersion
@@ -53,7 +70,8 @@ type InstantOut struct {
 	// initiationHeight is the height at which the swap was initiated.
 	initiationHeight int32
 
-	// Value is the amount that is swapped.
+	// Value is the amount that is swapped. It is lower than the value of
+	// the reservations if the swap has a change reservation.
 	Value btcutil.Amount
 
 	// keyLocator is the key locator that is used for the swap.
@@ -71,9 +89,14 @@ type InstantOut struct {
 	// htlcFeeRate is the fee rate that is used for the htlc transaction.
 	htlcFeeRate chainfee.SatPerKWeight
 
-	// sweepAddress is the address that is used to sweep the funds to.
+	// sweepAddress is the address that is used to sweep the funds to. It
+	// receives the remainder of the swap after the sweep outputs and fees.
 	sweepAddress btcutil.Address
 
+	// sweepOutputs are additional outputs of the sweepless sweep and htlc
+	// sweep transactions that pay fixed amounts.
+	sweepOutputs []SweepOutput
+
 	// finalizedHtlcTx is the finalized htlc transaction that is used in the
 	// non-cooperative path for the instant out swap.
 	finalizedHtlcTx *wire.MsgTx
@@ -144,6 +167,71 @@ func (i *InstantOut) getInputReservations() (InputReservations, error) {
 	return inputs, nil
 }
 
+// changeOutput returns the change reservation output of the instant out or nil
+// if the instant out doesn't have any change.
+func (i *InstantOut) changeOutput() (*wire.TxOut, error) {
+	if i.ChangeReservation == nil {
+		return nil, nil
+	}
+
+	return i.ChangeReservation.Output()
+}
+
+// fixedSweepTxOuts returns the outputs of the sweep transactions that pay
+// fixed amounts and their total value.
+func (i *InstantOut) fixedSweepTxOuts() ([]*wire.TxOut, btcutil.Amount,
+	error) {
+
+	return sweepTxOuts(i.sweepOutputs)
+}
+
+// sweepTxOuts converts the passed sweep outputs to transaction outputs and
+// returns them together with their total value.
+func sweepTxOuts(outputs []SweepOutput) ([]*wire.TxOut, btcutil.Amount,
+	error) {
+
+	var (
+		txOuts = make([]*wire.TxOut, 0, len(outputs))
+		total  btcutil.Amount
+	)
+	for _, output := range outputs {
+		pkScript, err := txscript.PayToAddrScript(output.Address)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		txOuts = append(txOuts, &wire.TxOut{
+			Value:    int64(output.Value),
+			PkScript: pkScript,
+		})
+		total += output.Value
+	}
+
+	return txOuts, total, nil
+}
+
+// remainderSweepTxOut returns the output of the sweep transactions that pays
+// the remainder of the swap to the sweep address.
+func (i *InstantOut) remainderSweepTxOut(value btcutil.Amount) (*wire.TxOut,
+	error) {
+
+	pkScript, err := txscript.PayToAddrScript(i.sweepAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	dustLimit := lnwallet.DustLimitForSize(len(pkScript))
+	if value < dustLimit {
+		return nil, fmt.Errorf("remaining sweep amount %v is below the "+
+			"dust limit %v", value, dustLimit)
+	}
+
+	return &wire.TxOut{
+		Value:    int64(value),
+		PkScript: pkScript,
+	}, nil
+}
+
 // createHtlcTransaction creates the htlc transaction for the instant out.
 func (i *InstantOut) createHtlcTransaction(network *chaincfg.Params) (
 	*wire.MsgTx, error) {
@@ -167,8 +255,13 @@ func (i *InstantOut) createHtlcTransaction(network *chaincfg.Params) (
 		})
 	}
 
+	changeOutput, err := i.changeOutput()
+	if err != nil {
+		return nil, err
+	}
+
 	// Estimate the fee
-	weight := htlcWeight(len(inputReservations))
+	weight := htlcWeight(len(inputReservations), changeOutput != nil)
 	fee := i.htlcFeeRate.FeeForWeight(weight)
 	if fee > i.Value/5 {
 		return nil, errors.New("fee is higher than 20% of " +
@@ -188,6 +281,12 @@ func (i *InstantOut) createHtlcTransaction(network *chaincfg.Params) (
 
 	msgTx.AddTxOut(sweepOutput)
 
+	// The change goes back into a new reservation. The htlc output needs
+	// to stay at index 0 as the htlc sweep spends it from there.
+	if changeOutput != nil {
+		msgTx.AddTxOut(changeOutput)
+	}
+
 	return msgTx, nil
 }
 
@@ -211,26 +310,43 @@ func (i *InstantOut) createSweeplessSweepTx(feerate chainfee.SatPerKWeight) (
 		})
 	}
 
+	changeOutput, err := i.changeOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	fixedOutputs, fixedAmt, err := i.fixedSweepTxOuts()
+	if err != nil {
+		return nil, err
+	}
+
 	// Estimate the fee
-	weight := sweeplessSweepWeight(len(inputReservations))
+	weight := sweeplessSweepWeight(
+		len(inputReservations), changeOutput != nil, fixedOutputs,
+	)
 	fee := feerate.FeeForWeight(weight)
 	if fee > i.Value/5 {
 		return nil, errors.New("fee is higher than 20% of " +
 			"sweep value")
 	}
 
-	pkscript, err := txscript.PayToAddrScript(i.sweepAddress)
+	// Create the sweep output that receives the remainder of the swap.
+	sweepOutput, err := i.remainderSweepTxOut(i.Value - fixedAmt - fee)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create the sweep output
-	sweepOutput := &wire.TxOut{
-		Value:    int64(i.Value) - int64(fee),
-		PkScript: pkscript,
+	msgTx.AddTxOut(sweepOutput)
+
+	// The fixed outputs follow in the order they were requested.
+	for _, txOut := range fixedOutputs {
+		msgTx.AddTxOut(txOut)
 	}
 
-	msgTx.AddTxOut(sweepOutput)
+	// The change goes back into a new reservation.
+	if changeOutput != nil {
+		msgTx.AddTxOut(changeOutput)
+	}
 
 	return msgTx, nil
 }
@@ -355,8 +471,16 @@ func (i *InstantOut) generateHtlcSweepTx(ctx context.Context,
 	sweepTx := wire.NewMsgTx(2)
 	sweepTx.LockTime = blockheight
 
+	fixedOutputs, fixedAmt, err := i.fixedSweepTxOuts()
+	if err != nil {
+		return nil, err
+	}
+
 	var weightEstimator input.TxWeightEstimator
 	weightEstimator.AddP2TROutput()
+	for _, txOut := range fixedOutputs {
+		weightEstimator.AddOutput(txOut.PkScript)
+	}
 
 	err = htlc.AddSuccessToEstimator(&weightEstimator)
 	if err != nil {
@@ -375,21 +499,22 @@ func (i *InstantOut) generateHtlcSweepTx(ctx context.Context,
 		Sequence:        htlc.SuccessSequence(),
 	})
 
-	// Add the sweep output.
-	sweepPkScript, err := txscript.PayToAddrScript(i.sweepAddress)
-	if err != nil {
-		return nil, err
-	}
-
 	fee := feeRate.FeeForWeight(weightEstimator.Weight())
 
+	// Add the sweep output that receives the remainder of the htlc,
+	// followed by the fixed outputs.
 	htlcOutValue := i.finalizedHtlcTx.TxOut[0].Value
-	output := &wire.TxOut{
-		Value:    htlcOutValue - int64(fee),
-		PkScript: sweepPkScript,
+	output, err := i.remainderSweepTxOut(
+		btcutil.Amount(htlcOutValue) - fixedAmt - fee,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	sweepTx.AddTxOut(output)
+	for _, txOut := range fixedOutputs {
+		sweepTx.AddTxOut(txOut)
+	}
 
 	signDesc := lndclient.SignDescriptor{
 		WitnessScript: htlc.SuccessScript(),
@@ -424,7 +549,7 @@ func (i *InstantOut) generateHtlcSweepTx(ctx context.Context,
 }
 
 // htlcWeight returns the weight for the htlc transaction.
-func htlcWeight(numInputs int) lntypes.WeightUnit {
+func htlcWeight(numInputs int, hasChange bool) lntypes.WeightUnit {
 	var weightEstimator input.TxWeightEstimator
 	for i := 0; i < numInputs; i++ {
 		weightEstimator.AddTaprootKeySpendInput(
@@ -434,11 +559,19 @@ func htlcWeight(numInputs int) lntypes.WeightUnit {
 
 	weightEstimator.AddP2WSHOutput()
 
+	// The change reservation is a taproot output.
+	if hasChange {
+		weightEstimator.AddP2TROutput()
+	}
+
 	return weightEstimator.Weight()
 }
 
-// sweeplessSweepWeight returns the weight for the sweepless sweep transaction.
-func sweeplessSweepWeight(numInputs int) lntypes.WeightUnit {
+// sweeplessSweepWeight returns the weight for the sweepless sweep transaction
+// with the given fixed amount outputs.
+func sweeplessSweepWeight(numInputs int, hasChange bool,
+	fixedOutputs []*wire.TxOut) lntypes.WeightUnit {
+
 	var weightEstimator input.TxWeightEstimator
 	for i := 0; i < numInputs; i++ {
 		weightEstimator.AddTaprootKeySpendInput(
@@ -447,6 +580,13 @@ func sweeplessSweepWeight(numInputs int) lntypes.WeightUnit {
 	}
 
 	weightEstimator.AddP2TROutput()
+	for _, txOut := range fixedOutputs {
+		weightEstimator.AddOutput(txOut.PkScript)
+	}
+
+	if hasChange {
+		weightEstimator.AddP2TROutput()
+	}
 
 	return weightEstimator.Weight()
 }
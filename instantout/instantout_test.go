@@ -0,0 +1,320 @@
+package instantout
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/instantout/reservation"
+	"github.com/lightninglabs/loop/swapserverrpc"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestReservation creates a confirmed reservation with fresh keys.
+func newTestReservation(t *testing.T, id byte,
+	value btcutil.Amount) *reservation.Reservation {
+
+	clientKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	serverKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return &reservation.Reservation{
+		ID:           reservation.ID{id},
+		ClientPubkey: clientKey.PubKey(),
+		ServerPubkey: serverKey.PubKey(),
+		Value:        value,
+		Expiry:       1000,
+		Outpoint: &wire.OutPoint{
+			Hash:  chainhash.Hash{id},
+			Index: 1,
+		},
+	}
+}
+
+// TestChangeReservationOutputs tests that the htlc and sweepless sweep txes
+// of an instant out that only swaps a part of its reservations return the
+// remainder into the change reservation.
+func TestChangeReservationOutputs(t *testing.T) {
+	_, clientPubkey := test.CreateKey(1)
+	_, serverPubkey := test.CreateKey(2)
+
+	sweepAddr, err := btcutil.NewAddressTaproot(
+		make([]byte, 32), &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+
+	feeRate := chainfee.SatPerKWeight(1000)
+	instantOut := &InstantOut{
+		CltvExpiry: 500,
+		Reservations: []*reservation.Reservation{
+			newTestReservation(t, 1, 100_000),
+			newTestReservation(t, 2, 100_000),
+		},
+		ChangeReservation: newTestReservation(t, 3, 50_000),
+		Value:             150_000,
+		clientPubkey:      clientPubkey,
+		serverPubkey:      serverPubkey,
+		htlcFeeRate:       feeRate,
+		sweepAddress:      sweepAddr,
+	}
+
+	changeOutput, err := instantOut.ChangeReservation.Output()
+	require.NoError(t, err)
+
+	// The sweepless sweep pays the swapped amount minus the fee to the
+	// sweep address and the change to the change reservation.
+	sweepTx, err := instantOut.createSweeplessSweepTx(feeRate)
+	require.NoError(t, err)
+	require.Len(t, sweepTx.TxIn, 2)
+	require.Len(t, sweepTx.TxOut, 2)
+
+	sweepFee := feeRate.FeeForWeight(sweeplessSweepWeight(2, true, nil))
+	require.EqualValues(t, 150_000-sweepFee, sweepTx.TxOut[0].Value)
+	require.Equal(t, changeOutput, sweepTx.TxOut[1])
+
+	// The htlc tx keeps the htlc at index 0 and also returns the change.
+	htlcTx, err := instantOut.createHtlcTransaction(
+		&chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+	require.Len(t, htlcTx.TxOut, 2)
+
+	htlc, err := instantOut.getHtlc(&chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+
+	htlcFee := feeRate.FeeForWeight(htlcWeight(2, true))
+	require.Equal(t, htlc.PkScript, htlcTx.TxOut[0].PkScript)
+	require.EqualValues(t, 150_000-htlcFee, htlcTx.TxOut[0].Value)
+	require.Equal(t, changeOutput, htlcTx.TxOut[1])
+
+	// Without a change reservation, there is only a single output.
+	instantOut.ChangeReservation = nil
+	instantOut.Value = 200_000
+
+	sweepTx, err = instantOut.createSweeplessSweepTx(feeRate)
+	require.NoError(t, err)
+	require.Len(t, sweepTx.TxOut, 1)
+
+	sweepFee = feeRate.FeeForWeight(sweeplessSweepWeight(2, false, nil))
+	require.EqualValues(t, 200_000-sweepFee, sweepTx.TxOut[0].Value)
+}
+
+// TestSweepOutputs tests that the sweepless sweep and the htlc sweep pay the
+// fixed amounts of the sweep outputs and the remainder to the sweep address.
+func TestSweepOutputs(t *testing.T) {
+	_, clientPubkey := test.CreateKey(1)
+	_, serverPubkey := test.CreateKey(2)
+	_, outputPubkey := test.CreateKey(3)
+
+	params := &chaincfg.RegressionNetParams
+	sweepAddr, err := btcutil.NewAddressTaproot(make([]byte, 32), params)
+	require.NoError(t, err)
+
+	p2wkhAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(outputPubkey.SerializeCompressed()), params,
+	)
+	require.NoError(t, err)
+
+	p2trAddr, err := btcutil.NewAddressTaproot(
+		outputPubkey.SerializeCompressed()[1:], params,
+	)
+	require.NoError(t, err)
+
+	preimage := lntypes.Preimage{1}
+	feeRate := chainfee.SatPerKWeight(1000)
+	instantOut := &InstantOut{
+		SwapHash:     preimage.Hash(),
+		swapPreimage: preimage,
+		CltvExpiry:   500,
+		Reservations: []*reservation.Reservation{
+			newTestReservation(t, 1, 100_000),
+			newTestReservation(t, 2, 100_000),
+		},
+		ChangeReservation: newTestReservation(t, 3, 50_000),
+		Value:             150_000,
+		clientPubkey:      clientPubkey,
+		serverPubkey:      serverPubkey,
+		htlcFeeRate:       feeRate,
+		sweepAddress:      sweepAddr,
+		sweepOutputs: []SweepOutput{
+			{Address: p2wkhAddr, Value: 30_000},
+			{Address: p2trAddr, Value: 20_000},
+		},
+	}
+
+	fixedOutputs, fixedAmt, err := instantOut.fixedSweepTxOuts()
+	require.NoError(t, err)
+	require.EqualValues(t, 50_000, fixedAmt)
+
+	changeOutput, err := instantOut.ChangeReservation.Output()
+	require.NoError(t, err)
+
+	// The sweepless sweep pays the remainder to the sweep address, followed
+	// by the fixed outputs and the change.
+	sweepTx, err := instantOut.createSweeplessSweepTx(feeRate)
+	require.NoError(t, err)
+	require.Len(t, sweepTx.TxOut, 4)
+
+	sweepFee := feeRate.FeeForWeight(
+		sweeplessSweepWeight(2, true, fixedOutputs),
+	)
+	require.Greater(
+		t, sweepFee, feeRate.FeeForWeight(
+			sweeplessSweepWeight(2, true, nil),
+		),
+	)
+	require.EqualValues(t, 100_000-sweepFee, sweepTx.TxOut[0].Value)
+	require.Equal(t, fixedOutputs[0], sweepTx.TxOut[1])
+	require.Equal(t, fixedOutputs[1], sweepTx.TxOut[2])
+	require.Equal(t, changeOutput, sweepTx.TxOut[3])
+
+	// The htlc sweep pays the same split.
+	htlcTx, err := instantOut.createHtlcTransaction(params)
+	require.NoError(t, err)
+	instantOut.finalizedHtlcTx = htlcTx
+
+	mockLnd := test.NewMockLnd()
+	go func() {
+		<-mockLnd.SignOutputRawChannel
+	}()
+
+	htlcSweepTx, err := instantOut.generateHtlcSweepTx(
+		context.Background(), mockLnd.Signer, feeRate, params, 600,
+	)
+	require.NoError(t, err)
+	require.Len(t, htlcSweepTx.TxOut, 3)
+	require.Equal(t, fixedOutputs[0], htlcSweepTx.TxOut[1])
+	require.Equal(t, fixedOutputs[1], htlcSweepTx.TxOut[2])
+
+	var outputSum int64
+	for _, txOut := range htlcSweepTx.TxOut {
+		outputSum += txOut.Value
+	}
+	require.Less(t, outputSum, htlcTx.TxOut[0].Value)
+
+	// Fixed outputs that don't leave enough for the sweep address are
+	// rejected.
+	instantOut.sweepOutputs[0].Value = 129_900
+	_, err = instantOut.createSweeplessSweepTx(feeRate)
+	require.ErrorContains(t, err, "dust limit")
+}
+
+// TestInstantOutQuoteSweepOutputs tests that the on-chain fee of a quote
+// includes the outputs of the destinations that receive fixed amounts.
+func TestInstantOutQuoteSweepOutputs(t *testing.T) {
+	_, outputPubkey := test.CreateKey(1)
+	params := &chaincfg.RegressionNetParams
+
+	p2trAddr, err := btcutil.NewAddressTaproot(
+		outputPubkey.SerializeCompressed()[1:], params,
+	)
+	require.NoError(t, err)
+
+	mockLnd := test.NewMockLnd()
+	manager := NewInstantOutManager(&Config{
+		Wallet:           mockLnd.WalletKit,
+		InstantOutClient: &mockInstantOutClient{},
+	})
+
+	ctx := context.Background()
+	quote, err := manager.GetInstantOutQuote(ctx, 100_000, 2, nil, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, quote.ServiceFee)
+	require.Equal(
+		t, test.DefaultMockFee.FeeForWeight(
+			sweeplessSweepWeight(2, false, nil),
+		), quote.OnChainFee,
+	)
+
+	sweepOutputs := []SweepOutput{{Address: p2trAddr, Value: 30_000}}
+	fixedOutputs, _, err := sweepTxOuts(sweepOutputs)
+	require.NoError(t, err)
+
+	destQuote, err := manager.GetInstantOutQuote(
+		ctx, 100_000, 2, nil, sweepOutputs,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, test.DefaultMockFee.FeeForWeight(
+			sweeplessSweepWeight(2, false, fixedOutputs),
+		), destQuote.OnChainFee,
+	)
+	require.Greater(t, destQuote.OnChainFee, quote.OnChainFee)
+}
+
+// TestRequiredProtocolVersion tests that an instant out only claims the
+// protocol version of the features it uses.
+func TestRequiredProtocolVersion(t *testing.T) {
+	sweepAddr, err := btcutil.NewAddressTaproot(
+		make([]byte, 32), &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+
+	sweepOutputs := []SweepOutput{{Address: sweepAddr, Value: 10_000}}
+
+	require.Equal(
+		t, ProtocolVersionFullReservation,
+		requiredProtocolVersion(false, nil),
+	)
+	require.Equal(
+		t, ProtocolVersionChangeReservation,
+		requiredProtocolVersion(true, nil),
+	)
+	require.Equal(
+		t, ProtocolVersionMultiSweepOutputs,
+		requiredProtocolVersion(false, sweepOutputs),
+	)
+	require.Equal(
+		t, ProtocolVersionMultiSweepOutputs,
+		requiredProtocolVersion(true, sweepOutputs),
+	)
+}
+
+// TestParseChangeReservationExpiry tests that the expiry of a change
+// reservation offered by the server is bounded like any other reservation.
+func TestParseChangeReservationExpiry(t *testing.T) {
+	_, clientPubkey := test.CreateKey(1)
+	_, serverPubkey := test.CreateKey(2)
+
+	clientKeyDesc := &keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(reservation.KeyFamily),
+		},
+		PubKey: clientPubkey,
+	}
+
+	const (
+		height    = int32(1000)
+		minExpiry = uint32(1500)
+	)
+
+	parse := func(expiry uint32) error {
+		res := &swapserverrpc.InstantLoopOutResponse{
+			ChangeReservationId: make([]byte, reservation.IdLength),
+			ChangeServerKey:     serverPubkey.SerializeCompressed(),
+			ChangeExpiry:        expiry,
+		}
+		res.ChangeReservationId[0] = 1
+
+		_, err := parseChangeReservation(
+			res, clientKeyDesc, 50_000, height, minExpiry,
+		)
+
+		return err
+	}
+
+	require.NoError(t, parse(minExpiry))
+	require.NoError(t, parse(uint32(height)+reservation.MaxExpiryDelta))
+	require.Error(t, parse(minExpiry-1))
+	require.Error(t, parse(uint32(height)+reservation.MaxExpiryDelta+1))
+}
@@ -43,6 +43,11 @@ type ReservationManager interface {
 
 	// UnlockReservation unlocks the reservation for the given id.
 	UnlockReservation(ctx context.Context, id reservation.ID) error
+
+	// AddChangeReservation stores the change reservation of an instant
+	// out and waits for it to confirm.
+	AddChangeReservation(ctx context.Context,
+		changeReservation *reservation.Reservation) error
 }
 
 // InputReservations is a helper struct for the input reservations.
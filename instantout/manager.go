@@ -12,6 +12,7 @@ import (
 	"github.com/lightninglabs/loop/instantout/reservation"
 	"github.com/lightninglabs/loop/swapserverrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/queue"
 )
 
 var (
@@ -37,6 +38,16 @@ type Manager struct {
 
 	runCtx context.Context
 
+	// subscribers contains the update queues of all clients that are
+	// subscribed to instant out updates.
+	subscribers map[int]chan<- interface{}
+
+	// nextSubscriberID is the id of the next update subscriber.
+	nextSubscriberID int
+
+	// subscribersLock guards the subscribers map and nextSubscriberID.
+	subscribersLock sync.Mutex
+
 	sync.Mutex
 }
 
@@ -46,6 +57,7 @@ func NewInstantOutManager(cfg *Config) *Manager {
 		cfg:               cfg,
 		activeInstantOuts: make(map[lntypes.Hash]*FSM),
 		blockEpochChan:    make(chan int32),
+		subscribers:       make(map[int]chan<- interface{}),
 	}
 }
 
@@ -89,12 +101,29 @@ func (m *Manager) Run(ctx context.Context, initChan chan struct{},
 			m.currentHeight = height
 			m.Unlock()
 
+			m.notifyHeight(height)
+
 		case err := <-newBlockErrChan:
 			return err
 		}
 	}
 }
 
+// notifyHeight passes a new block height to the state machines of all active
+// instant outs.
+func (m *Manager) notifyHeight(height int32) {
+	m.Lock()
+	instantOuts := make([]*FSM, 0, len(m.activeInstantOuts))
+	for _, instantOutFSM := range m.activeInstantOuts {
+		instantOuts = append(instantOuts, instantOutFSM)
+	}
+	m.Unlock()
+
+	for _, instantOutFSM := range instantOuts {
+		instantOutFSM.NotifyHeight(height)
+	}
+}
+
 // recoverInstantOuts recovers all the active instantouts from the database.
 func (m *Manager) recoverInstantOuts(ctx context.Context) error {
 	// Fetch all the active instantouts from the database.
@@ -116,6 +145,8 @@ func (m *Manager) recoverInstantOuts(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		instantOutFSM.NotifyHeight(m.currentHeight)
+		m.observeInstantOut(instantOutFSM)
 
 		m.activeInstantOuts[instantOut.SwapHash] = instantOutFSM
 
@@ -135,20 +166,26 @@ func (m *Manager) recoverInstantOuts(ctx context.Context) error {
 	return nil
 }
 
-// NewInstantOut creates a new instantout.
+// NewInstantOut creates a new instantout. If amt is lower than the value of
+// the reservations, the remainder is returned into a change reservation. An
+// amount of zero swaps the full value of the reservations. The sweep outputs
+// are paid their fixed amounts from the swap, while the sweep address receives
+// the remainder after fees. If no sweep address is given, a new wallet address
+// is used.
 func (m *Manager) NewInstantOut(ctx context.Context,
-	reservations []reservation.ID, sweepAddress string) (*FSM, error) {
+	reservations []reservation.ID, amt btcutil.Amount,
+	sweepAddr btcutil.Address, sweepOutputs []SweepOutput) (*FSM, error) {
 
-	var (
-		sweepAddr btcutil.Address
-		err       error
-	)
-	if sweepAddress != "" {
-		sweepAddr, err = btcutil.DecodeAddress(
-			sweepAddress, m.cfg.Network,
-		)
-		if err != nil {
-			return nil, err
+	if sweepAddr != nil && !sweepAddr.IsForNet(m.cfg.Network) {
+		return nil, fmt.Errorf("sweep address %v is not for network "+
+			"%v", sweepAddr, m.cfg.Network.Name)
+	}
+
+	for _, output := range sweepOutputs {
+		if !output.Address.IsForNet(m.cfg.Network) {
+			return nil, fmt.Errorf("sweep output address %v is "+
+				"not for network %v", output.Address,
+				m.cfg.Network.Name)
 		}
 	}
 
@@ -157,18 +194,20 @@ func (m *Manager) NewInstantOut(ctx context.Context,
 	request := &InitInstantOutCtx{
 		cltvExpiry:      m.currentHeight + int32(defaultCltv),
 		reservations:    reservations,
+		amount:          amt,
 		initationHeight: m.currentHeight,
 		protocolVersion: CurrentProtocolVersion(),
 		sweepAddress:    sweepAddr,
+		sweepOutputs:    sweepOutputs,
 	}
 
-	instantOut, err := NewFSM(
-		m.runCtx, m.cfg, ProtocolVersionFullReservation,
-	)
+	instantOut, err := NewFSM(m.runCtx, m.cfg, CurrentProtocolVersion())
 	if err != nil {
 		m.Unlock()
 		return nil, err
 	}
+	instantOut.NotifyHeight(m.currentHeight)
+	m.observeInstantOut(instantOut)
 	m.activeInstantOuts[instantOut.InstantOut.SwapHash] = instantOut
 	m.Unlock()
 
@@ -193,6 +232,27 @@ func (m *Manager) NewInstantOut(ctx context.Context,
 	return instantOut, nil
 }
 
+// CancelInstantOut cancels an active instant out that hasn't sent its htlc
+// signatures to the server yet. The swap is cancelled with the server, its
+// reservations are unlocked and the swap ends in the Cancelled state.
+func (m *Manager) CancelInstantOut(ctx context.Context,
+	swapHash lntypes.Hash) error {
+
+	instantOut, err := m.GetActiveInstantOut(swapHash)
+	if err != nil {
+		return err
+	}
+
+	err = instantOut.requestCancel()
+	if err != nil {
+		return err
+	}
+
+	return instantOut.DefaultObserver.WaitForState(
+		ctx, defaultStateWaitTime, Cancelled,
+	)
+}
+
 // GetActiveInstantOut returns an active instant out.
 func (m *Manager) GetActiveInstantOut(swapHash lntypes.Hash) (*FSM, error) {
 	m.Lock()
@@ -218,11 +278,24 @@ type Quote struct {
 
 	// OnChainFee is the estimated on chain fee in sat.
 	OnChainFee btcutil.Amount
+
+	// ChangeAmt is the amount in sat that is returned into a change
+	// reservation.
+	ChangeAmt btcutil.Amount
 }
 
-// GetInstantOutQuote returns a quote for an instant out.
+// GetInstantOutQuote returns a quote for an instant out. If the reservations
+// are known, the quote accounts for the change reservation that receives the
+// part of the reservations that exceeds the amount. The on-chain fee includes
+// the passed sweep outputs that receive fixed amounts.
 func (m *Manager) GetInstantOutQuote(ctx context.Context,
-	amt btcutil.Amount, numReservations int) (Quote, error) {
+	amt btcutil.Amount, numReservations int,
+	reservationIDs []reservation.ID, sweepOutputs []SweepOutput) (Quote,
+	error) {
+
+	if len(reservationIDs) > 0 {
+		numReservations = len(reservationIDs)
+	}
 
 	if numReservations <= 0 {
 		return Quote{}, fmt.Errorf("no reservations selected")
@@ -232,6 +305,26 @@ func (m *Manager) GetInstantOutQuote(ctx context.Context,
 		return Quote{}, fmt.Errorf("no amount selected")
 	}
 
+	var reservationAmt btcutil.Amount
+	for _, id := range reservationIDs {
+		res, err := m.cfg.ReservationManager.GetReservation(ctx, id)
+		if err != nil {
+			return Quote{}, err
+		}
+
+		reservationAmt += res.Value
+	}
+
+	var changeAmt btcutil.Amount
+	if len(reservationIDs) > 0 {
+		if amt > reservationAmt {
+			return Quote{}, fmt.Errorf("amount %v exceeds the "+
+				"reservation value %v", amt, reservationAmt)
+		}
+
+		changeAmt = reservationAmt - amt
+	}
+
 	// Get the service fee.
 	quoteRes, err := m.cfg.InstantOutClient.GetInstantOutQuote(
 		ctx, &swapserverrpc.GetInstantOutQuoteRequest{
@@ -249,16 +342,146 @@ func (m *Manager) GetInstantOutQuote(ctx context.Context,
 		return Quote{}, err
 	}
 
+	// The sweepless sweep pays the fixed amount outputs on top of the
+	// remainder of the swap.
+	fixedOutputs, _, err := sweepTxOuts(sweepOutputs)
+	if err != nil {
+		return Quote{}, err
+	}
+
 	// The on chain chainFee is the chainFee rate times the estimated
 	// sweepless sweep transaction size.
-	chainFee := feeRate.FeeForWeight(sweeplessSweepWeight(numReservations))
+	chainFee := feeRate.FeeForWeight(
+		sweeplessSweepWeight(
+			numReservations, changeAmt > 0, fixedOutputs,
+		),
+	)
 
 	return Quote{
 		ServiceFee: btcutil.Amount(quoteRes.SwapFee),
 		OnChainFee: chainFee,
+		ChangeAmt:  changeAmt,
 	}, nil
 }
 
+// SubscribeInstantOutUpdates returns a channel that receives a copy of an
+// instant out every time its state machine transitions to a new state. The
+// subscription is cancelled once the passed context is done.
+func (m *Manager) SubscribeInstantOutUpdates(
+	ctx context.Context) <-chan *InstantOut {
+
+	// Start a notification queue for this subscriber, so that a slow
+	// subscriber never blocks an instant out state machine.
+	updateQueue := queue.NewConcurrentQueue(20)
+	updateQueue.Start()
+
+	m.subscribersLock.Lock()
+	id := m.nextSubscriberID
+	m.nextSubscriberID++
+	m.subscribers[id] = updateQueue.ChanIn()
+	m.subscribersLock.Unlock()
+
+	updates := make(chan *InstantOut)
+	go func() {
+		defer func() {
+			m.subscribersLock.Lock()
+			delete(m.subscribers, id)
+			m.subscribersLock.Unlock()
+			updateQueue.Stop()
+		}()
+
+		for {
+			select {
+			case item, ok := <-updateQueue.ChanOut():
+				if !ok {
+					return
+				}
+
+				select {
+				case updates <- item.(*InstantOut):
+
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates
+}
+
+// observeInstantOut registers an observer on the passed instant out FSM that
+// forwards all state changes of the instant out to the update subscribers.
+func (m *Manager) observeInstantOut(instantOutFSM *FSM) {
+	instantOutFSM.RegisterObserver(&updateObserver{
+		manager:       m,
+		instantOutFSM: instantOutFSM,
+	})
+}
+
+// updateObserver is an fsm.Observer that forwards the state changes of an
+// instant out to the update subscribers of the manager.
+type updateObserver struct {
+	manager       *Manager
+	instantOutFSM *FSM
+}
+
+// Notify implements the fsm.Observer interface.
+func (o *updateObserver) Notify(notification fsm.Notification) {
+	// The instant out is only populated once it has been initialized.
+	instantOut := o.instantOutFSM.InstantOut
+	if instantOut == nil || instantOut.SwapHash == (lntypes.Hash{}) {
+		return
+	}
+
+	// New instant outs are only indexed by their swap hash once it is
+	// known.
+	o.manager.indexInstantOut(instantOut.SwapHash, o.instantOutFSM)
+
+	// Just like the store, we skip the early states and instant outs
+	// that failed before they were created.
+	if notification.NextState == Init ||
+		notification.NextState == fsm.EmptyState ||
+		(notification.PreviousState == Init &&
+			notification.NextState == Failed) {
+
+		return
+	}
+
+	// The observer is notified before the instant out itself is updated,
+	// so we send a copy with the new state.
+	update := *instantOut
+	update.State = notification.NextState
+
+	o.manager.notifySubscribers(&update)
+}
+
+// indexInstantOut adds the passed instant out FSM to the active instant outs
+// under its swap hash.
+func (m *Manager) indexInstantOut(swapHash lntypes.Hash, instantOutFSM *FSM) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.activeInstantOuts[lntypes.Hash{}] == instantOutFSM {
+		delete(m.activeInstantOuts, lntypes.Hash{})
+	}
+
+	m.activeInstantOuts[swapHash] = instantOutFSM
+}
+
+// notifySubscribers sends the instant out update to all subscribers.
+func (m *Manager) notifySubscribers(update *InstantOut) {
+	m.subscribersLock.Lock()
+	defer m.subscribersLock.Unlock()
+
+	for _, subscriber := range m.subscribers {
+		subscriber <- update
+	}
+}
+
 // ListInstantOuts returns all instant outs from the database.
 func (m *Manager) ListInstantOuts(ctx context.Context) ([]*InstantOut, error) {
 	return m.cfg.Store.ListInstantLoopOuts(ctx)
@@ -22,10 +22,10 @@ type InitReservationContext struct {
 // InitAction is the action that is executed when the reservation state machine
 // is initialized. It creates the reservation in the database and dispatches the
 // payment to the server.
-func (f *FSM) InitAction(eventCtx fsm.EventContext) fsm.EventType {
-	// Check if the context is of the correct type.
-	reservationRequest, ok := eventCtx.(*InitReservationContext)
-	if !ok {
+func (f *FSM) InitAction(
+	reservationRequest *InitReservationContext) fsm.EventType {
+
+	if reservationRequest == nil {
 		return f.HandleError(fsm.ErrInvalidContextType)
 	}
 
@@ -76,8 +76,12 @@ func (f *FSM) InitAction(eventCtx fsm.EventContext) fsm.EventType {
 
 // SubscribeToConfirmationAction is the action that is executed when the
 // reservation is waiting for confirmation. It subscribes to the confirmation
-// of the reservation transaction.
-func (f *FSM) SubscribeToConfirmationAction(_ fsm.EventContext) fsm.EventType {
+// of the reservation transaction. If the subscription fails, the reservation
+// fails, unless we're shutting down, in which case it keeps waiting for the
+// confirmation once it is resumed.
+func (f *FSM) SubscribeToConfirmationAction(
+	_ *InitReservationContext) fsm.EventType {
+
 	pkscript, err := f.reservation.GetPkScript()
 	if err != nil {
 		return f.HandleError(err)
@@ -97,7 +101,7 @@ func (f *FSM) SubscribeToConfirmationAction(_ fsm.EventContext) fsm.EventType {
 	)
 	if err != nil {
 		f.Errorf("unable to subscribe to conf notification: %v", err)
-		return f.HandleError(err)
+		return f.handleConfError(err)
 	}
 
 	blockChan, errBlockChan, err := f.cfg.ChainNotifier.RegisterBlockEpochNtfn(
@@ -105,7 +109,7 @@ func (f *FSM) SubscribeToConfirmationAction(_ fsm.EventContext) fsm.EventType {
 	)
 	if err != nil {
 		f.Errorf("unable to subscribe to block notifications: %v", err)
-		return f.HandleError(err)
+		return f.handleConfError(err)
 	}
 
 	// We'll now wait for the confirmation of the reservation transaction.
@@ -113,11 +117,11 @@ func (f *FSM) SubscribeToConfirmationAction(_ fsm.EventContext) fsm.EventType {
 		select {
 		case err := <-errConfChan:
 			f.Errorf("conf subscription error: %v", err)
-			return f.HandleError(err)
+			return f.handleConfError(err)
 
 		case err := <-errBlockChan:
 			f.Errorf("block subscription error: %v", err)
-			return f.HandleError(err)
+			return f.handleConfError(err)
 
 		case confInfo := <-confChan:
 			f.Debugf("confirmed in tx: %v", confInfo.Tx)
@@ -147,27 +151,27 @@ func (f *FSM) SubscribeToConfirmationAction(_ fsm.EventContext) fsm.EventType {
 	}
 }
 
-// AsyncWaitForExpiredOrSweptAction waits for the reservation to be either
-// expired or swept. This is non-blocking and can be used to wait for the
-// reservation to expire while expecting other events.
-func (f *FSM) AsyncWaitForExpiredOrSweptAction(_ fsm.EventContext,
-) fsm.EventType {
-
-	notifCtx, cancel := context.WithCancel(f.ctx)
-
-	blockHeightChan, errEpochChan, err := f.cfg.ChainNotifier.
-		RegisterBlockEpochNtfn(notifCtx)
-	if err != nil {
-		cancel()
-		return f.HandleError(err)
+// handleConfError handles an error of the confirmation subscription. Errors
+// caused by a shutdown don't fail the reservation.
+func (f *FSM) handleConfError(err error) fsm.EventType {
+	if f.ctx.Err() != nil {
+		return fsm.NoOp
 	}
 
+	return f.HandleError(err)
+}
+
+// AsyncWaitForSpendAction waits for the spend of the reservation output. This
+// is non-blocking, so that other events can be processed while waiting. The
+// expiry of the reservation is handled by the timeouts of the state.
+func (f *FSM) AsyncWaitForSpendAction(_ *InitReservationContext) fsm.EventType {
 	pkScript, err := f.reservation.GetPkScript()
 	if err != nil {
-		cancel()
 		return f.HandleError(err)
 	}
 
+	notifCtx, cancel := context.WithCancel(f.ctx)
+
 	spendChan, errSpendChan, err := f.cfg.ChainNotifier.RegisterSpendNtfn(
 		notifCtx, f.reservation.Outpoint, pkScript,
 		f.reservation.InitiationHeight,
@@ -179,10 +183,7 @@ func (f *FSM) AsyncWaitForExpiredOrSweptAction(_ fsm.EventContext,
 
 	go func() {
 		defer cancel()
-		op, err := f.handleSubcriptions(
-			notifCtx, blockHeightChan, spendChan, errEpochChan,
-			errSpendChan,
-		)
+		op, err := f.waitForSpend(notifCtx, spendChan, errSpendChan)
 		if err != nil {
 			f.handleAsyncError(err)
 			return
@@ -199,40 +200,114 @@ func (f *FSM) AsyncWaitForExpiredOrSweptAction(_ fsm.EventContext,
 	return fsm.NoOp
 }
 
-func (f *FSM) handleSubcriptions(ctx context.Context,
-	blockHeightChan <-chan int32, spendChan <-chan *chainntnfs.SpendDetail,
-	errEpochChan <-chan error, errSpendChan <-chan error,
+// waitForSpend waits for the spend of the reservation output and returns the
+// event that matches the spend path.
+func (f *FSM) waitForSpend(ctx context.Context,
+	spendChan <-chan *chainntnfs.SpendDetail, errSpendChan <-chan error,
 ) (fsm.EventType, error) {
 
-	for {
+	select {
+	case err := <-errSpendChan:
+		return fsm.OnError, err
+
+	case spend := <-spendChan:
+		return f.handleSpend(spend), nil
+
+	case <-ctx.Done():
+		return fsm.NoOp, nil
+	}
+}
+
+// AsyncWaitForSweepAction waits for the spend of an expired reservation. As
+// the client can't spend the reservation output on its own, this only records
+// the server's sweep. A cooperative spend that confirms after the expiry moves
+// the reservation to the Spent state instead.
+func (f *FSM) AsyncWaitForSweepAction(_ *InitReservationContext) fsm.EventType {
+	// We can't watch for a spend if the reservation never confirmed, and
+	// there's nothing left to do if we already know about the spend.
+	if f.reservation.Outpoint == nil || f.reservation.SpendTxHash != nil {
+		return fsm.NoOp
+	}
+
+	pkScript, err := f.reservation.GetPkScript()
+	if err != nil {
+		return f.HandleError(err)
+	}
+
+	notifCtx, cancel := context.WithCancel(f.ctx)
+
+	spendChan, errSpendChan, err := f.cfg.ChainNotifier.RegisterSpendNtfn(
+		notifCtx, f.reservation.Outpoint, pkScript,
+		f.reservation.InitiationHeight,
+	)
+	if err != nil {
+		cancel()
+		return f.HandleError(err)
+	}
+
+	go func() {
+		defer cancel()
+
+		var event fsm.EventType
 		select {
-		case err := <-errEpochChan:
-			return fsm.OnError, err
+		case spend := <-spendChan:
+			event = f.handleSpend(spend)
 
 		case err := <-errSpendChan:
-			return fsm.OnError, err
+			f.Errorf("Error waiting for reservation sweep: %v", err)
+			return
 
-		case blockHeight := <-blockHeightChan:
-			expired := blockHeight >= int32(f.reservation.Expiry)
+		case <-notifCtx.Done():
+			return
+		}
 
-			if expired {
-				f.Debugf("Reservation expired")
-				return OnTimedOut, nil
-			}
+		err := f.SendEvent(event, nil)
+		if err != nil {
+			f.Errorf("Error sending %s event: %v", event, err)
+		}
+	}()
+
+	return fsm.NoOp
+}
 
-		case <-spendChan:
-			return OnSpent, nil
+// handleSpend stores the spend of the reservation output so that it's
+// persisted on the next transition and returns the event that matches the
+// spend path. The cooperative key spend path has a single signature in its
+// witness, while the server's timeout path reveals the expiry script.
+func (f *FSM) handleSpend(spend *chainntnfs.SpendDetail) fsm.EventType {
+	f.setPendingSpend(spend)
 
-		case <-ctx.Done():
-			return fsm.NoOp, nil
-		}
+	witness := spend.SpendingTx.TxIn[spend.SpenderInputIndex].Witness
+	if len(witness) > 1 {
+		f.Infof("Reservation swept by server in tx %v",
+			spend.SpenderTxHash)
+
+		return OnSwept
 	}
+
+	return OnSpent
+}
+
+// checkExpiringSoon raises an alert once the reservation is about to expire.
+func (f *FSM) checkExpiringSoon(height int32) {
+	if f.reservation.BlocksUntilExpiry(height) > expiryAlertDelta {
+		return
+	}
+
+	if f.expiryAlerted.Swap(true) {
+		return
+	}
+
+	f.alert(Alert{
+		Type:   AlertExpiringSoon,
+		Height: height,
+	})
 }
 
 func (f *FSM) handleAsyncError(err error) {
 	f.LastActionError = err
 	f.Errorf("Error on async action: %v", err)
-	err2 := f.SendEvent(fsm.OnError, err)
+	err2 := f.SendEvent(fsm.OnError, nil)
 	if err2 != nil {
 		f.Errorf("Error sending event: %v", err2)
 	}
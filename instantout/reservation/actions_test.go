@@ -57,6 +57,26 @@ func (m *mockReservationClient) OpenReservation(ctx context.Context,
 		args.Error(1)
 }
 
+func (m *mockReservationClient) QuoteReservation(ctx context.Context,
+	in *swapserverrpc.ServerQuoteReservationRequest,
+	opts ...grpc.CallOption) (*swapserverrpc.ServerQuoteReservationResponse,
+	error) {
+
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*swapserverrpc.ServerQuoteReservationResponse),
+		args.Error(1)
+}
+
+func (m *mockReservationClient) RequestReservation(ctx context.Context,
+	in *swapserverrpc.ServerRequestReservationRequest,
+	opts ...grpc.CallOption) (
+	*swapserverrpc.ServerRequestReservationResponse, error) {
+
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*swapserverrpc.ServerRequestReservationResponse),
+		args.Error(1)
+}
+
 func (m *mockReservationClient) ReservationNotificationStream(
 	ctx context.Context, in *swapserverrpc.ReservationNotificationRequest,
 	opts ...grpc.CallOption,
@@ -96,7 +116,7 @@ func (m *mockStore) CreateReservation(ctx context.Context,
 func TestInitReservationAction(t *testing.T) {
 	tests := []struct {
 		name             string
-		eventCtx         fsm.EventContext
+		eventCtx         *InitReservationContext
 		mockStoreErr     error
 		mockClientReturn *swapserverrpc.ServerOpenReservationResponse
 		mockClientErr    error
@@ -109,8 +129,8 @@ func TestInitReservationAction(t *testing.T) {
 			expectedEvent:    OnBroadcast,
 		},
 		{
-			name:          "invalid context",
-			eventCtx:      struct{}{},
+			name:          "missing context",
+			eventCtx:      nil,
 			expectedEvent: fsm.OnError,
 		},
 		{
@@ -151,8 +171,10 @@ func TestInitReservationAction(t *testing.T) {
 				ReservationClient: mockReservationClient,
 				Store:             mockStore,
 			},
-			StateMachine: &fsm.StateMachine{},
 		}
+		reservationFSM.TypedStateMachine = fsm.NewTypedStateMachine(
+			reservationFSM.GetReservationStates(), 0,
+		)
 
 		event := reservationFSM.InitAction(tc.eventCtx)
 		require.Equal(t, tc.expectedEvent, event)
@@ -229,7 +251,7 @@ func TestSubscribeToConfirmationAction(t *testing.T) {
 			chainNotifier := new(MockChainNotifier)
 
 			// Create the FSM.
-			r := NewFSMFromReservation(
+			r, err := NewFSMFromReservation(
 				context.Background(), &Config{
 					ChainNotifier: chainNotifier,
 				},
@@ -240,6 +262,8 @@ func TestSubscribeToConfirmationAction(t *testing.T) {
 					Value:        defaultValue,
 				},
 			)
+			require.NoError(t, err)
+
 			pkScript, err := r.reservation.GetPkScript()
 			require.NoError(t, err)
 
@@ -306,12 +330,11 @@ func TestSubscribeToConfirmationAction(t *testing.T) {
 	}
 }
 
-// AsyncWaitForExpiredOrSweptAction tests the AsyncWaitForExpiredOrSweptAction
-// of the reservation state machine.
-func TestAsyncWaitForExpiredOrSweptAction(t *testing.T) {
+// TestAsyncWaitForSpendAction tests the AsyncWaitForSpendAction of the
+// reservation state machine.
+func TestAsyncWaitForSpendAction(t *testing.T) {
 	tests := []struct {
 		name          string
-		blockErr      error
 		spendErr      error
 		expectedEvent fsm.EventType
 	}{
@@ -319,11 +342,6 @@ func TestAsyncWaitForExpiredOrSweptAction(t *testing.T) {
 			name:          "noop",
 			expectedEvent: fsm.NoOp,
 		},
-		{
-			name:          "block error",
-			blockErr:      errors.New("block error"),
-			expectedEvent: fsm.OnError,
-		},
 		{
 			name:          "spend error",
 			spendErr:      errors.New("spend error"),
@@ -337,7 +355,7 @@ func TestAsyncWaitForExpiredOrSweptAction(t *testing.T) {
 			chainNotifier := new(MockChainNotifier)
 
 			// Define your FSM
-			r := NewFSMFromReservation(
+			r, err := NewFSMFromReservation(
 				context.Background(), &Config{
 					ChainNotifier: chainNotifier,
 				},
@@ -347,12 +365,9 @@ func TestAsyncWaitForExpiredOrSweptAction(t *testing.T) {
 					Expiry:       defaultExpiry,
 				},
 			)
+			require.NoError(t, err)
 
 			// Define the expected return values for your mocks
-			chainNotifier.On("RegisterBlockEpochNtfn", mock.Anything).Return(
-				make(chan int32), make(chan error), tc.blockErr,
-			)
-
 			chainNotifier.On(
 				"RegisterSpendNtfn", mock.Anything,
 				mock.Anything, mock.Anything,
@@ -361,44 +376,41 @@ func TestAsyncWaitForExpiredOrSweptAction(t *testing.T) {
 				make(chan error), tc.spendErr,
 			)
 
-			eventType := r.AsyncWaitForExpiredOrSweptAction(nil)
+			eventType := r.AsyncWaitForSpendAction(nil)
 			// Assert that the return value is as expected
 			require.Equal(t, tc.expectedEvent, eventType)
 		})
 	}
 }
 
-// TesthandleSubcriptions tests the handleSubcriptions function of the
-// reservation state machine.
-func TestHandleSubcriptions(t *testing.T) {
-	var (
-		blockErr = errors.New("block error")
-		spendErr = errors.New("spend error")
-	)
+// TestWaitForSpend tests the waitForSpend function of the reservation state
+// machine.
+func TestWaitForSpend(t *testing.T) {
+	spendErr := errors.New("spend error")
 	tests := []struct {
 		name          string
-		blockHeight   int32
-		blockErr      error
 		spendDetail   *chainntnfs.SpendDetail
 		spendErr      error
 		expectedEvent fsm.EventType
 		expectedErr   error
 	}{
 		{
-			name:          "expired",
-			blockHeight:   100,
-			expectedEvent: OnTimedOut,
-		},
-		{
-			name:          "block error",
-			blockErr:      blockErr,
-			expectedEvent: fsm.OnError,
-			expectedErr:   blockErr,
+			name: "spent",
+			spendDetail: &chainntnfs.SpendDetail{
+				SpendingTx: spendingTx(wire.TxWitness{
+					make([]byte, 64),
+				}),
+			},
+			expectedEvent: OnSpent,
 		},
 		{
-			name:          "spent",
-			spendDetail:   &chainntnfs.SpendDetail{},
-			expectedEvent: OnSpent,
+			name: "swept by server",
+			spendDetail: &chainntnfs.SpendDetail{
+				SpendingTx: spendingTx(wire.TxWitness{
+					make([]byte, 64), {0x01}, {0x02},
+				}),
+			},
+			expectedEvent: OnSwept,
 		},
 		{
 			name:          "spend error",
@@ -414,7 +426,7 @@ func TestHandleSubcriptions(t *testing.T) {
 			chainNotifier := new(MockChainNotifier)
 
 			// Create the FSM.
-			r := NewFSMFromReservation(
+			r, err := NewFSMFromReservation(
 				context.Background(), &Config{
 					ChainNotifier: chainNotifier,
 				},
@@ -424,22 +436,12 @@ func TestHandleSubcriptions(t *testing.T) {
 					Expiry:       defaultExpiry,
 				},
 			)
-
-			blockChan := make(chan int32)
-			blockErrChan := make(chan error)
+			require.NoError(t, err)
 
 			spendChan := make(chan *chainntnfs.SpendDetail)
 			spendErrChan := make(chan error)
 
 			go func() {
-				if tc.blockHeight != 0 {
-					blockChan <- tc.blockHeight
-				}
-
-				if tc.blockErr != nil {
-					blockErrChan <- tc.blockErr
-				}
-
 				if tc.spendDetail != nil {
 					spendChan <- tc.spendDetail
 				}
@@ -448,12 +450,30 @@ func TestHandleSubcriptions(t *testing.T) {
 				}
 			}()
 
-			eventType, err := r.handleSubcriptions(
-				context.Background(), blockChan, spendChan,
-				blockErrChan, spendErrChan,
+			eventType, err := r.waitForSpend(
+				context.Background(), spendChan, spendErrChan,
 			)
 			require.Equal(t, tc.expectedErr, err)
 			require.Equal(t, tc.expectedEvent, eventType)
+
+			// A detected spend must be applied on the next
+			// transition.
+			if tc.spendDetail != nil {
+				require.Equal(
+					t, tc.spendDetail, r.takePendingSpend(),
+				)
+			}
 		})
 	}
 }
+
+// spendingTx returns a transaction that spends the reservation output with
+// the given witness.
+func spendingTx(witness wire.TxWitness) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		Witness: witness,
+	})
+
+	return tx
+}
@@ -0,0 +1,97 @@
+package reservation
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	// expiryAlertDelta is the number of blocks before the expiry of a
+	// reservation at which we alert that the reservation is about to
+	// expire.
+	expiryAlertDelta = 144
+)
+
+// AlertType describes why a reservation requires the user's attention.
+type AlertType uint8
+
+const (
+	// AlertExpiringSoon is raised once a reservation that hasn't been
+	// used is about to expire.
+	AlertExpiringSoon AlertType = iota
+
+	// AlertExpired is raised when a reservation expired without having
+	// been spent cooperatively.
+	AlertExpired
+
+	// AlertSweptByServer is raised when the server swept an expired
+	// reservation through the timeout path of the reservation script.
+	AlertSweptByServer
+)
+
+// String returns a human readable representation of the alert type.
+func (a AlertType) String() string {
+	switch a {
+	case AlertExpiringSoon:
+		return "ExpiringSoon"
+
+	case AlertExpired:
+		return "Expired"
+
+	case AlertSweptByServer:
+		return "SweptByServer"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(a))
+	}
+}
+
+// Alert is dispatched to the configured alert hook whenever a reservation
+// requires the user's attention.
+type Alert struct {
+	// Type is the type of the alert.
+	Type AlertType
+
+	// ReservationID is the id of the reservation the alert is about.
+	ReservationID ID
+
+	// Value is the value of the reservation.
+	Value btcutil.Amount
+
+	// Expiry is the absolute block height at which the reservation
+	// expires.
+	Expiry uint32
+
+	// Height is the block height at which the alert was raised. It is
+	// not set for AlertExpired.
+	Height int32
+
+	// SpendTxHash is the hash of the sweep transaction of the server. It
+	// is only set for AlertSweptByServer.
+	SpendTxHash *chainhash.Hash
+}
+
+// String returns a human readable representation of the alert.
+func (a Alert) String() string {
+	switch a.Type {
+	case AlertExpiringSoon:
+		return fmt.Sprintf("reservation %x of %v expires in %d blocks",
+			a.ReservationID, a.Value,
+			int32(a.Expiry)-a.Height)
+
+	case AlertExpired:
+		return fmt.Sprintf("reservation %x of %v expired at height %d",
+			a.ReservationID, a.Value, a.Expiry)
+
+	case AlertSweptByServer:
+		return fmt.Sprintf("reservation %x of %v was swept by the "+
+			"server in tx %v", a.ReservationID, a.Value,
+			a.SpendTxHash)
+
+	default:
+		return fmt.Sprintf("reservation %x: %v", a.ReservationID,
+			a.Type)
+	}
+}
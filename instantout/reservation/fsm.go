@@ -2,60 +2,112 @@ package reservation
 
 import (
 	"context"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
 
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/swapserverrpc"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 )
 
 const (
 	// defaultObserverSize is the size of the fsm observer channel.
 	defaultObserverSize = 15
+
+	// FSMKind is the kind under which the transitions of reservation
+	// state machines are recorded.
+	FSMKind = "reservation"
 )
 
+// CurrentRpcProtocolVersion returns the current rpc protocol version.
+func CurrentRpcProtocolVersion() swapserverrpc.ReservationProtocolVersion {
+	return swapserverrpc.
+		ReservationProtocolVersion_RESERVATION_CLIENT_REQUEST
+}
+
 // Config contains all the services that the reservation FSM needs to operate.
 type Config struct {
 	// Store is the database store for the reservations.
 	Store Store
 
+	// TransitionStore records the transitions of the reservation state
+	// machines, so that they can be resumed after a restart. If it is
+	// nil, the transitions aren't recorded.
+	TransitionStore fsm.Store
+
 	// Wallet handles the key derivation for the reservation.
 	Wallet lndclient.WalletKitClient
 
 	// ChainNotifier is used to subscribe to block notifications.
 	ChainNotifier lndclient.ChainNotifierClient
 
+	// LightningClient is used to decode the fee invoice of client
+	// requested reservations.
+	LightningClient lndclient.LightningClient
+
+	// RouterClient is used to pay the fee invoice of client requested
+	// reservations.
+	RouterClient lndclient.RouterClient
+
 	// ReservationClient is the client used to communicate with the
 	// swap server.
 	ReservationClient swapserverrpc.ReservationServiceClient
 
 	// FetchL402 is the function used to fetch the l402 token.
 	FetchL402 func(context.Context) error
+
+	// AlertHook is an optional function that is called whenever a
+	// reservation requires the user's attention, e.g. because it is about
+	// to expire or has been swept by the server. The manager additionally
+	// sends all alerts to its update subscribers. The hook must not block.
+	AlertHook func(Alert)
 }
 
 // FSM is the state machine that manages the reservation lifecycle.
 type FSM struct {
-	*fsm.StateMachine
+	*fsm.TypedStateMachine[*InitReservationContext]
 
 	cfg *Config
 
 	reservation *Reservation
 
 	ctx context.Context
+
+	// pendingSpend is the spend of the reservation output that has been
+	// detected but not yet persisted. It is applied to the reservation
+	// on the next state transition.
+	pendingSpend *chainntnfs.SpendDetail
+	spendMtx     sync.Mutex
+
+	// expiryAlerted is set once we alerted that the reservation is about
+	// to expire.
+	expiryAlerted atomic.Bool
 }
 
-// NewFSM creates a new reservation FSM.
-func NewFSM(ctx context.Context, cfg *Config) *FSM {
+// NewFSM creates a new reservation FSM for the reservation with the given id.
+func NewFSM(ctx context.Context, cfg *Config, id ID) (*FSM, error) {
 	reservation := &Reservation{
 		State: fsm.EmptyState,
 	}
 
-	return NewFSMFromReservation(ctx, cfg, reservation)
+	return newFSM(ctx, cfg, id, reservation)
 }
 
 // NewFSMFromReservation creates a new reservation FSM from an existing
 // reservation recovered from the database.
 func NewFSMFromReservation(ctx context.Context, cfg *Config,
-	reservation *Reservation) *FSM {
+	reservation *Reservation) (*FSM, error) {
+
+	return newFSM(ctx, cfg, reservation.ID, reservation)
+}
+
+// newFSM creates a new reservation FSM that starts out in the state of the
+// passed reservation. If a transition store is configured, the transitions
+// of the FSM are recorded under the given reservation id.
+func newFSM(ctx context.Context, cfg *Config, id ID,
+	reservation *Reservation) (*FSM, error) {
 
 	reservationFsm := &FSM{
 		ctx:         ctx,
@@ -63,13 +115,36 @@ func NewFSMFromReservation(ctx context.Context, cfg *Config,
 		reservation: reservation,
 	}
 
-	reservationFsm.StateMachine = fsm.NewStateMachineWithState(
-		reservationFsm.GetReservationStates(), reservation.State,
-		defaultObserverSize,
+	var (
+		states  = reservationFsm.GetReservationStates()
+		machine *fsm.TypedStateMachine[*InitReservationContext]
+		err     error
 	)
+	if cfg.TransitionStore == nil {
+		machine = fsm.NewTypedStateMachineWithState(
+			states, reservation.State, defaultObserverSize,
+		)
+	} else {
+		persistence := &fsm.TypedPersistenceConfig[*InitReservationContext]{
+			Kind:    FSMKind,
+			ID:      hex.EncodeToString(id[:]),
+			Store:   cfg.TransitionStore,
+			Context: ctx,
+		}
+
+		machine, err = fsm.NewTypedPersistentStateMachineWithState(
+			states, persistence, reservation.State,
+			defaultObserverSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reservationFsm.TypedStateMachine = machine
 	reservationFsm.ActionEntryFunc = reservationFsm.updateReservation
 
-	return reservationFsm
+	return reservationFsm, nil
 }
 
 // States.
@@ -139,15 +214,15 @@ var (
 
 // GetReservationStates returns the statemap that defines the reservation
 // state machine.
-func (f *FSM) GetReservationStates() fsm.States {
-	return fsm.States{
-		fsm.EmptyState: fsm.State{
+func (f *FSM) GetReservationStates() fsm.TypedStates[*InitReservationContext] {
+	return fsm.TypedStates[*InitReservationContext]{
+		fsm.EmptyState: {
 			Transitions: fsm.Transitions{
 				OnServerRequest: Init,
 			},
 			Action: nil,
 		},
-		Init: fsm.State{
+		Init: {
 			Transitions: fsm.Transitions{
 				OnBroadcast: WaitForConfirmation,
 				OnRecover:   Failed,
@@ -155,57 +230,90 @@ func (f *FSM) GetReservationStates() fsm.States {
 			},
 			Action: f.InitAction,
 		},
-		WaitForConfirmation: fsm.State{
+		WaitForConfirmation: {
 			Transitions: fsm.Transitions{
 				OnRecover:   WaitForConfirmation,
 				OnConfirmed: Confirmed,
 				OnTimedOut:  TimedOut,
+				fsm.OnError: Failed,
 			},
 			Action: f.SubscribeToConfirmationAction,
 		},
-		Confirmed: fsm.State{
+		Confirmed: {
 			Transitions: fsm.Transitions{
 				OnSpent:     Spent,
 				OnTimedOut:  TimedOut,
+				OnSwept:     TimedOut,
 				OnRecover:   Confirmed,
 				OnLocked:    Locked,
 				fsm.OnError: Confirmed,
 			},
-			Action: f.AsyncWaitForExpiredOrSweptAction,
+			Timeouts: f.expiryTimeouts(),
+			Action:   f.AsyncWaitForSpendAction,
 		},
-		Locked: fsm.State{
+		Locked: {
 			Transitions: fsm.Transitions{
 				OnUnlocked:  Confirmed,
 				OnTimedOut:  TimedOut,
+				OnSwept:     TimedOut,
 				OnRecover:   Locked,
 				OnSpent:     Spent,
 				fsm.OnError: Locked,
 			},
-			Action: f.AsyncWaitForExpiredOrSweptAction,
+			Timeouts: f.expiryTimeouts(),
+			Action:   f.AsyncWaitForSpendAction,
 		},
-		TimedOut: fsm.State{
+		TimedOut: {
 			Transitions: fsm.Transitions{
 				OnTimedOut: TimedOut,
+				OnSwept:    TimedOut,
+				OnSpent:    Spent,
+				OnRecover:  TimedOut,
 			},
-			Action: fsm.NoOpAction,
+			Action: f.AsyncWaitForSweepAction,
 		},
 
-		Spent: fsm.State{
+		Spent: {
 			Transitions: fsm.Transitions{
 				OnSpent: Spent,
 			},
-			Action: fsm.NoOpAction,
+			Action: fsm.TypedNoOpAction[*InitReservationContext],
 		},
 
-		Failed: fsm.State{
-			Action: fsm.NoOpAction,
+		Failed: {
+			Action: fsm.TypedNoOpAction[*InitReservationContext],
 		},
 	}
 }
 
+// expiryTimeouts returns the timeouts that time out the reservation once its
+// expiry height is reached.
+func (f *FSM) expiryTimeouts() []fsm.Timeout {
+	return []fsm.Timeout{{
+		Height: func() int32 {
+			return int32(f.reservation.Expiry)
+		},
+		Event: OnTimedOut,
+	}}
+}
+
+// notifyHeight passes a new block height to the state machine, which times
+// out the reservation once it expired. It also raises an alert if a confirmed
+// reservation is about to expire.
+func (f *FSM) notifyHeight(height int32) {
+	f.NotifyHeight(height)
+
+	switch f.CurrentState() {
+	case Confirmed, Locked:
+		f.checkExpiringSoon(height)
+	}
+}
+
 // updateReservation updates the reservation in the database. This function
 // is called after every new state transition.
-func (r *FSM) updateReservation(notification fsm.Notification) {
+func (r *FSM) updateReservation(
+	notification fsm.TypedNotification[*InitReservationContext]) {
+
 	if r.reservation == nil {
 		return
 	}
@@ -218,6 +326,14 @@ func (r *FSM) updateReservation(notification fsm.Notification) {
 
 	r.reservation.State = notification.NextState
 
+	// Apply a spend of the reservation output that was detected since the
+	// last transition, so that it's persisted together with the new state.
+	spend := r.takePendingSpend()
+	if spend != nil {
+		r.reservation.SpendTxHash = spend.SpenderTxHash
+		r.reservation.SpendHeight = uint32(spend.SpendingHeight)
+	}
+
 	// Don't update the reservation if we are in an initial state or if we
 	// are transitioning from an initial state to a failed state.
 	if r.reservation.State == fsm.EmptyState ||
@@ -232,6 +348,60 @@ func (r *FSM) updateReservation(notification fsm.Notification) {
 	if err != nil {
 		r.Errorf("unable to update reservation: %v", err)
 	}
+
+	// Alerts are raised once the new state is persisted, so that the
+	// stored reservation reflects the alert.
+	switch {
+	// Alerts for the resumed state were raised before the restart.
+	case notification.Event == fsm.OnResume:
+
+	case notification.Event == OnSwept && spend != nil:
+		r.alert(Alert{
+			Type:        AlertSweptByServer,
+			Height:      spend.SpendingHeight,
+			SpendTxHash: spend.SpenderTxHash,
+		})
+
+	case notification.NextState == TimedOut &&
+		notification.PreviousState != TimedOut:
+
+		r.alert(Alert{Type: AlertExpired})
+	}
+}
+
+// setPendingSpend stores a detected spend of the reservation output until it
+// is persisted on the next state transition.
+func (r *FSM) setPendingSpend(spend *chainntnfs.SpendDetail) {
+	r.spendMtx.Lock()
+	defer r.spendMtx.Unlock()
+
+	r.pendingSpend = spend
+}
+
+// takePendingSpend returns and clears the pending spend of the reservation
+// output.
+func (r *FSM) takePendingSpend() *chainntnfs.SpendDetail {
+	r.spendMtx.Lock()
+	defer r.spendMtx.Unlock()
+
+	spend := r.pendingSpend
+	r.pendingSpend = nil
+
+	return spend
+}
+
+// alert fills in the reservation details of the alert, logs it and passes
+// it to the alert hook if one is configured.
+func (r *FSM) alert(alert Alert) {
+	alert.ReservationID = r.reservation.ID
+	alert.Value = r.reservation.Value
+	alert.Expiry = r.reservation.Expiry
+
+	log.Warnf("Reservation alert: %v", alert)
+
+	if r.cfg.AlertHook != nil {
+		r.cfg.AlertHook(alert)
+	}
 }
 
 func (r *FSM) Infof(format string, args ...interface{}) {
@@ -263,3 +433,17 @@ func isFinalState(state fsm.StateType) bool {
 	}
 	return false
 }
+
+// awaitingSweep returns true if the reservation expired but we haven't seen
+// the spend of the reservation output yet.
+func awaitingSweep(reservation *Reservation) bool {
+	return reservation.State == TimedOut &&
+		reservation.Outpoint != nil &&
+		reservation.SpendTxHash == nil
+}
+
+// isActive returns true if the state machine of the reservation still needs
+// to run. Expired reservations are active until the server's sweep is seen.
+func isActive(reservation *Reservation) bool {
+	return !isFinalState(reservation.State) || awaitingSweep(reservation)
+}
@@ -0,0 +1,24 @@
+package reservation
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop/fsm"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckReservationStates statically checks the reservation states.
+func TestCheckReservationStates(t *testing.T) {
+	issues := fsm.CheckStates(
+		(&FSM{}).GetReservationStates(), &fsm.CheckConfig{
+			FinalStates: []fsm.StateType{Failed, TimedOut, Spent},
+			Events: []fsm.EventType{
+				OnServerRequest, OnBroadcast, OnConfirmed,
+				OnTimedOut, OnSwept, OnRecover, OnSpent,
+				OnLocked, OnUnlocked,
+			},
+		},
+	)
+
+	require.Empty(t, issues)
+}
@@ -2,6 +2,9 @@ package reservation
 
 import (
 	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -9,8 +12,30 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/fsm"
+	"github.com/lightninglabs/loop/swap"
 	reservationrpc "github.com/lightninglabs/loop/swapserverrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/queue"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// reservationExpiryTolerance is the number of blocks that the expiry
+	// of a requested reservation may fall short of the requested expiry,
+	// as the server might not have seen the latest block yet.
+	reservationExpiryTolerance = 3
+
+	// reservationFeeTimeout is the timeout for paying the fee of a
+	// requested reservation.
+	reservationFeeTimeout = time.Minute
+
+	// maxRoutingFeeBase and maxRoutingFeeRate limit the routing fee for
+	// paying the fee of a requested reservation.
+	maxRoutingFeeBase = btcutil.Amount(10)
+
+	maxRoutingFeeRate = int64(20000)
 )
 
 // Manager manages the reservation state machines.
@@ -25,17 +50,44 @@ type Manager struct {
 	// hasL402 is true if the client has a valid L402.
 	hasL402 bool
 
+	// currentHeight stores the currently best known block height.
+	currentHeight int32
+
 	runCtx context.Context
 
+	// subscribers contains the update queues of all clients that are
+	// subscribed to reservation updates.
+	subscribers map[int]chan<- interface{}
+
+	// nextSubscriberID is the id of the next update subscriber.
+	nextSubscriberID int
+
+	// subscribersLock guards the subscribers map and nextSubscriberID.
+	subscribersLock sync.Mutex
+
 	sync.Mutex
 }
 
 // NewManager creates a new reservation manager.
 func NewManager(cfg *Config) *Manager {
-	return &Manager{
-		cfg:                cfg,
+	m := &Manager{
 		activeReservations: make(map[ID]*FSM),
+		subscribers:        make(map[int]chan<- interface{}),
 	}
+
+	// Alerts are sent to the update subscribers as well, so that they
+	// reach the user and not only the log.
+	managerCfg := *cfg
+	managerCfg.AlertHook = func(alert Alert) {
+		if cfg.AlertHook != nil {
+			cfg.AlertHook(alert)
+		}
+
+		m.notifySubscribers(&alert)
+	}
+	m.cfg = &managerCfg
+
+	return m
 }
 
 // Run runs the reservation manager.
@@ -48,6 +100,10 @@ func (m *Manager) Run(ctx context.Context, height int32) error {
 	m.runCtx = runCtx
 	currentHeight := height
 
+	m.Lock()
+	m.currentHeight = height
+	m.Unlock()
+
 	err := m.RecoverReservations(runCtx)
 	if err != nil {
 		return err
@@ -74,6 +130,12 @@ func (m *Manager) Run(ctx context.Context, height int32) error {
 			log.Debugf("Received block %v", height)
 			currentHeight = height
 
+			m.Lock()
+			m.currentHeight = height
+			m.Unlock()
+
+			m.notifyHeight(height)
+
 		case reservationRes := <-reservationResChan:
 			log.Debugf("Received reservation %x",
 				reservationRes.ReservationId)
@@ -114,9 +176,12 @@ func (m *Manager) newReservation(ctx context.Context, currentHeight uint32,
 	// Create the reservation state machine. We need to pass in the runCtx
 	// of the reservation manager so that the state machine will keep on
 	// running even if the grpc conte
-	reservationFSM := NewFSM(
-		ctx, m.cfg,
-	)
+	reservationFSM, err := NewFSM(ctx, m.cfg, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	reservationFSM.NotifyHeight(int32(currentHeight))
+	m.observeReservation(reservationFSM)
 
 	// Add the reservation to the active reservations map.
 	m.Lock()
@@ -157,6 +222,237 @@ func (m *Manager) newReservation(ctx context.Context, currentHeight uint32,
 	return reservationFSM, nil
 }
 
+// QuoteReservation returns the fee the server charges for opening a
+// reservation with the given value and expiry in blocks.
+func (m *Manager) QuoteReservation(ctx context.Context, value btcutil.Amount,
+	expiry uint32) (btcutil.Amount, error) {
+
+	err := validateReservationRequest(value, expiry)
+	if err != nil {
+		return 0, err
+	}
+
+	quote, err := m.cfg.ReservationClient.QuoteReservation(
+		ctx, &reservationrpc.ServerQuoteReservationRequest{
+			Value:  uint64(value),
+			Expiry: expiry,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return btcutil.Amount(quote.Fee), nil
+}
+
+// RequestReservation requests a new reservation with the given value and
+// expiry in blocks from the server. The parameters returned by the server are
+// validated and its fee invoice is checked against the server's quote. The
+// reservation is then opened just like a reservation that was offered by the
+// server. Only once the server acknowledged our side of the reservation, its
+// fee is paid, if it doesn't exceed maxFee. If the payment fails, the server
+// never funds the reservation and it times out. The reservation and the paid
+// fee are returned.
+func (m *Manager) RequestReservation(ctx context.Context, value btcutil.Amount,
+	expiry uint32, maxFee btcutil.Amount) (*Reservation, btcutil.Amount,
+	error) {
+
+	err := validateReservationRequest(value, expiry)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if m.runCtx == nil {
+		return nil, 0, errors.New("reservation manager not running")
+	}
+
+	m.Lock()
+	currentHeight := m.currentHeight
+	m.Unlock()
+
+	quote, err := m.cfg.ReservationClient.QuoteReservation(
+		ctx, &reservationrpc.ServerQuoteReservationRequest{
+			Value:  uint64(value),
+			Expiry: expiry,
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, err := m.cfg.ReservationClient.RequestReservation(
+		ctx, &reservationrpc.ServerRequestReservationRequest{
+			Value:           uint64(value),
+			Expiry:          expiry,
+			ProtocolVersion: CurrentRpcProtocolVersion(),
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fee, err := m.validateRequestedReservation(
+		ctx, res, quote, value, uint32(currentHeight)+expiry,
+		currentHeight, maxFee,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The reservation state machine needs to keep on running after the
+	// request returns, so we pass in the run context of the manager.
+	reservationFSM, err := m.newReservation(
+		m.runCtx, uint32(currentHeight),
+		&reservationrpc.ServerReservationNotification{
+			ReservationId: res.ReservationId,
+			Value:         res.Value,
+			ServerKey:     res.ServerKey,
+			Expiry:        res.Expiry,
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if fee > 0 {
+		err = m.payReservationFee(ctx, res.FeeInvoice, fee)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to pay reservation "+
+				"fee: %w", err)
+		}
+	}
+
+	return reservationFSM.reservation, fee, nil
+}
+
+// validateReservationRequest checks the parameters of a client requested
+// reservation.
+func validateReservationRequest(value btcutil.Amount, expiry uint32) error {
+	if value <= 0 {
+		return errors.New("reservation value must be positive")
+	}
+
+	if expiry == 0 {
+		return errors.New("reservation expiry must be positive")
+	}
+
+	if expiry > MaxExpiryDelta {
+		return fmt.Errorf("reservation expiry must not exceed %v "+
+			"blocks", MaxExpiryDelta)
+	}
+
+	return nil
+}
+
+// validateRequestedReservation checks that the reservation offered by the
+// server matches the requested parameters and that its fee invoice matches the
+// server's quote. It returns the fee the server charges for the reservation.
+func (m *Manager) validateRequestedReservation(ctx context.Context,
+	res *reservationrpc.ServerRequestReservationResponse,
+	quote *reservationrpc.ServerQuoteReservationResponse,
+	value btcutil.Amount, expiry uint32, currentHeight int32,
+	maxFee btcutil.Amount) (btcutil.Amount, error) {
+
+	var id ID
+	err := id.FromByteSlice(res.ReservationId)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = btcec.ParsePubKey(res.ServerKey)
+	if err != nil {
+		return 0, fmt.Errorf("invalid server key: %w", err)
+	}
+
+	if btcutil.Amount(res.Value) != value {
+		return 0, fmt.Errorf("server offered reservation value %v, "+
+			"expected %v", btcutil.Amount(res.Value), value)
+	}
+
+	// The server might be a few blocks behind us, so we allow for a
+	// small tolerance.
+	err = ValidateExpiry(
+		res.Expiry, expiry-reservationExpiryTolerance, currentHeight,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("server offered invalid reservation "+
+			"expiry: %w", err)
+	}
+
+	if res.FeeInvoice == "" {
+		return 0, nil
+	}
+
+	payReq, err := m.cfg.LightningClient.DecodePaymentRequest(
+		ctx, res.FeeInvoice,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	fee := payReq.Value.ToSatoshis()
+	if fee != btcutil.Amount(quote.Fee) {
+		return 0, fmt.Errorf("reservation fee invoice amount %v "+
+			"doesn't match quoted fee %v", fee,
+			btcutil.Amount(quote.Fee))
+	}
+
+	payee, err := route.NewVertexFromBytes(quote.FeePayee)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quoted fee payee: %w", err)
+	}
+
+	if payReq.Destination != payee {
+		return 0, fmt.Errorf("reservation fee invoice pays to %v, "+
+			"expected quoted payee %v", payReq.Destination, payee)
+	}
+
+	if fee > maxFee {
+		return 0, fmt.Errorf("reservation fee %v exceeds max fee %v",
+			fee, maxFee)
+	}
+
+	return fee, nil
+}
+
+// payReservationFee pays the fee invoice of a client requested reservation.
+func (m *Manager) payReservationFee(ctx context.Context, invoice string,
+	fee btcutil.Amount) error {
+
+	payChan, errChan, err := m.cfg.RouterClient.SendPayment(
+		ctx, lndclient.SendPaymentRequest{
+			Invoice: invoice,
+			Timeout: reservationFeeTimeout,
+			MaxFee: swap.CalcFee(
+				fee, maxRoutingFeeBase, maxRoutingFeeRate,
+			),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case status := <-payChan:
+			switch status.State {
+			case lnrpc.Payment_SUCCEEDED:
+				return nil
+
+			case lnrpc.Payment_FAILED:
+				return fmt.Errorf("payment failed: %v",
+					status.FailureReason)
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // fetchL402 fetches the L402 from the server. This method will keep on
 // retrying until it gets a valid response.
 func (m *Manager) fetchL402(ctx context.Context) {
@@ -252,45 +548,359 @@ func (m *Manager) RegisterReservationNotifications(
 	return nil
 }
 
-// RecoverReservations tries to recover all reservations that are still active
-// from the database.
+// notifyHeight passes a new block height to the state machines of all active
+// reservations.
+func (m *Manager) notifyHeight(height int32) {
+	m.Lock()
+	reservations := make([]*FSM, 0, len(m.activeReservations))
+	for _, reservationFSM := range m.activeReservations {
+		reservations = append(reservations, reservationFSM)
+	}
+	m.Unlock()
+
+	for _, reservationFSM := range reservations {
+		reservationFSM.notifyHeight(height)
+	}
+}
+
+// CurrentHeight returns the best block height known to the manager.
+func (m *Manager) CurrentHeight() int32 {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.currentHeight
+}
+
+// RecoverReservations resumes the state machines of all reservations that are
+// still active from their last recorded transition.
 func (m *Manager) RecoverReservations(ctx context.Context) error {
+	if m.cfg.TransitionStore == nil {
+		return errors.New("transition store required to recover " +
+			"reservations")
+	}
+
+	err := m.recordLegacyTransitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = fsm.ResumeTypedMachines(
+		ctx, m.cfg.TransitionStore, FSMKind,
+		[]fsm.StateType{Failed, Spent}, m.reservationFactory(ctx),
+	)
+
+	return err
+}
+
+// recordLegacyTransitions records the stored state of active reservations
+// that were created before the transitions of their state machines were
+// recorded, so that they are resumed in that state.
+func (m *Manager) recordLegacyTransitions(ctx context.Context) error {
 	reservations, err := m.cfg.Store.ListReservations(ctx)
 	if err != nil {
 		return err
 	}
 
 	for _, reservation := range reservations {
-		if isFinalState(reservation.State) {
+		if !isActive(reservation) {
 			continue
 		}
 
-		log.Debugf("Recovering reservation %x", reservation.ID)
+		id := hex.EncodeToString(reservation.ID[:])
+		transitions, err := m.cfg.TransitionStore.Transitions(
+			ctx, FSMKind, id,
+		)
+		if err != nil {
+			return err
+		}
 
-		fsmCtx := context.WithValue(ctx, reservation.ID, nil)
+		if len(transitions) > 0 {
+			continue
+		}
 
-		reservationFSM := NewFSMFromReservation(
-			fsmCtx, m.cfg, reservation,
+		err = m.cfg.TransitionStore.RecordTransition(
+			ctx, &fsm.Transition{
+				Kind:          FSMKind,
+				ID:            id,
+				PreviousState: reservation.State,
+				NextState:     reservation.State,
+				Event:         OnRecover,
+				Timestamp:     time.Now(),
+			},
 		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-		m.activeReservations[reservation.ID] = reservationFSM
+// reservationFactory returns the factory that creates the state machines of
+// the reservations that are resumed.
+func (m *Manager) reservationFactory(
+	ctx context.Context) fsm.TypedMachineFactory[*InitReservationContext] {
 
-		// As SendEvent can block, we'll start a goroutine to process
-		// the event.
-		go func() {
-			err := reservationFSM.SendEvent(OnRecover, nil)
-			if err != nil {
-				log.Errorf("FSM %v Error sending recover "+
-					"event %v, state: %v",
-					reservationFSM.reservation.ID, err,
-					reservationFSM.reservation.State)
-			}
-		}()
+	return func(transition *fsm.Transition) (
+		*fsm.TypedStateMachine[*InitReservationContext], error) {
+
+		idBytes, err := hex.DecodeString(transition.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		var id ID
+		err = id.FromByteSlice(idBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		var reservationFSM *FSM
+		reservation, err := m.cfg.Store.GetReservation(ctx, id)
+		switch {
+		// The reservation is only stored once it was opened with the
+		// server. If that was interrupted, the resumed init action
+		// fails the reservation.
+		case errors.Is(err, sql.ErrNoRows) &&
+			transition.NextState == Init:
+
+			reservationFSM, err = NewFSM(ctx, m.cfg, id)
+
+		case err != nil:
+			return nil, err
+
+		// Expired reservations stay in the TimedOut state once their
+		// sweep was recorded, so there's nothing left to resume.
+		case !isActive(reservation):
+			return nil, nil
+
+		default:
+			reservationFSM, err = NewFSMFromReservation(
+				ctx, m.cfg, reservation,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Change reservations of instant outs may already have been
+		// added.
+		m.Lock()
+		defer m.Unlock()
+
+		if _, ok := m.activeReservations[id]; ok {
+			return nil, nil
+		}
+
+		log.Debugf("Recovering reservation %x", id)
+
+		reservationFSM.NotifyHeight(m.currentHeight)
+		m.observeReservation(reservationFSM)
+		m.activeReservations[id] = reservationFSM
+
+		return reservationFSM.TypedStateMachine, nil
+	}
+}
+
+// AddChangeReservation stores the change reservation of an instant out and
+// starts waiting for its confirmation. The change reservation is created by
+// the sweepless sweep or htlc tx of the instant out, so there is no need to
+// negotiate it with the server. Adding an already known reservation is a
+// no-op.
+func (m *Manager) AddChangeReservation(ctx context.Context,
+	changeReservation *Reservation) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.activeReservations[changeReservation.ID]; ok {
+		return nil
+	}
+
+	_, err := m.cfg.Store.GetReservation(ctx, changeReservation.ID)
+	switch {
+	// The reservation is already stored, so it is either active or
+	// recovered on startup.
+	case err == nil:
+		return nil
+
+	case !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+
+	if m.runCtx == nil {
+		return errors.New("reservation manager not running")
+	}
+
+	log.Debugf("Adding change reservation %x", changeReservation.ID)
+
+	res := *changeReservation
+	res.State = WaitForConfirmation
+
+	err = m.cfg.Store.CreateReservation(ctx, &res)
+	if err != nil {
+		return err
 	}
 
+	reservationFSM, err := NewFSMFromReservation(m.runCtx, m.cfg, &res)
+	if err != nil {
+		return err
+	}
+	reservationFSM.NotifyHeight(m.currentHeight)
+	m.observeReservation(reservationFSM)
+	m.activeReservations[res.ID] = reservationFSM
+
+	// The change reservation starts out waiting for its confirmation,
+	// which is the same as recovering a reservation in that state.
+	go func() {
+		err := reservationFSM.SendEvent(OnRecover, nil)
+		if err != nil {
+			log.Errorf("Error sending recover event for change "+
+				"reservation %x: %v", res.ID, err)
+		}
+	}()
+
 	return nil
 }
 
+// Update is sent to the subscribers of reservation updates.
+type Update struct {
+	// Reservation is a copy of the reservation at the time of the update.
+	// For alerts, it is the stored reservation.
+	Reservation *Reservation
+
+	// Alert is set if the update was sent because the reservation
+	// requires the user's attention.
+	Alert *Alert
+}
+
+// SubscribeReservationUpdates returns a channel that receives a copy of a
+// reservation every time its state machine transitions to a new state or
+// raises an alert. The subscription is cancelled once the passed context is
+// done.
+func (m *Manager) SubscribeReservationUpdates(
+	ctx context.Context) <-chan *Update {
+
+	// Start a notification queue for this subscriber, so that a slow
+	// subscriber never blocks a reservation state machine.
+	updateQueue := queue.NewConcurrentQueue(20)
+	updateQueue.Start()
+
+	m.subscribersLock.Lock()
+	id := m.nextSubscriberID
+	m.nextSubscriberID++
+	m.subscribers[id] = updateQueue.ChanIn()
+	m.subscribersLock.Unlock()
+
+	updates := make(chan *Update)
+	go func() {
+		defer func() {
+			m.subscribersLock.Lock()
+			delete(m.subscribers, id)
+			m.subscribersLock.Unlock()
+			updateQueue.Stop()
+		}()
+
+		for {
+			select {
+			case item, ok := <-updateQueue.ChanOut():
+				if !ok {
+					return
+				}
+
+				update, err := m.toUpdate(ctx, item)
+				if err != nil {
+					log.Errorf("Unable to create reservation "+
+						"update: %v", err)
+
+					continue
+				}
+
+				select {
+				case updates <- update:
+
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates
+}
+
+// toUpdate converts an item of a subscriber's update queue into an update. An
+// alert is sent together with the stored reservation it is about.
+func (m *Manager) toUpdate(ctx context.Context, item interface{}) (*Update,
+	error) {
+
+	switch item := item.(type) {
+	case *Reservation:
+		return &Update{Reservation: item}, nil
+
+	case *Alert:
+		res, err := m.cfg.Store.GetReservation(ctx, item.ReservationID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Update{
+			Reservation: res,
+			Alert:       item,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown update type %T", item)
+	}
+}
+
+// observeReservation registers an observer on the passed reservation FSM that
+// forwards all state changes of the reservation to the update subscribers.
+func (m *Manager) observeReservation(reservationFSM *FSM) {
+	reservationFSM.RegisterObserver(&updateObserver{
+		manager:        m,
+		reservationFSM: reservationFSM,
+	})
+}
+
+// updateObserver is an fsm.TypedObserver that forwards the state changes of a
+// reservation to the update subscribers of the manager.
+type updateObserver struct {
+	manager        *Manager
+	reservationFSM *FSM
+}
+
+// Notify implements the fsm.TypedObserver interface.
+func (o *updateObserver) Notify(
+	notification fsm.TypedNotification[*InitReservationContext]) {
+
+	// The reservation is only populated once it has been initialized.
+	res := o.reservationFSM.reservation
+	if res == nil || res.ID == (ID{}) {
+		return
+	}
+
+	// The observer is notified before the reservation itself is updated,
+	// so we send a copy with the new state.
+	update := *res
+	update.State = notification.NextState
+
+	o.manager.notifySubscribers(&update)
+}
+
+// notifySubscribers sends a reservation update or alert to all subscribers.
+func (m *Manager) notifySubscribers(update interface{}) {
+	m.subscribersLock.Lock()
+	defer m.subscribersLock.Unlock()
+
+	for _, subscriber := range m.subscribers {
+		subscriber <- update
+	}
+}
+
 // GetReservations retrieves all reservations from the database.
 func (m *Manager) GetReservations(ctx context.Context) ([]*Reservation, error) {
 	return m.cfg.Store.ListReservations(ctx)
@@ -303,6 +913,19 @@ func (m *Manager) GetReservation(ctx context.Context, id ID) (*Reservation,
 	return m.cfg.Store.GetReservation(ctx, id)
 }
 
+// GetActiveReservation returns the state machine of an active reservation.
+func (m *Manager) GetActiveReservation(id ID) (*FSM, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	reservationFSM, ok := m.activeReservations[id]
+	if !ok {
+		return nil, ErrReservationNotFound
+	}
+
+	return reservationFSM, nil
+}
+
 // LockReservation locks the reservation with the given ID.
 func (m *Manager) LockReservation(ctx context.Context, id ID) error {
 	// Try getting the reservation from the active reservations map.
@@ -6,11 +6,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swapserverrpc"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -88,6 +95,9 @@ func TestManager(t *testing.T) {
 
 	testContext.mockLnd.SpendChannel <- &chainntnfs.SpendDetail{
 		SpentOutPoint: spendReg.Outpoint,
+		SpendingTx: spendingTx(wire.TxWitness{
+			make([]byte, 64),
+		}),
 	}
 
 	// We'll now expect the reservation to be expired.
@@ -95,6 +105,552 @@ func TestManager(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestAddChangeReservation tests that the change reservation of an instant out
+// is stored and waits for its confirmation.
+func TestAddChangeReservation(t *testing.T) {
+	ctxb, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testContext := newManagerTestContext(t)
+	manager := testContext.manager
+	manager.runCtx = ctxb
+
+	height := uint32(testContext.mockLnd.Height)
+	changeReservation, err := NewReservation(
+		defaultReservationId, defaultPubkey, defaultPubkey,
+		defaultValue, height+defaultExpiry, height,
+		keychain.KeyLocator{Family: keychain.KeyFamily(KeyFamily)},
+	)
+	require.NoError(t, err)
+
+	err = manager.AddChangeReservation(ctxb, changeReservation)
+	require.NoError(t, err)
+
+	stored, err := manager.GetReservation(ctxb, defaultReservationId)
+	require.NoError(t, err)
+	require.Equal(t, WaitForConfirmation, stored.State)
+
+	// The change reservation waits for its confirmation.
+	pkScript, err := changeReservation.GetPkScript()
+	require.NoError(t, err)
+
+	confReg := <-testContext.mockLnd.RegisterConfChannel
+	require.Equal(t, pkScript, confReg.PkScript)
+
+	// Adding the change reservation again is a no-op.
+	err = manager.AddChangeReservation(ctxb, changeReservation)
+	require.NoError(t, err)
+
+	confReg.ConfChan <- &chainntnfs.TxConfirmation{
+		BlockHeight: height,
+		Tx: &wire.MsgTx{
+			TxOut: []*wire.TxOut{{PkScript: pkScript}},
+		},
+	}
+
+	manager.Lock()
+	reservationFSM := manager.activeReservations[defaultReservationId]
+	manager.Unlock()
+
+	err = reservationFSM.DefaultObserver.WaitForState(
+		ctxb, 5*time.Second, Confirmed,
+	)
+	require.NoError(t, err)
+
+	spendReg := <-testContext.mockLnd.RegisterSpendChannel
+	require.Equal(t, pkScript, spendReg.PkScript)
+}
+
+// TestRecoverExpiredReservation tests that an expired reservation is
+// recovered until the server's sweep of the reservation output is recorded
+// and that the sweep is reported to the update subscribers.
+func TestRecoverExpiredReservation(t *testing.T) {
+	ctxb, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testContext := newManagerTestContext(t)
+	manager := testContext.manager
+
+	updates := manager.SubscribeReservationUpdates(ctxb)
+
+	height := uint32(testContext.mockLnd.Height)
+	expired, err := NewReservation(
+		defaultReservationId, defaultPubkey, defaultPubkey,
+		defaultValue, height, height-defaultExpiry,
+		keychain.KeyLocator{Family: keychain.KeyFamily(KeyFamily)},
+	)
+	require.NoError(t, err)
+
+	expired.State = TimedOut
+	expired.Outpoint = &wire.OutPoint{Index: 1}
+
+	err = manager.cfg.Store.CreateReservation(ctxb, expired)
+	require.NoError(t, err)
+	err = manager.cfg.Store.UpdateReservation(ctxb, expired)
+	require.NoError(t, err)
+
+	err = manager.RecoverReservations(ctxb)
+	require.NoError(t, err)
+
+	// The recovered reservation waits for the sweep of the server.
+	spendReg := <-testContext.mockLnd.RegisterSpendChannel
+	require.Equal(t, expired.Outpoint, spendReg.Outpoint)
+
+	sweepTx := spendingTx(wire.TxWitness{
+		make([]byte, 64), {0x01}, {0x02},
+	})
+	sweepTxHash := sweepTx.TxHash()
+	testContext.mockLnd.SpendChannel <- &chainntnfs.SpendDetail{
+		SpentOutPoint:  spendReg.Outpoint,
+		SpendingTx:     sweepTx,
+		SpenderTxHash:  &sweepTxHash,
+		SpendingHeight: int32(height) + 1,
+	}
+
+	// State updates of the recovered reservation may arrive before the
+	// alert.
+	var alertUpdate *Update
+	for alertUpdate == nil {
+		select {
+		case update := <-updates:
+			if update.Alert != nil {
+				alertUpdate = update
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected sweep alert")
+		}
+	}
+
+	alert := alertUpdate.Alert
+	require.Equal(t, AlertSweptByServer, alert.Type)
+	require.Equal(t, defaultReservationId, alert.ReservationID)
+	require.Equal(t, &sweepTxHash, alert.SpendTxHash)
+	require.Equal(t, defaultReservationId, alertUpdate.Reservation.ID)
+	require.Equal(t, &sweepTxHash, alertUpdate.Reservation.SpendTxHash)
+
+	require.Eventually(t, func() bool {
+		stored, err := manager.cfg.Store.GetReservation(
+			ctxb, defaultReservationId,
+		)
+		require.NoError(t, err)
+
+		return stored.SpendTxHash != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stored, err := manager.cfg.Store.GetReservation(
+		ctxb, defaultReservationId,
+	)
+	require.NoError(t, err)
+	require.Equal(t, TimedOut, stored.State)
+	require.Equal(t, sweepTxHash, *stored.SpendTxHash)
+	require.Equal(t, height+1, stored.SpendHeight)
+
+	// Once the sweep is recorded, the reservation isn't recovered again.
+	require.False(t, awaitingSweep(stored))
+}
+
+// TestReservationExpiry tests that a confirmed reservation raises an alert
+// once it is about to expire and times out at its expiry height.
+func TestReservationExpiry(t *testing.T) {
+	ctxb, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testContext := newManagerTestContext(t)
+	manager := testContext.manager
+	manager.runCtx = ctxb
+
+	updates := manager.SubscribeReservationUpdates(ctxb)
+
+	height := testContext.mockLnd.Height
+	res, err := NewReservation(
+		defaultReservationId, defaultPubkey, defaultPubkey,
+		defaultValue, uint32(height)+defaultExpiry, uint32(height),
+		keychain.KeyLocator{Family: keychain.KeyFamily(KeyFamily)},
+	)
+	require.NoError(t, err)
+
+	err = manager.AddChangeReservation(ctxb, res)
+	require.NoError(t, err)
+
+	reservationFSM, err := manager.GetActiveReservation(
+		defaultReservationId,
+	)
+	require.NoError(t, err)
+
+	pkScript, err := res.GetPkScript()
+	require.NoError(t, err)
+
+	confReg := <-testContext.mockLnd.RegisterConfChannel
+	confReg.ConfChan <- &chainntnfs.TxConfirmation{
+		BlockHeight: uint32(height),
+		Tx: &wire.MsgTx{
+			TxOut: []*wire.TxOut{{PkScript: pkScript}},
+		},
+	}
+
+	err = reservationFSM.DefaultObserver.WaitForState(
+		ctxb, 5*time.Second, Confirmed,
+	)
+	require.NoError(t, err)
+	<-testContext.mockLnd.RegisterSpendChannel
+
+	// waitForAlert waits for an alert of the given type.
+	waitForAlert := func(alertType AlertType) {
+		t.Helper()
+
+		for {
+			select {
+			case update := <-updates:
+				if update.Alert == nil {
+					continue
+				}
+
+				require.Equal(t, alertType, update.Alert.Type)
+
+				return
+
+			case <-time.After(5 * time.Second):
+				t.Fatalf("expected %v alert", alertType)
+			}
+		}
+	}
+
+	// Shortly before the expiry, the reservation is still confirmed but
+	// an alert is raised.
+	expiry := int32(res.Expiry)
+	manager.notifyHeight(expiry - expiryAlertDelta)
+	waitForAlert(AlertExpiringSoon)
+	require.Equal(t, Confirmed, reservationFSM.CurrentState())
+
+	// The height timeout of the state times out the reservation.
+	manager.notifyHeight(expiry)
+	err = reservationFSM.DefaultObserver.WaitForState(
+		ctxb, 5*time.Second, TimedOut,
+	)
+	require.NoError(t, err)
+	waitForAlert(AlertExpired)
+}
+
+// TestResumeReservations tests that reservations are resumed from their last
+// recorded transition and that an interrupted reservation request fails.
+func TestResumeReservations(t *testing.T) {
+	ctxb, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testContext := newManagerTestContext(t)
+	manager := testContext.manager
+	transitionStore := manager.cfg.TransitionStore
+
+	height := uint32(testContext.mockLnd.Height)
+	confirmed, err := NewReservation(
+		defaultReservationId, defaultPubkey, defaultPubkey,
+		defaultValue, height+defaultExpiry, height,
+		keychain.KeyLocator{Family: keychain.KeyFamily(KeyFamily)},
+	)
+	require.NoError(t, err)
+
+	confirmed.State = Confirmed
+	confirmed.Outpoint = &wire.OutPoint{Index: 1}
+
+	err = manager.cfg.Store.CreateReservation(ctxb, confirmed)
+	require.NoError(t, err)
+	err = manager.cfg.Store.UpdateReservation(ctxb, confirmed)
+	require.NoError(t, err)
+
+	confirmedID := hex.EncodeToString(defaultReservationId[:])
+	err = transitionStore.RecordTransition(ctxb, &fsm.Transition{
+		Kind:          FSMKind,
+		ID:            confirmedID,
+		PreviousState: WaitForConfirmation,
+		NextState:     Confirmed,
+		Event:         OnConfirmed,
+		Timestamp:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	// The request of this reservation was interrupted before it was
+	// stored.
+	var initID ID
+	initID[0] = 1
+	err = transitionStore.RecordTransition(ctxb, &fsm.Transition{
+		Kind:          FSMKind,
+		ID:            hex.EncodeToString(initID[:]),
+		PreviousState: fsm.EmptyState,
+		NextState:     Init,
+		Event:         OnServerRequest,
+		Timestamp:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	err = manager.RecoverReservations(ctxb)
+	require.NoError(t, err)
+
+	// The confirmed reservation waits for its expiry or spend again.
+	spendReg := <-testContext.mockLnd.RegisterSpendChannel
+	require.Equal(t, confirmed.Outpoint, spendReg.Outpoint)
+
+	reservationFSM, err := manager.GetActiveReservation(
+		defaultReservationId,
+	)
+	require.NoError(t, err)
+	require.Equal(t, Confirmed, reservationFSM.CurrentState())
+
+	// The interrupted request fails without a stored reservation.
+	require.Eventually(t, func() bool {
+		transitions, err := transitionStore.Transitions(
+			ctxb, FSMKind, hex.EncodeToString(initID[:]),
+		)
+		require.NoError(t, err)
+
+		last := transitions[len(transitions)-1]
+
+		return last.NextState == Failed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = manager.cfg.Store.GetReservation(ctxb, initID)
+	require.Error(t, err)
+}
+
+// TestSubscribeReservationUpdates tests that subscribers receive the state
+// changes of reservations and are removed once their context is done.
+func TestSubscribeReservationUpdates(t *testing.T) {
+	ctxb, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testContext := newManagerTestContext(t)
+	manager := testContext.manager
+	manager.runCtx = ctxb
+
+	subscribeCtx, cancelSubscription := context.WithCancel(ctxb)
+	updates := manager.SubscribeReservationUpdates(subscribeCtx)
+
+	expectUpdate := func(state fsm.StateType) {
+		t.Helper()
+
+		select {
+		case update := <-updates:
+			require.Nil(t, update.Alert)
+			require.Equal(
+				t, defaultReservationId, update.Reservation.ID,
+			)
+			require.Equal(t, state, update.Reservation.State)
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected update to state %v", state)
+		}
+	}
+
+	height := uint32(testContext.mockLnd.Height)
+	changeReservation, err := NewReservation(
+		defaultReservationId, defaultPubkey, defaultPubkey,
+		defaultValue, height+defaultExpiry, height,
+		keychain.KeyLocator{Family: keychain.KeyFamily(KeyFamily)},
+	)
+	require.NoError(t, err)
+
+	err = manager.AddChangeReservation(ctxb, changeReservation)
+	require.NoError(t, err)
+	expectUpdate(WaitForConfirmation)
+
+	pkScript, err := changeReservation.GetPkScript()
+	require.NoError(t, err)
+
+	confReg := <-testContext.mockLnd.RegisterConfChannel
+	confReg.ConfChan <- &chainntnfs.TxConfirmation{
+		BlockHeight: height,
+		Tx: &wire.MsgTx{
+			TxOut: []*wire.TxOut{{PkScript: pkScript}},
+		},
+	}
+	expectUpdate(Confirmed)
+
+	<-testContext.mockLnd.RegisterSpendChannel
+
+	// Once the subscription is cancelled, the subscriber is removed.
+	cancelSubscription()
+	require.Eventually(t, func() bool {
+		manager.subscribersLock.Lock()
+		defer manager.subscribersLock.Unlock()
+
+		return len(manager.subscribers) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// mockFeeInvoiceDecoder decodes every invoice into an invoice that pays the
+// given fee to the given payee.
+type mockFeeInvoiceDecoder struct {
+	lndclient.LightningClient
+
+	fee   btcutil.Amount
+	payee route.Vertex
+}
+
+// DecodePaymentRequest implements the lndclient.LightningClient interface.
+func (m *mockFeeInvoiceDecoder) DecodePaymentRequest(_ context.Context,
+	_ string) (*lndclient.PaymentRequest, error) {
+
+	return &lndclient.PaymentRequest{
+		Destination: m.payee,
+		Value:       lnwire.NewMSatFromSatoshis(m.fee),
+	}, nil
+}
+
+// TestRequestReservation tests that a reservation requested by the client is
+// validated against the request and the server's quote, opened and only paid
+// for afterwards.
+func TestRequestReservation(t *testing.T) {
+	ctxb, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const fee = btcutil.Amount(50)
+
+	testContext := newManagerTestContext(t)
+	manager := testContext.manager
+	manager.runCtx = ctxb
+	manager.currentHeight = testContext.mockLnd.Height
+	payee := route.Vertex{2, 1}
+	decoder := &mockFeeInvoiceDecoder{fee: fee, payee: payee}
+	manager.cfg.LightningClient = decoder
+	manager.cfg.RouterClient = testContext.mockLnd.Router
+
+	testContext.mockReservationClient.On(
+		"QuoteReservation", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return(&swapserverrpc.ServerQuoteReservationResponse{
+		Fee:      uint64(fee),
+		FeePayee: payee[:],
+	}, nil)
+
+	// Requests for an expiry beyond the maximum are rejected right away.
+	_, _, err := manager.RequestReservation(
+		ctxb, defaultValue, MaxExpiryDelta+1, fee,
+	)
+	require.ErrorContains(t, err, "reservation expiry")
+
+	height := uint32(testContext.mockLnd.Height)
+	newResponse := func() *swapserverrpc.ServerRequestReservationResponse {
+		return &swapserverrpc.ServerRequestReservationResponse{
+			ReservationId: defaultReservationId[:],
+			Value:         uint64(defaultValue),
+			ServerKey:     defaultPubkeyBytes,
+			Expiry:        height + defaultExpiry,
+			FeeInvoice:    "feeinvoice",
+		}
+	}
+
+	// A reservation with a different value than requested is rejected.
+	invalidValue := newResponse()
+	invalidValue.Value++
+	testContext.mockReservationClient.On(
+		"RequestReservation", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return(invalidValue, nil).Once()
+
+	_, _, err = manager.RequestReservation(
+		ctxb, defaultValue, defaultExpiry, fee,
+	)
+	require.ErrorContains(t, err, "reservation value")
+
+	// A reservation that expires too early is rejected.
+	invalidExpiry := newResponse()
+	invalidExpiry.Expiry -= reservationExpiryTolerance + 1
+	testContext.mockReservationClient.On(
+		"RequestReservation", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return(invalidExpiry, nil).Once()
+
+	_, _, err = manager.RequestReservation(
+		ctxb, defaultValue, defaultExpiry, fee,
+	)
+	require.ErrorContains(t, err, "reservation expiry")
+
+	// A reservation that expires too late is rejected as well.
+	invalidExpiry = newResponse()
+	invalidExpiry.Expiry = height + MaxExpiryDelta + 1
+	testContext.mockReservationClient.On(
+		"RequestReservation", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return(invalidExpiry, nil).Once()
+
+	_, _, err = manager.RequestReservation(
+		ctxb, defaultValue, defaultExpiry, fee,
+	)
+	require.ErrorContains(t, err, "exceeds the maximum expiry")
+
+	// A fee invoice that doesn't match the quoted fee or payee is
+	// rejected.
+	testContext.mockReservationClient.On(
+		"RequestReservation", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return(newResponse(), nil).Twice()
+
+	decoder.fee = fee - 1
+	_, _, err = manager.RequestReservation(
+		ctxb, defaultValue, defaultExpiry, fee,
+	)
+	require.ErrorContains(t, err, "doesn't match quoted fee")
+
+	decoder.fee = fee
+	decoder.payee = route.Vertex{3}
+	_, _, err = manager.RequestReservation(
+		ctxb, defaultValue, defaultExpiry, fee,
+	)
+	require.ErrorContains(t, err, "expected quoted payee")
+	decoder.payee = payee
+
+	// A fee above the accepted maximum is rejected.
+	testContext.mockReservationClient.On(
+		"RequestReservation", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return(newResponse(), nil).Once()
+
+	_, _, err = manager.RequestReservation(
+		ctxb, defaultValue, defaultExpiry, fee-1,
+	)
+	require.ErrorContains(t, err, "exceeds max fee")
+
+	// A valid reservation is paid for and opened.
+	testContext.mockReservationClient.On(
+		"RequestReservation", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return(newResponse(), nil).Once()
+
+	type result struct {
+		reservation *Reservation
+		fee         btcutil.Amount
+		err         error
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		res, paidFee, err := manager.RequestReservation(
+			ctxb, defaultValue, defaultExpiry, fee,
+		)
+		resultChan <- result{res, paidFee, err}
+	}()
+
+	// The reservation is opened with the server and waits for its
+	// confirmation before its fee is paid.
+	<-testContext.mockLnd.RegisterConfChannel
+
+	payment := <-testContext.mockLnd.RouterSendPaymentChannel
+	require.Equal(t, "feeinvoice", payment.Invoice)
+	payment.Updates <- lndclient.PaymentStatus{
+		State: lnrpc.Payment_SUCCEEDED,
+	}
+
+	res := <-resultChan
+	require.NoError(t, res.err)
+	require.Equal(t, fee, res.fee)
+	require.Equal(t, defaultReservationId, res.reservation.ID)
+	require.Equal(t, defaultValue, res.reservation.Value)
+	require.Equal(t, height+defaultExpiry, res.reservation.Expiry)
+
+	stored, err := manager.GetReservation(ctxb, defaultReservationId)
+	require.NoError(t, err)
+	require.Equal(t, WaitForConfirmation, stored.State)
+}
+
 // ManagerTestContext is a helper struct that contains all the necessary
 // components to test the reservation manager.
 type ManagerTestContext struct {
@@ -134,7 +690,10 @@ func newManagerTestContext(t *testing.T) *ManagerTestContext {
 	)
 
 	cfg := &Config{
-		Store:             store,
+		Store: store,
+		TransitionStore: fsm.NewSQLStore(
+			loopdb.NewTypedStore[fsm.Querier](dbFixture),
+		),
 		Wallet:            mockLnd.WalletKit,
 		ChainNotifier:     mockLnd.ChainNotifier,
 		FetchL402:         func(context.Context) error { return nil },
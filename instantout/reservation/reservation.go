@@ -8,6 +8,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/fsm"
@@ -16,6 +17,12 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 )
 
+// MaxExpiryDelta is the maximum number of blocks from the current height
+// until the expiry of a reservation that we request or accept from the
+// server. It protects against nonsensical expiries that would leave a
+// reservation open for an unreasonable time.
+const MaxExpiryDelta = 4 * 2016
+
 // ID is a unique identifier for a reservation.
 type ID [IdLength]byte
 
@@ -65,6 +72,39 @@ type Reservation struct {
 	// ConfirmationHeight is the height at which the reservation was
 	// confirmed.
 	ConfirmationHeight uint32
+
+	// SpendTxHash is the hash of the transaction that spent the
+	// reservation output, if the spend has been detected.
+	SpendTxHash *chainhash.Hash
+
+	// SpendHeight is the height at which the reservation output was
+	// spent.
+	SpendHeight uint32
+}
+
+// ValidateExpiry checks that the absolute expiry of a reservation offered by
+// the server is at least minExpiry and at most MaxExpiryDelta blocks after
+// the current height.
+func ValidateExpiry(expiry, minExpiry uint32, currentHeight int32) error {
+	if expiry < minExpiry {
+		return fmt.Errorf("reservation expiry %v is below the "+
+			"minimum expiry %v", expiry, minExpiry)
+	}
+
+	maxExpiry := uint32(currentHeight) + MaxExpiryDelta
+	if expiry > maxExpiry {
+		return fmt.Errorf("reservation expiry %v exceeds the "+
+			"maximum expiry %v", expiry, maxExpiry)
+	}
+
+	return nil
+}
+
+// BlocksUntilExpiry returns the number of blocks left until the reservation
+// expires at the given height. The result is negative if the reservation has
+// already expired.
+func (r *Reservation) BlocksUntilExpiry(currentHeight int32) int32 {
+	return int32(r.Expiry) - currentHeight
 }
 
 func NewReservation(id ID, serverPubkey, clientPubkey *btcec.PublicKey,
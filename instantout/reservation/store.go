@@ -116,6 +116,11 @@ func (r *SQLStore) UpdateReservation(ctx context.Context,
 		}
 	}
 
+	var spendTxid []byte
+	if reservation.SpendTxHash != nil {
+		spendTxid = reservation.SpendTxHash[:]
+	}
+
 	insertUpdateArgs := sqlc.InsertReservationUpdateParams{
 		ReservationID:   reservation.ID[:],
 		UpdateTimestamp: r.clock.Now().UTC(),
@@ -129,6 +134,10 @@ func (r *SQLStore) UpdateReservation(ctx context.Context,
 		ConfirmationHeight: marshalSqlNullInt32(
 			int32(reservation.ConfirmationHeight),
 		),
+		SpendTxid: spendTxid,
+		SpendHeight: marshalSqlNullInt32(
+			int32(reservation.SpendHeight),
+		),
 	}
 
 	return r.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
@@ -271,6 +280,14 @@ func sqlReservationToReservation(row sqlc.Reservation,
 		)
 	}
 
+	var spendTxHash *chainhash.Hash
+	if row.SpendTxid != nil {
+		spendTxHash, err = chainhash.NewHash(row.SpendTxid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Reservation{
 		ID:           id,
 		ClientPubkey: clientPubkey,
@@ -287,6 +304,10 @@ func sqlReservationToReservation(row sqlc.Reservation,
 		),
 		InitiationHeight: row.InitiationHeight,
 		State:            fsm.StateType(lastUpdate.UpdateState),
+		SpendTxHash:      spendTxHash,
+		SpendHeight: uint32(
+			unmarshalSqlNullInt32(row.SpendHeight),
+		),
 	}, nil
 }
 
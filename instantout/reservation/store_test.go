@@ -66,6 +66,18 @@ func TestSqlStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, reservation, reservation2)
 
+	// Record the spend of the reservation and compare it.
+	reservation.SpendTxHash = &chainhash.Hash{0x02}
+	reservation.SpendHeight = 120
+	reservation.State = TimedOut
+
+	err = store.UpdateReservation(ctxb, reservation)
+	require.NoError(t, err)
+
+	reservation2, err = store.GetReservation(ctxb, reservation.ID)
+	require.NoError(t, err)
+	require.Equal(t, reservation, reservation2)
+
 	// Add a second reservation.
 	reservation3 := &Reservation{
 		ID:           getRandomReservationID(),
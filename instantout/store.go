@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -34,6 +35,16 @@ type Querier interface {
 	InsertInstantOut(ctx context.Context,
 		arg sqlc.InsertInstantOutParams) error
 
+	// InsertInstantOutChangeReservation inserts the change reservation of
+	// an instant out swap.
+	InsertInstantOutChangeReservation(ctx context.Context,
+		arg sqlc.InsertInstantOutChangeReservationParams) error
+
+	// InsertInstantOutSweepOutput inserts an additional sweep output of an
+	// instant out swap.
+	InsertInstantOutSweepOutput(ctx context.Context,
+		arg sqlc.InsertInstantOutSweepOutputParams) error
+
 	// InsertInstantOutUpdate inserts a new instant out update.
 	InsertInstantOutUpdate(ctx context.Context,
 		arg sqlc.InsertInstantOutUpdateParams) error
@@ -46,6 +57,16 @@ type Querier interface {
 	GetInstantOutSwap(ctx context.Context,
 		swapHash []byte) (sqlc.GetInstantOutSwapRow, error)
 
+	// GetInstantOutChangeReservation retrieves the change reservation of
+	// an instant out swap.
+	GetInstantOutChangeReservation(ctx context.Context,
+		swapHash []byte) (sqlc.InstantoutChangeReservation, error)
+
+	// GetInstantOutSweepOutputs retrieves the additional sweep outputs of
+	// an instant out swap.
+	GetInstantOutSweepOutputs(ctx context.Context,
+		swapHash []byte) ([]sqlc.InstantoutSweepOutput, error)
+
 	// GetInstantOutSwapUpdates retrieves all instant out swap updates.
 	GetInstantOutSwapUpdates(ctx context.Context,
 		swapHash []byte) ([]sqlc.InstantoutUpdate, error)
@@ -133,6 +154,38 @@ func (s *SQLStore) CreateInstantLoopOut(ctx context.Context,
 		SwapInvoice:     instantOut.swapInvoice,
 	}
 
+	var changeArgs *sqlc.InsertInstantOutChangeReservationParams
+	if instantOut.ChangeReservation != nil {
+		change := instantOut.ChangeReservation
+		changeArgs = &sqlc.InsertInstantOutChangeReservationParams{
+			SwapHash:      instantOut.SwapHash[:],
+			ReservationID: change.ID[:],
+			ClientPubkey: change.ClientPubkey.
+				SerializeCompressed(),
+			ServerPubkey: change.ServerPubkey.
+				SerializeCompressed(),
+			Expiry:          int32(change.Expiry),
+			Value:           int64(change.Value),
+			ClientKeyFamily: int32(change.KeyLocator.Family),
+			ClientKeyIndex:  int32(change.KeyLocator.Index),
+		}
+	}
+
+	sweepOutputArgs := make(
+		[]sqlc.InsertInstantOutSweepOutputParams, 0,
+		len(instantOut.sweepOutputs),
+	)
+	for idx, output := range instantOut.sweepOutputs {
+		sweepOutputArgs = append(
+			sweepOutputArgs, sqlc.InsertInstantOutSweepOutputParams{
+				SwapHash:    instantOut.SwapHash[:],
+				OutputIndex: int32(idx),
+				Address:     output.Address.String(),
+				Amount:      int64(output.Value),
+			},
+		)
+	}
+
 	updateArgs := sqlc.InsertInstantOutUpdateParams{
 		SwapHash:        instantOut.SwapHash[:],
 		UpdateTimestamp: s.clock.Now(),
@@ -156,6 +209,22 @@ func (s *SQLStore) CreateInstantLoopOut(ctx context.Context,
 				return err
 			}
 
+			if changeArgs != nil {
+				err = q.InsertInstantOutChangeReservation(
+					ctx, *changeArgs,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, args := range sweepOutputArgs {
+				err = q.InsertInstantOutSweepOutput(ctx, args)
+				if err != nil {
+					return err
+				}
+			}
+
 			return q.InsertInstantOutUpdate(ctx, updateArgs)
 		})
 }
@@ -354,20 +423,33 @@ func (s *SQLStore) sqlInstantOutToInstantOut(ctx context.Context,
 		reservations = append(reservations, reservation)
 	}
 
+	changeReservation, err := s.getChangeReservation(
+		ctx, row.SwapHash, row.InitiationHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	sweepAddress, err := btcutil.DecodeAddress(row.SweepAddress, s.network)
 	if err != nil {
 		return nil, err
 	}
 
+	sweepOutputs, err := s.getSweepOutputs(ctx, row.SwapHash)
+	if err != nil {
+		return nil, err
+	}
+
 	instantOut := &InstantOut{
-		SwapHash:         swapHash,
-		swapPreimage:     swapPreImage,
-		CltvExpiry:       row.CltvExpiry,
-		outgoingChanSet:  outgoingChanSet,
-		Reservations:     reservations,
-		protocolVersion:  ProtocolVersion(row.ProtocolVersion),
-		initiationHeight: row.InitiationHeight,
-		Value:            btcutil.Amount(row.AmountRequested),
+		SwapHash:          swapHash,
+		swapPreimage:      swapPreImage,
+		CltvExpiry:        row.CltvExpiry,
+		outgoingChanSet:   outgoingChanSet,
+		Reservations:      reservations,
+		ChangeReservation: changeReservation,
+		protocolVersion:   ProtocolVersion(row.ProtocolVersion),
+		initiationHeight:  row.InitiationHeight,
+		Value:             btcutil.Amount(row.AmountRequested),
 		keyLocator: keychain.KeyLocator{
 			Family: keychain.KeyFamily(row.ClientKeyFamily),
 			Index:  uint32(row.ClientKeyIndex),
@@ -377,6 +459,7 @@ func (s *SQLStore) sqlInstantOutToInstantOut(ctx context.Context,
 		swapInvoice:               row.SwapInvoice,
 		htlcFeeRate:               chainfee.SatPerKWeight(row.HtlcFeeRate),
 		sweepAddress:              sweepAddress,
+		sweepOutputs:              sweepOutputs,
 		finalizedHtlcTx:           finalizedHtlcTx,
 		SweepTxHash:               sweepTxHash,
 		FinalizedSweeplessSweepTx: finalizedSweepLessSweepTx,
@@ -393,6 +476,75 @@ func (s *SQLStore) sqlInstantOutToInstantOut(ctx context.Context,
 	return instantOut, nil
 }
 
+// getSweepOutputs returns the additional sweep outputs of the instant out with
+// the given swap hash.
+func (s *SQLStore) getSweepOutputs(ctx context.Context, swapHash []byte) (
+	[]SweepOutput, error) {
+
+	rows, err := s.baseDb.GetInstantOutSweepOutputs(ctx, swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var sweepOutputs []SweepOutput
+	for _, row := range rows {
+		address, err := btcutil.DecodeAddress(row.Address, s.network)
+		if err != nil {
+			return nil, err
+		}
+
+		sweepOutputs = append(sweepOutputs, SweepOutput{
+			Address: address,
+			Value:   btcutil.Amount(row.Amount),
+		})
+	}
+
+	return sweepOutputs, nil
+}
+
+// getChangeReservation returns the change reservation of the instant out with
+// the given swap hash, or nil if the swap has no change.
+func (s *SQLStore) getChangeReservation(ctx context.Context, swapHash []byte,
+	initiationHeight int32) (*reservation.Reservation, error) {
+
+	row, err := s.baseDb.GetInstantOutChangeReservation(ctx, swapHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var id reservation.ID
+	err = id.FromByteSlice(row.ReservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientPubkey, err := btcec.ParsePubKey(row.ClientPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverPubkey, err := btcec.ParsePubKey(row.ServerPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservation.Reservation{
+		ID:           id,
+		ClientPubkey: clientPubkey,
+		ServerPubkey: serverPubkey,
+		Value:        btcutil.Amount(row.Value),
+		Expiry:       uint32(row.Expiry),
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(row.ClientKeyFamily),
+			Index:  uint32(row.ClientKeyIndex),
+		},
+		InitiationHeight: initiationHeight,
+	}, nil
+}
+
 // reservationIdsToByteSlice converts a slice of reservation ids to a byte
 // slice.
 func reservationIdsToByteSlice(reservations []*reservation.Reservation) []byte {
@@ -1,10 +1,18 @@
 package instantout
 
 import (
+	"context"
 	"crypto/rand"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightninglabs/loop/instantout/reservation"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,3 +42,130 @@ func TestConvertingReservations(t *testing.T) {
 	require.Equal(t, resId1, reservationIds[0])
 	require.Equal(t, resId2, reservationIds[1])
 }
+
+// TestChangeReservationStore tests that the change reservation of an instant
+// out is persisted.
+func TestChangeReservationStore(t *testing.T) {
+	ctxb := context.Background()
+
+	db := loopdb.NewTestDB(t)
+	reservationStore := reservation.NewSQLStore(
+		loopdb.NewTypedStore[reservation.Querier](db),
+	)
+	store := NewSQLStore(
+		loopdb.NewTypedStore[Querier](db), clock.NewDefaultClock(),
+		reservationStore, &chaincfg.RegressionNetParams,
+	)
+
+	res := newTestReservation(t, 1, 100_000)
+	res.KeyLocator.Family = keychain.KeyFamily(reservation.KeyFamily)
+	require.NoError(t, reservationStore.CreateReservation(ctxb, res))
+
+	_, clientPubkey := test.CreateKey(1)
+	_, serverPubkey := test.CreateKey(2)
+
+	sweepAddr, err := btcutil.NewAddressTaproot(
+		make([]byte, 32), &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+
+	change := newTestReservation(t, 2, 40_000)
+	change.Outpoint = nil
+	change.InitiationHeight = 100
+	change.KeyLocator = keychain.KeyLocator{
+		Family: keychain.KeyFamily(reservation.KeyFamily),
+		Index:  7,
+	}
+
+	preimage := lntypes.Preimage{1}
+	instantOut := &InstantOut{
+		SwapHash:          preimage.Hash(),
+		swapPreimage:      preimage,
+		CltvExpiry:        500,
+		Reservations:      []*reservation.Reservation{res},
+		ChangeReservation: change,
+		protocolVersion:   ProtocolVersionChangeReservation,
+		initiationHeight:  100,
+		Value:             60_000,
+		clientPubkey:      clientPubkey,
+		serverPubkey:      serverPubkey,
+		sweepAddress:      sweepAddr,
+	}
+	require.NoError(t, store.CreateInstantLoopOut(ctxb, instantOut))
+
+	stored, err := store.GetInstantLoopOut(ctxb, instantOut.SwapHash[:])
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(60_000), stored.Value)
+	require.Equal(t, change, stored.ChangeReservation)
+
+	// Instant outs without change don't have a change reservation.
+	instantOut.swapPreimage = lntypes.Preimage{2}
+	instantOut.SwapHash = instantOut.swapPreimage.Hash()
+	instantOut.ChangeReservation = nil
+	require.NoError(t, store.CreateInstantLoopOut(ctxb, instantOut))
+
+	stored, err = store.GetInstantLoopOut(ctxb, instantOut.SwapHash[:])
+	require.NoError(t, err)
+	require.Nil(t, stored.ChangeReservation)
+}
+
+// TestSweepOutputsStore tests that the additional sweep outputs of an instant
+// out are persisted in order.
+func TestSweepOutputsStore(t *testing.T) {
+	ctxb := context.Background()
+	params := &chaincfg.RegressionNetParams
+
+	db := loopdb.NewTestDB(t)
+	reservationStore := reservation.NewSQLStore(
+		loopdb.NewTypedStore[reservation.Querier](db),
+	)
+	store := NewSQLStore(
+		loopdb.NewTypedStore[Querier](db), clock.NewDefaultClock(),
+		reservationStore, params,
+	)
+
+	res := newTestReservation(t, 1, 100_000)
+	require.NoError(t, reservationStore.CreateReservation(ctxb, res))
+
+	_, clientPubkey := test.CreateKey(1)
+	_, serverPubkey := test.CreateKey(2)
+
+	sweepAddr, err := btcutil.NewAddressTaproot(make([]byte, 32), params)
+	require.NoError(t, err)
+
+	outputAddr1, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), params,
+	)
+	require.NoError(t, err)
+
+	outputAddr2, err := btcutil.NewAddressTaproot(
+		clientPubkey.SerializeCompressed()[1:], params,
+	)
+	require.NoError(t, err)
+
+	sweepOutputs := []SweepOutput{
+		{Address: outputAddr1, Value: 30_000},
+		{Address: outputAddr2, Value: 20_000},
+	}
+
+	preimage := lntypes.Preimage{1}
+	instantOut := &InstantOut{
+		SwapHash:         preimage.Hash(),
+		swapPreimage:     preimage,
+		CltvExpiry:       500,
+		Reservations:     []*reservation.Reservation{res},
+		protocolVersion:  ProtocolVersionMultiSweepOutputs,
+		initiationHeight: 100,
+		Value:            100_000,
+		clientPubkey:     clientPubkey,
+		serverPubkey:     serverPubkey,
+		sweepAddress:     sweepAddr,
+		sweepOutputs:     sweepOutputs,
+	}
+	require.NoError(t, store.CreateInstantLoopOut(ctxb, instantOut))
+
+	stored, err := store.GetInstantLoopOut(ctxb, instantOut.SwapHash[:])
+	require.NoError(t, err)
+	require.Equal(t, sweepOutputs, stored.sweepOutputs)
+	require.Equal(t, sweepAddr, stored.sweepAddress)
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -98,6 +100,16 @@ type OutRequest struct {
 	// the configured maximum payment timeout) the total time spent may be
 	// a multiple of this value.
 	PaymentTimeout time.Duration
+
+	// SwapRoutePreferences optionally restricts the route used for the
+	// swap payment. If set, the payment is sent over a single route that
+	// is built or selected up front instead of letting lnd pathfind
+	// freely.
+	SwapRoutePreferences loopdb.RoutePreferences
+
+	// PrepayRoutePreferences optionally restricts the route used for the
+	// prepayment.
+	PrepayRoutePreferences loopdb.RoutePreferences
 }
 
 // Out contains the full details of a loop out request. This includes things
@@ -234,6 +246,93 @@ type LoopInRequest struct {
 	RouteHints [][]zpay32.HopHint
 }
 
+// FundLoopInPsbtRequest contains the parameters for funding the htlc of an
+// external loop in swap with a PSBT.
+type FundLoopInPsbtRequest struct {
+	// SwapHash identifies the external loop in swap to fund.
+	SwapHash lntypes.Hash
+
+	// Account is the optional lnd wallet account to select inputs from
+	// and to send the change to. If empty, the default account is used.
+	Account string
+
+	// Utxos optionally specifies the inputs to spend. If empty, lnd
+	// performs coin selection.
+	Utxos []wire.OutPoint
+
+	// SatPerVbyte is the fee rate to use for the htlc transaction. If
+	// zero, the fee rate is estimated with ConfTarget.
+	SatPerVbyte uint64
+
+	// ConfTarget is the confirmation target to estimate the fee rate
+	// with. If both SatPerVbyte and ConfTarget are zero, the htlc
+	// confirmation target of the swap is used.
+	ConfTarget int32
+}
+
+// FundLoopInPsbtResponse contains the funded but unsigned PSBT that pays the
+// htlc of an external loop in swap.
+type FundLoopInPsbtResponse struct {
+	// Packet is the funded PSBT which still needs to be signed.
+	Packet *psbt.Packet
+
+	// HtlcOutputIndex is the index of the htlc output.
+	HtlcOutputIndex uint32
+
+	// ChangeOutputIndex is the index of the change output or -1 if there
+	// is no change output.
+	ChangeOutputIndex int32
+
+	// Fee is the on-chain fee that is paid by the transaction.
+	Fee btcutil.Amount
+}
+
+// RecoverHtlcRequest contains the parameters for manually spending the htlc of
+// a swap back to the client's wallet.
+type RecoverHtlcRequest struct {
+	// SwapHash identifies the swap whose htlc should be spent. For loop in
+	// swaps the timeout path is used, for loop out swaps the success path.
+	SwapHash lntypes.Hash
+
+	// DestAddr is the address to send the htlc funds to. If nil, a new
+	// address of lnd's wallet is used.
+	DestAddr btcutil.Address
+
+	// SatPerVbyte is the fee rate of the spending transaction.
+	SatPerVbyte uint64
+
+	// Publish indicates whether the transaction should be published.
+	// Otherwise it is only returned.
+	Publish bool
+}
+
+// RecoverHtlcResponse contains the signed transaction that spends the htlc of
+// a swap.
+type RecoverHtlcResponse struct {
+	// Tx is the signed transaction spending the htlc.
+	Tx *wire.MsgTx
+
+	// Fee is the on-chain fee paid by the transaction.
+	Fee btcutil.Amount
+
+	// Published indicates whether the transaction was published.
+	Published bool
+}
+
+// PresignedTimeouts contains the presigned timeout transactions of the htlc of
+// a pending loop in swap.
+type PresignedTimeouts struct {
+	// SwapHash is the hash of the loop in swap.
+	SwapHash lntypes.Hash
+
+	// CltvExpiry is the height from which on the timeout transactions can
+	// be published.
+	CltvExpiry int32
+
+	// Txs are the timeout transactions, ordered by fee rate.
+	Txs []*loopdb.PresignedTimeoutTx
+}
+
 // LoopInTerms are the server terms on which it executes loop in swaps.
 type LoopInTerms struct {
 	// MinSwapAmount is the minimum amount that the server requires for a
@@ -1,6 +1,9 @@
 package labels
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 const (
 	// loopdLabelPattern is the pattern that loop uses to label on-chain
@@ -14,11 +17,17 @@ const (
 	// loopInHtlc is the label used for loop in swaps to publish an HTLC.
 	loopInHtlc = "InHtlc"
 
+	// loopInBatchHtlc is the label used for loop in swaps that publish
+	// their HTLCs in a shared transaction.
+	loopInBatchHtlc = "BatchInHtlc"
+
 	// loopInTimeout is the label used for loop in swaps to sweep an HTLC
 	// that has timed out.
 	loopInSweepTimeout = "InSweepTimeout"
 
 	loopOutBatchSweepSuccess = "BatchOutSweepSuccess -- %d"
+
+	loopInBatchSweepTimeout = "BatchInSweepTimeout -- %d"
 )
 
 // LoopOutSweepSuccess returns the label used for loop out swaps to sweep the
@@ -32,6 +41,12 @@ func LoopOutBatchSweepSuccess(batchID int32) string {
 	return fmt.Sprintf(loopOutBatchSweepSuccess, batchID)
 }
 
+// LoopInBatchSweepTimeout returns the label used for batches of loop in
+// timeout sweeps.
+func LoopInBatchSweepTimeout(batchID int32) string {
+	return fmt.Sprintf(loopInBatchSweepTimeout, batchID)
+}
+
 // LoopInHtlcLabel returns the label used for loop in swaps to publish an HTLC.
 func LoopInHtlcLabel(swapHash string) string {
 	return fmt.Sprintf(loopdLabelPattern, loopInHtlc, swapHash)
@@ -42,3 +57,21 @@ func LoopInHtlcLabel(swapHash string) string {
 func LoopInSweepTimeout(swapHash string) string {
 	return fmt.Sprintf(loopdLabelPattern, loopInSweepTimeout, swapHash)
 }
+
+// LoopInBatchHtlcLabel returns the label used for loop in swaps that publish
+// their HTLCs in a shared transaction. If the label would exceed the maximum
+// label length, only the number of swaps is included.
+func LoopInBatchHtlcLabel(swapHashes []string) string {
+	label := fmt.Sprintf(
+		loopdLabelPattern, loopInBatchHtlc,
+		strings.Join(swapHashes, ","),
+	)
+	if len(label) <= MaxLength {
+		return label
+	}
+
+	return fmt.Sprintf(
+		loopdLabelPattern, loopInBatchHtlc,
+		fmt.Sprintf("%d swaps", len(swapHashes)),
+	)
+}
@@ -7,11 +7,15 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/aperture/l402"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swapbackup"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -48,6 +52,10 @@ var (
 	defaultTotalPaymentTimeout = time.Minute * 60
 	defaultMaxPaymentRetries   = 3
 
+	// defaultPresignedTimeoutFeeRates are the default fee rates in
+	// sat/vbyte at which the timeout txs of loop in htlcs are presigned.
+	defaultPresignedTimeoutFeeRates = "2,10,25,50,100"
+
 	// DefaultTLSCertFilename is the default file name for the autogenerated
 	// TLS certificate.
 	DefaultTLSCertFilename = "tls.cert"
@@ -102,6 +110,12 @@ var (
 		LoopDirBase, DefaultNetwork, DefaultMacaroonFilename,
 	)
 
+	// DefaultSwapBackupPath is the default full path of the encrypted swap
+	// backup file.
+	DefaultSwapBackupPath = filepath.Join(
+		LoopDirBase, DefaultNetwork, swapbackup.DefaultBackupFilename,
+	)
+
 	// DefaultAutogenValidity is the default validity of a self-signed
 	// certificate in number of days.
 	DefaultAutogenValidity = 365 * 24 * time.Hour
@@ -162,6 +176,9 @@ type Config struct {
 
 	MacaroonPath string `long:"macaroonpath" description:"Path to write the macaroon for loop's RPC and REST services if it doesn't exist."`
 
+	SwapBackupPath string `long:"swapbackuppath" description:"Path of the encrypted backup file that contains all pending swaps. It is updated whenever a swap is created or its state changes."`
+	NoSwapBackup   bool   `long:"noswapbackup" description:"Don't keep an encrypted backup file of the pending swaps. Swaps can't be recovered from a backup while this is set."`
+
 	LogDir         string `long:"logdir" description:"Directory to log output."`
 	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)."`
 	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB."`
@@ -177,6 +194,10 @@ type Config struct {
 	TotalPaymentTimeout time.Duration `long:"totalpaymenttimeout" description:"The timeout to use for off-chain payments."`
 	MaxPaymentRetries   int           `long:"maxpaymentretries" description:"The maximum number of times an off-chain payment may be retried."`
 
+	PresignedTimeoutFeeRates string `long:"presignedtimeoutfeerates" description:"Comma separated list of fee rates in sat/vbyte at which the timeout transaction of a confirmed loop in htlc is signed in advance. The transactions can be handed to a third party that publishes them if loopd is offline when the htlc expires. Set to an empty value to disable presigning."`
+
+	SwapRetentionDays uint32 `long:"swapretentiondays" description:"If set, finished swaps are compacted on startup once they reached their final state more than this number of days ago. Only the final state and the costs of a compacted swap are kept, its intermediate updates are deleted. Set to 0 to keep all updates."`
+
 	EnableExperimental bool `long:"experimental" description:"Enable experimental features: reservations"`
 
 	Lnd *lndConfig `group:"lnd" namespace:"lnd"`
@@ -215,12 +236,16 @@ func DefaultConfig() Config {
 		TLSKeyPath:          DefaultTLSKeyPath,
 		TLSValidity:         DefaultAutogenValidity,
 		MacaroonPath:        DefaultMacaroonPath,
+		SwapBackupPath:      DefaultSwapBackupPath,
 		MaxL402Cost:         l402.DefaultMaxCostSats,
 		MaxL402Fee:          l402.DefaultMaxRoutingFeeSats,
 		LoopOutMaxParts:     defaultLoopOutMaxParts,
 		TotalPaymentTimeout: defaultTotalPaymentTimeout,
 		MaxPaymentRetries:   defaultMaxPaymentRetries,
 		EnableExperimental:  false,
+
+		PresignedTimeoutFeeRates: defaultPresignedTimeoutFeeRates,
+
 		Lnd: &lndConfig{
 			Host:         "localhost:10009",
 			MacaroonPath: DefaultLndMacaroonPath,
@@ -238,6 +263,7 @@ func Validate(cfg *Config) error {
 	cfg.TLSCertPath = lncfg.CleanAndExpandPath(cfg.TLSCertPath)
 	cfg.TLSKeyPath = lncfg.CleanAndExpandPath(cfg.TLSKeyPath)
 	cfg.MacaroonPath = lncfg.CleanAndExpandPath(cfg.MacaroonPath)
+	cfg.SwapBackupPath = lncfg.CleanAndExpandPath(cfg.SwapBackupPath)
 
 	// Since our loop directory overrides our log/data dir values, make sure
 	// that they are not set when loop dir is set. We hard here rather than
@@ -299,6 +325,11 @@ func Validate(cfg *Config) error {
 			cfg.DataDir, DefaultMacaroonFilename,
 		)
 	}
+	if cfg.SwapBackupPath == DefaultSwapBackupPath {
+		cfg.SwapBackupPath = filepath.Join(
+			cfg.DataDir, swapbackup.DefaultBackupFilename,
+		)
+	}
 
 	// If the user doesn't specify Lnd.MacaroonPath, we'll reassemble it
 	// with the passed Network options.
@@ -362,6 +393,11 @@ func Validate(cfg *Config) error {
 		return fmt.Errorf("max payment retries must be at least 1")
 	}
 
+	if _, err := parseFeeRates(cfg.PresignedTimeoutFeeRates); err != nil {
+		return fmt.Errorf("invalid presigned timeout fee rates: %w",
+			err)
+	}
+
 	// TLS Validity period to be at least 24 hours
 	if cfg.TLSValidity < time.Hour*24 {
 		return fmt.Errorf("TLS certificate minimum validity period is 24h")
@@ -370,6 +406,43 @@ func Validate(cfg *Config) error {
 	return nil
 }
 
+// parseFeeRates parses a comma separated list of distinct non-zero fee rates
+// in sat/vbyte and returns them in ascending order.
+func parseFeeRates(list string) ([]uint64, error) {
+	if strings.TrimSpace(list) == "" {
+		return nil, nil
+	}
+
+	var feeRates []uint64
+	for _, part := range strings.Split(list, ",") {
+		feeRate, err := strconv.ParseUint(
+			strings.TrimSpace(part), 10, 64,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if feeRate == 0 {
+			return nil, fmt.Errorf("fee rate must be positive")
+		}
+
+		feeRates = append(feeRates, feeRate)
+	}
+
+	sort.Slice(feeRates, func(i, j int) bool {
+		return feeRates[i] < feeRates[j]
+	})
+
+	for i := 1; i < len(feeRates); i++ {
+		if feeRates[i] == feeRates[i-1] {
+			return nil, fmt.Errorf("duplicate fee rate %v",
+				feeRates[i])
+		}
+	}
+
+	return feeRates, nil
+}
+
 // getTLSConfig generates a new self signed certificate or refreshes an existing
 // one if necessary, then returns the full TLS configuration for initializing
 // a secure server interface.
@@ -16,11 +16,13 @@ import (
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/instantout"
 	"github.com/lightninglabs/loop/instantout/reservation"
 	"github.com/lightninglabs/loop/loopd/perms"
 	"github.com/lightninglabs/loop/loopdb"
 	loop_looprpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swapbackup"
 	loop_swaprpc "github.com/lightninglabs/loop/swapserverrpc"
 	"github.com/lightninglabs/loop/sweepbatcher"
 	"github.com/lightningnetwork/lnd/clock"
@@ -417,14 +419,53 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		return err
 	}
 
+	// Compact the finished swaps that are older than the retention period,
+	// so that they don't slow down loading the swaps.
+	if d.cfg.SwapRetentionDays > 0 {
+		_, err = compactSwaps(
+			d.mainCtx, baseDb, d.cfg.SwapRetentionDays, false,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to compact swaps: %w", err)
+		}
+	}
+
 	sweeperDb := sweepbatcher.NewSQLStore(
 		loopdb.NewTypedStore[sweepbatcher.Querier](baseDb),
 		chainParams,
 	)
 
+	// Keep an encrypted backup of the pending swaps, unless the user
+	// disabled it. We write it once on startup, afterwards it is updated
+	// by the swap store whenever a swap is created or its state changes.
+	var (
+		swapStore  loopdb.SwapStore = swapDb
+		swapBackup *swapbackup.Backup
+	)
+	if !d.cfg.NoSwapBackup {
+		swapBackup, err = swapbackup.NewBackup(
+			d.mainCtx, d.cfg.SwapBackupPath, baseDb,
+			d.lnd.WalletKit,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = swapBackup.Update(d.mainCtx)
+		if err != nil {
+			return fmt.Errorf("unable to write swap backup: %w",
+				err)
+		}
+
+		log.Infof("Backing up pending swaps to %v",
+			d.cfg.SwapBackupPath)
+
+		swapStore = swapbackup.NewSwapStore(swapDb, swapBackup)
+	}
+
 	// Create an instance of the loop client library.
 	swapClient, clientCleanup, err := getClient(
-		d.cfg, swapDb, sweeperDb, &d.lnd.LndServices,
+		d.cfg, swapStore, sweeperDb, &d.lnd.LndServices,
 	)
 	if err != nil {
 		return err
@@ -507,10 +548,16 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		reservationStore := reservation.NewSQLStore(
 			loopdb.NewTypedStore[reservation.Querier](baseDb),
 		)
+		transitionStore := fsm.NewSQLStore(
+			loopdb.NewTypedStore[fsm.Querier](baseDb),
+		)
 		reservationConfig := &reservation.Config{
 			Store:             reservationStore,
+			TransitionStore:   transitionStore,
 			Wallet:            d.lnd.WalletKit,
 			ChainNotifier:     d.lnd.ChainNotifier,
+			LightningClient:   d.lnd.Client,
+			RouterClient:      d.lnd.Router,
 			ReservationClient: reservationClient,
 			FetchL402:         swapClient.Server.FetchL402,
 		}
@@ -555,6 +602,8 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		mainCtx:            d.mainCtx,
 		reservationManager: reservationManager,
 		instantOutManager:  instantOutManager,
+		baseDb:             baseDb,
+		swapBackup:         swapBackup,
 	}
 
 	// Retrieve all currently existing swaps from the database.
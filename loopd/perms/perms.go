@@ -104,6 +104,14 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "swap",
 		Action: "read",
 	}},
+	"/looprpc.SwapClient/ReservationQuote": {{
+		Entity: "swap",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/RequestReservation": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
 	"/looprpc.SwapClient/InstantOut": {{
 		Entity: "swap",
 		Action: "execute",
@@ -116,4 +124,58 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "swap",
 		Action: "read",
 	}},
+	"/looprpc.SwapClient/CancelInstantOut": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
+	"/looprpc.SwapClient/MonitorReservations": {{
+		Entity: "swap",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/MonitorInstantOuts": {{
+		Entity: "swap",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/FundLoopInPsbt": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "in",
+	}},
+	"/looprpc.SwapClient/PublishLoopInPsbt": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "in",
+	}},
+	"/looprpc.SwapClient/GetStateMachine": {{
+		Entity: "swap",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/ExportDatabase": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
+	"/looprpc.SwapClient/ImportDatabase": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
+	"/looprpc.SwapClient/CompactSwaps": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
+	"/looprpc.SwapClient/RecoverSwaps": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
+	"/looprpc.SwapClient/RecoverHtlc": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
+	"/looprpc.SwapClient/GetPresignedTimeouts": {{
+		Entity: "swap",
+		Action: "read",
+	}},
 }
@@ -6,18 +6,20 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"reflect"
+	"math"
 	"sort"
-	"strings"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/aperture/l402"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/instantout"
 	"github.com/lightninglabs/loop/instantout/reservation"
 	"github.com/lightninglabs/loop/labels"
@@ -25,6 +27,7 @@ import (
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/swapbackup"
 	"github.com/lightninglabs/loop/swapserverrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -83,6 +86,8 @@ type swapClientServer struct {
 	lnd                *lndclient.LndServices
 	reservationManager *reservation.Manager
 	instantOutManager  *instantout.Manager
+	baseDb             *loopdb.BaseDB
+	swapBackup         *swapbackup.Backup
 	swaps              map[lntypes.Hash]loop.SwapInfo
 	subscribers        map[int]chan<- interface{}
 	statusChan         chan loop.SwapInfo
@@ -133,28 +138,13 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 
 		isExternalAddr = true
 
-	case in.Account != "" && in.AccountAddrType == looprpc.AddressType_ADDRESS_TYPE_UNKNOWN:
-		return nil, liquidity.ErrAccountAndAddrType
-
 	case in.Account != "":
 		// Derive a new receiving address from the stated account.
-		addrType, err := toWalletAddrType(in.AccountAddrType)
-		if err != nil {
-			return nil, err
-		}
-
-		// Check if account with address type exists.
-		if !s.accountExists(ctx, in.Account, addrType) {
-			return nil, fmt.Errorf("the provided account does " +
-				"not exist")
-		}
-
-		sweepAddr, err = s.lnd.WalletKit.NextAddr(
-			ctx, in.Account, addrType, false,
+		sweepAddr, err = s.accountAddr(
+			ctx, in.Account, in.AccountAddrType,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("NextAddr from account error: "+
-				"%v", err)
+			return nil, err
 		}
 
 		isExternalAddr = true
@@ -210,6 +200,22 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		req.OutgoingChanSet = in.OutgoingChanSet
 	}
 
+	req.SwapRoutePreferences, err = unmarshallRoutePreferences(
+		in.SwapRoutePreferences,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid swap route preferences: %w",
+			err)
+	}
+
+	req.PrepayRoutePreferences, err = unmarshallRoutePreferences(
+		in.PrepayRoutePreferences,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prepay route preferences: %w",
+			err)
+	}
+
 	info, err := s.impl.LoopOut(ctx, req)
 	if err != nil {
 		log.Errorf("LoopOut: %v", err)
@@ -233,6 +239,32 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 	return resp, nil
 }
 
+// accountAddr derives a new receiving address from the given lnd account.
+func (s *swapClientServer) accountAddr(ctx context.Context, account string,
+	accountAddrType looprpc.AddressType) (btcutil.Address, error) {
+
+	if accountAddrType == looprpc.AddressType_ADDRESS_TYPE_UNKNOWN {
+		return nil, liquidity.ErrAccountAndAddrType
+	}
+
+	addrType, err := toWalletAddrType(accountAddrType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if account with address type exists.
+	if !s.accountExists(ctx, account, addrType) {
+		return nil, fmt.Errorf("the provided account does not exist")
+	}
+
+	addr, err := s.lnd.WalletKit.NextAddr(ctx, account, addrType, false)
+	if err != nil {
+		return nil, fmt.Errorf("NextAddr from account error: %v", err)
+	}
+
+	return addr, nil
+}
+
 // accountExists returns true if account under the address type exists in the
 // backing lnd instance and false otherwise.
 func (s *swapClientServer) accountExists(ctx context.Context, account string,
@@ -265,8 +297,24 @@ func toWalletAddrType(addrType looprpc.AddressType) (walletrpc.AddressType,
 	}
 }
 
-func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
-	*looprpc.SwapStatus, error) {
+// placeholderAddr returns an address of the passed address type that is only
+// used to estimate the size of an output.
+func placeholderAddr(addrType looprpc.AddressType,
+	params *chaincfg.Params) (btcutil.Address, error) {
+
+	switch addrType {
+	case looprpc.AddressType_TAPROOT_PUBKEY:
+		return btcutil.NewAddressTaproot(make([]byte, 32), params)
+
+	default:
+		return nil, liquidity.ErrAccountAndAddrType
+	}
+}
+
+// marshallSwapState converts a swap state into its rpc state and failure
+// reason.
+func marshallSwapState(swapState loopdb.SwapState) (looprpc.SwapState,
+	looprpc.FailureReason, error) {
 
 	var (
 		state         looprpc.SwapState
@@ -278,7 +326,7 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 	// previous versions where we squashed all failure reasons to a single
 	// failure state, we set a failure reason for all our different failure
 	// states, and set our failed state for all of them.
-	switch loopSwap.State {
+	switch swapState {
 	case loopdb.StateInitiated:
 		state = looprpc.SwapState_INITIATED
 
@@ -322,7 +370,7 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 		failureReason = looprpc.FailureReason_FAILURE_REASON_INCORRECT_HTLC_AMT_SWEPT
 
 	default:
-		return nil, fmt.Errorf("unknown swap state: %v", loopSwap.State)
+		return 0, 0, fmt.Errorf("unknown swap state: %v", swapState)
 	}
 
 	// If we have a failure reason, we have a failure state, so should use
@@ -331,6 +379,17 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 		state = looprpc.SwapState_FAILED
 	}
 
+	return state, failureReason, nil
+}
+
+func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
+	*looprpc.SwapStatus, error) {
+
+	state, failureReason, err := marshallSwapState(loopSwap.State)
+	if err != nil {
+		return nil, err
+	}
+
 	var swapType looprpc.SwapType
 	var (
 		htlcAddress      string
@@ -494,29 +553,65 @@ func (s *swapClientServer) Monitor(in *looprpc.MonitorRequest,
 	}
 }
 
-// ListSwaps returns a list of all currently known swaps and their current
-// status.
-func (s *swapClientServer) ListSwaps(_ context.Context,
+// ListSwaps returns a page of the currently known swaps that match the filter
+// of the request, together with their current status.
+func (s *swapClientServer) ListSwaps(ctx context.Context,
 	req *looprpc.ListSwapsRequest) (*looprpc.ListSwapsResponse, error) {
 
-	var (
-		rpcSwaps = []*looprpc.SwapStatus{}
-		idx      = 0
-	)
+	query, err := unmarshallSwapQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rpcSwaps := []*looprpc.SwapStatus{}
+
+	// The filter may rule out all swap states.
+	if query == nil {
+		return &looprpc.ListSwapsResponse{Swaps: rpcSwaps}, nil
+	}
+
+	// We fetch one more swap than requested to find out whether there is
+	// another page of swaps.
+	if query.Limit != 0 && query.Limit < math.MaxUint32 {
+		query.Limit++
+	}
+
+	// The filtering, sorting and pagination is done by the database, so
+	// the swaps are selected by their last persisted state.
+	swaps, err := s.baseDb.QuerySwaps(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextOffset uint32
+	if req.MaxSwaps != 0 && len(swaps) > int(req.MaxSwaps) {
+		swaps = swaps[:req.MaxSwaps]
+		nextOffset = req.Offset + req.MaxSwaps
+	}
 
 	s.swapsLock.Lock()
 	defer s.swapsLock.Unlock()
 
-	// We can just use the server's in-memory cache as that contains the
-	// most up-to-date state including temporary failures which aren't
-	// persisted to disk. The swaps field is a map, that's why we need an
-	// additional index.
-	for _, swp := range s.swaps {
-		swp := swp
+	// We use the server's in-memory cache to return the selected swaps
+	// where possible as that contains the most up-to-date state including
+	// temporary failures which aren't persisted to disk. Swaps that aren't
+	// cached, for example because they were imported into the database,
+	// are returned as they are stored.
+	for _, queried := range swaps {
+		swp, ok := s.swaps[queried.Hash]
+		if !ok {
+			swapType := swap.TypeIn
+			if queried.LoopOut {
+				swapType = swap.TypeOut
+			}
 
-		// Filter the swap based on the provided filter.
-		if !filterSwap(&swp, req.ListSwapFilter) {
-			continue
+			swapInfo, err := s.impl.FetchSwap(
+				ctx, queried.Hash, swapType,
+			)
+			if err != nil {
+				return nil, err
+			}
+			swp = *swapInfo
 		}
 
 		rpcSwap, err := s.marshallSwap(&swp)
@@ -524,80 +619,137 @@ func (s *swapClientServer) ListSwaps(_ context.Context,
 			return nil, err
 		}
 		rpcSwaps = append(rpcSwaps, rpcSwap)
-		idx++
 	}
-	return &looprpc.ListSwapsResponse{Swaps: rpcSwaps}, nil
+
+	return &looprpc.ListSwapsResponse{
+		Swaps:      rpcSwaps,
+		NextOffset: nextOffset,
+	}, nil
 }
 
-// filterSwap filters the given swap based on the provided filter.
-func filterSwap(swapInfo *loop.SwapInfo, filter *looprpc.ListSwapsFilter) bool {
+// unmarshallSwapQuery converts a list swaps request into a swap query. If the
+// filter rules out all swap states, nil is returned.
+func unmarshallSwapQuery(req *looprpc.ListSwapsRequest) (*loopdb.SwapQuery,
+	error) {
+
+	query := &loopdb.SwapQuery{
+		Offset: req.Offset,
+		Limit:  req.MaxSwaps,
+	}
+
+	switch req.SortBy {
+	case looprpc.ListSwapsRequest_INITIATION_TIME:
+
+	case looprpc.ListSwapsRequest_AMOUNT:
+		query.SortByAmount = true
+
+	default:
+		return nil, fmt.Errorf("unknown sort field: %v", req.SortBy)
+	}
+	query.Descending = req.Descending
+
+	filter := req.ListSwapFilter
 	if filter == nil {
-		return true
+		return query, nil
 	}
 
-	// If the swap type filter is set, we only return swaps that match the
-	// filter.
-	if filter.SwapType != looprpc.ListSwapsFilter_ANY {
-		switch filter.SwapType {
-		case looprpc.ListSwapsFilter_LOOP_IN:
-			if swapInfo.SwapType != swap.TypeIn {
-				return false
-			}
+	switch filter.SwapType {
+	case looprpc.ListSwapsFilter_ANY:
 
-		case looprpc.ListSwapsFilter_LOOP_OUT:
-			if swapInfo.SwapType != swap.TypeOut {
-				return false
-			}
+	case looprpc.ListSwapsFilter_LOOP_OUT:
+		query.LoopOutOnly = true
+
+	case looprpc.ListSwapsFilter_LOOP_IN:
+		query.LoopInOnly = true
+
+	default:
+		return nil, fmt.Errorf("unknown swap type: %v", filter.SwapType)
+	}
+
+	if filter.PendingOnly || len(filter.States) > 0 {
+		states, err := unmarshallSwapStates(
+			filter.States, filter.PendingOnly,
+		)
+		if err != nil {
+			return nil, err
 		}
+
+		if len(states) == 0 {
+			return nil, nil
+		}
+		query.States = states
 	}
 
-	// If the pending only filter is set, we only return pending swaps.
-	if filter.PendingOnly && !swapInfo.State.IsPending() {
-		return false
+	if filter.StartTimeNs < 0 || filter.EndTimeNs < 0 {
+		return nil, errors.New("time range must not be negative")
+	}
+	if filter.StartTimeNs != 0 {
+		query.StartTime = time.Unix(0, filter.StartTimeNs)
+	}
+	if filter.EndTimeNs != 0 {
+		query.EndTime = time.Unix(0, filter.EndTimeNs)
 	}
 
-	// If the swap is of type loop out and the outgoing channel filter is
-	// set, we only return swaps that match the filter.
-	if swapInfo.SwapType == swap.TypeOut && filter.OutgoingChanSet != nil {
-		// First we sort both channel sets to make sure we can compare
-		// them.
-		sort.Slice(swapInfo.OutgoingChanSet, func(i, j int) bool {
-			return swapInfo.OutgoingChanSet[i] <
-				swapInfo.OutgoingChanSet[j]
-		})
-		sort.Slice(filter.OutgoingChanSet, func(i, j int) bool {
-			return filter.OutgoingChanSet[i] <
-				filter.OutgoingChanSet[j]
-		})
+	if filter.MinAmt < 0 || filter.MaxAmt < 0 {
+		return nil, errors.New("amount range must not be negative")
+	}
+	if filter.MaxAmt != 0 && filter.MinAmt > filter.MaxAmt {
+		return nil, errors.New("min amount exceeds max amount")
+	}
+	query.MinAmount = btcutil.Amount(filter.MinAmt)
+	query.MaxAmount = btcutil.Amount(filter.MaxAmt)
 
-		// Compare the outgoing channel set by using reflect.DeepEqual
-		// which compares the underlying arrays.
-		if !reflect.DeepEqual(swapInfo.OutgoingChanSet,
-			filter.OutgoingChanSet) {
+	query.Label = filter.Label
+	query.OutgoingChanSet = filter.OutgoingChanSet
 
-			return false
+	if filter.LoopInLastHop != nil {
+		lastHop, err := route.NewVertexFromBytes(filter.LoopInLastHop)
+		if err != nil {
+			return nil, err
 		}
+		query.LastHop = &lastHop
 	}
 
-	// If the swap is of type loop in and the last hop filter is set, we
-	// only return swaps that match the filter.
-	if swapInfo.SwapType == swap.TypeIn && filter.LoopInLastHop != nil {
-		// Compare the last hop by using reflect.DeepEqual which
-		// compares the underlying arrays.
-		if !reflect.DeepEqual(swapInfo.LastHop, filter.LoopInLastHop) {
-			return false
+	return query, nil
+}
+
+// unmarshallSwapStates returns the swap states that match one of the given
+// rpc states. If no rpc states are given, all swap states match. If pending
+// only is set, only pending swap states are returned.
+func unmarshallSwapStates(rpcStates []looprpc.SwapState,
+	pendingOnly bool) ([]loopdb.SwapState, error) {
+
+	requested := make(map[looprpc.SwapState]bool)
+	for _, rpcState := range rpcStates {
+		if _, ok := looprpc.SwapState_name[int32(rpcState)]; !ok {
+			return nil, fmt.Errorf("unknown swap state: %v",
+				rpcState)
 		}
+
+		requested[rpcState] = true
 	}
 
-	// If a label filter is set, we only return swaps that softly match the
-	// filter.
-	if filter.Label != "" {
-		if !strings.Contains(swapInfo.Label, filter.Label) {
-			return false
+	var states []loopdb.SwapState
+	for state := loopdb.StateInitiated; state <=
+		loopdb.StateFailIncorrectHtlcAmtSwept; state++ {
+
+		if pendingOnly && !state.IsPending() {
+			continue
+		}
+
+		rpcState, _, err := marshallSwapState(state)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(requested) > 0 && !requested[rpcState] {
+			continue
 		}
+
+		states = append(states, state)
 	}
 
-	return true
+	return states, nil
 }
 
 // SwapInfo returns all known details about a single swap.
@@ -812,6 +964,56 @@ func unmarshallRouteHints(rpcRouteHints []*swapserverrpc.RouteHint) (
 	return routeHints, nil
 }
 
+// unmarshallRoutePreferences unmarshalls the optional route preferences of a
+// payment.
+func unmarshallRoutePreferences(rpcPrefs *looprpc.RoutePreferences) (
+	loopdb.RoutePreferences, error) {
+
+	if rpcPrefs == nil {
+		return loopdb.RoutePreferences{}, nil
+	}
+
+	hops, err := unmarshallVertices(rpcPrefs.Hops)
+	if err != nil {
+		return loopdb.RoutePreferences{}, err
+	}
+
+	includeNodes, err := unmarshallVertices(rpcPrefs.IncludeNodes)
+	if err != nil {
+		return loopdb.RoutePreferences{}, err
+	}
+
+	excludeNodes, err := unmarshallVertices(rpcPrefs.ExcludeNodes)
+	if err != nil {
+		return loopdb.RoutePreferences{}, err
+	}
+
+	return loopdb.RoutePreferences{
+		Hops:         hops,
+		IncludeNodes: includeNodes,
+		ExcludeNodes: excludeNodes,
+	}, nil
+}
+
+// unmarshallVertices unmarshalls a list of compressed node public keys.
+func unmarshallVertices(rpcNodes [][]byte) ([]route.Vertex, error) {
+	if len(rpcNodes) == 0 {
+		return nil, nil
+	}
+
+	nodes := make([]route.Vertex, 0, len(rpcNodes))
+	for _, rpcNode := range rpcNodes {
+		node, err := route.NewVertexFromBytes(rpcNode)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
 // unmarshallHopHint unmarshalls a single hop hint.
 func unmarshallHopHint(rpcHint *swapserverrpc.HopHint) (zpay32.HopHint, error) {
 	pubBytes, err := hex.DecodeString(rpcHint.NodeId)
@@ -1196,128 +1398,649 @@ func (s *swapClientServer) ListReservations(ctx context.Context,
 
 	return &looprpc.ListReservationsResponse{
 		Reservations: ToClientReservations(
-			reservations,
+			reservations, s.reservationManager.CurrentHeight(),
 		),
 	}, nil
 }
 
-// InstantOut initiates an instant out swap.
-func (s *swapClientServer) InstantOut(ctx context.Context,
-	req *looprpc.InstantOutRequest) (*looprpc.InstantOutResponse,
-	error) {
-
-	reservationIds := make([]reservation.ID, len(req.ReservationIds))
-	for i, id := range req.ReservationIds {
-		if len(id) != reservation.IdLength {
-			return nil, fmt.Errorf("invalid reservation id: "+
-				"expected %v bytes, got %d",
-				reservation.IdLength, len(id))
-		}
-
-		var resId reservation.ID
-		copy(resId[:], id)
+// ReservationQuote returns the fee the server charges for opening a
+// reservation with the given amount and expiry.
+func (s *swapClientServer) ReservationQuote(ctx context.Context,
+	req *looprpc.ReservationQuoteRequest) (
+	*looprpc.ReservationQuoteResponse, error) {
 
-		reservationIds[i] = resId
+	if s.reservationManager == nil {
+		return nil, status.Error(codes.Unimplemented,
+			"Restart loop with --experimental")
 	}
 
-	instantOutFsm, err := s.instantOutManager.NewInstantOut(
-		ctx, reservationIds, req.DestAddr,
+	fee, err := s.reservationManager.QuoteReservation(
+		ctx, btcutil.Amount(req.Amt), req.Expiry,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	res := &looprpc.InstantOutResponse{
-		InstantOutHash: instantOutFsm.InstantOut.SwapHash[:],
-		State:          string(instantOutFsm.InstantOut.State),
-	}
+	return &looprpc.ReservationQuoteResponse{
+		FeeSat: int64(fee),
+	}, nil
+}
 
-	if instantOutFsm.InstantOut.SweepTxHash != nil {
-		res.SweepTxId = instantOutFsm.InstantOut.SweepTxHash.String()
-	}
+// RequestReservation requests a reservation with the given amount and expiry
+// from the server and opens it.
+func (s *swapClientServer) RequestReservation(ctx context.Context,
+	req *looprpc.RequestReservationRequest) (
+	*looprpc.RequestReservationResponse, error) {
 
-	return res, nil
-}
+	if s.reservationManager == nil {
+		return nil, status.Error(codes.Unimplemented,
+			"Restart loop with --experimental")
+	}
 
-// InstantOutQuote returns a quote for an instant out swap with the provided
-// parameters.
-func (s *swapClientServer) InstantOutQuote(ctx context.Context,
-	req *looprpc.InstantOutQuoteRequest) (
-	*looprpc.InstantOutQuoteResponse, error) {
+	if req.MaxFeeSat < 0 {
+		return nil, status.Error(codes.InvalidArgument,
+			"max fee must not be negative")
+	}
 
-	quote, err := s.instantOutManager.GetInstantOutQuote(
-		ctx, btcutil.Amount(req.Amt), int(req.NumReservations),
+	res, fee, err := s.reservationManager.RequestReservation(
+		ctx, btcutil.Amount(req.Amt), req.Expiry,
+		btcutil.Amount(req.MaxFeeSat),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &looprpc.InstantOutQuoteResponse{
-		ServiceFeeSat: int64(quote.ServiceFee),
-		SweepFeeSat:   int64(quote.OnChainFee),
+	return &looprpc.RequestReservationResponse{
+		Reservation: toClientReservation(
+			res, s.reservationManager.CurrentHeight(),
+		),
+		FeeSat: int64(fee),
 	}, nil
 }
 
-// ListInstantOuts returns a list of all currently known instant out swaps and
-// their current status.
-func (s *swapClientServer) ListInstantOuts(ctx context.Context,
-	_ *looprpc.ListInstantOutsRequest) (
-	*looprpc.ListInstantOutsResponse, error) {
+// MonitorReservations returns a stream of reservation updates. All known
+// reservations are sent first, followed by the updates of their state
+// machines and the alerts they raise.
+func (s *swapClientServer) MonitorReservations(
+	_ *looprpc.MonitorReservationsRequest,
+	server looprpc.SwapClient_MonitorReservationsServer) error {
 
-	instantOuts, err := s.instantOutManager.ListInstantOuts(ctx)
-	if err != nil {
-		return nil, err
+	if s.reservationManager == nil {
+		return status.Error(codes.Unimplemented,
+			"Restart loop with --experimental")
 	}
 
-	rpcSwaps := make([]*looprpc.InstantOut, 0, len(instantOuts))
-	for _, instantOut := range instantOuts {
-		rpcSwaps = append(rpcSwaps, rpcInstantOut(instantOut))
-	}
+	log.Infof("Monitor reservations request received")
 
-	return &looprpc.ListInstantOutsResponse{
-		Swaps: rpcSwaps,
-	}, nil
-}
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
 
-func rpcInstantOut(instantOut *instantout.InstantOut) *looprpc.InstantOut {
-	var sweepTxId string
-	if instantOut.SweepTxHash != nil {
-		sweepTxId = instantOut.SweepTxHash.String()
-	}
+	// Subscribe before fetching the known reservations, so that we don't
+	// miss any update in between. A client may therefore receive the
+	// same state of a reservation twice.
+	updates := s.reservationManager.SubscribeReservationUpdates(ctx)
 
-	reservations := make([][]byte, len(instantOut.Reservations))
-	for i, res := range instantOut.Reservations {
-		reservations[i] = res.ID[:]
+	reservations, err := s.reservationManager.GetReservations(ctx)
+	if err != nil {
+		return err
 	}
 
-	return &looprpc.InstantOut{
-		SwapHash:       instantOut.SwapHash[:],
-		State:          string(instantOut.State),
-		Amount:         uint64(instantOut.Value),
-		SweepTxId:      sweepTxId,
-		ReservationIds: reservations,
+	for _, res := range reservations {
+		err := server.Send(toClientReservation(
+			res, s.reservationManager.CurrentHeight(),
+		))
+		if err != nil {
+			return err
+		}
 	}
-}
 
-func rpcAutoloopReason(reason liquidity.Reason) (looprpc.AutoReason, error) {
-	switch reason {
-	case liquidity.ReasonNone:
-		return looprpc.AutoReason_AUTO_REASON_UNKNOWN, nil
+	// As long as the client is connected, keep passing through reservation
+	// updates.
+	for {
+		select {
+		case update := <-updates:
+			res := toClientReservation(
+				update.Reservation,
+				s.reservationManager.CurrentHeight(),
+			)
+			if update.Alert != nil {
+				res.Alert = update.Alert.String()
+			}
 
-	case liquidity.ReasonBudgetNotStarted:
-		return looprpc.AutoReason_AUTO_REASON_BUDGET_NOT_STARTED, nil
+			err := server.Send(res)
+			if err != nil {
+				return err
+			}
 
-	case liquidity.ReasonSweepFees:
-		return looprpc.AutoReason_AUTO_REASON_SWEEP_FEES, nil
+		// The client cancels the subscription.
+		case <-ctx.Done():
+			return nil
 
-	case liquidity.ReasonBudgetElapsed:
-		return looprpc.AutoReason_AUTO_REASON_BUDGET_ELAPSED, nil
+		// The server is shutting down.
+		case <-s.mainCtx.Done():
+			return fmt.Errorf("server is shutting down")
+		}
+	}
+}
 
-	case liquidity.ReasonInFlight:
-		return looprpc.AutoReason_AUTO_REASON_IN_FLIGHT, nil
+// InstantOut initiates an instant out swap.
+func (s *swapClientServer) InstantOut(ctx context.Context,
+	req *looprpc.InstantOutRequest) (*looprpc.InstantOutResponse,
+	error) {
 
-	case liquidity.ReasonSwapFee:
-		return looprpc.AutoReason_AUTO_REASON_SWAP_FEE, nil
+	reservationIds, err := parseReservationIds(req.ReservationIds)
+	if err != nil {
+		return nil, err
+	}
+
+	sweepAddr, sweepOutputs, err := s.instantOutDestinations(
+		ctx, req.DestAddr, req.Destinations, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	instantOutFsm, err := s.instantOutManager.NewInstantOut(
+		ctx, reservationIds, btcutil.Amount(req.Amt), sweepAddr,
+		sweepOutputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &looprpc.InstantOutResponse{
+		InstantOutHash: instantOutFsm.InstantOut.SwapHash[:],
+		State:          string(instantOutFsm.InstantOut.State),
+	}
+
+	if instantOutFsm.InstantOut.SweepTxHash != nil {
+		res.SweepTxId = instantOutFsm.InstantOut.SweepTxHash.String()
+	}
+
+	return res, nil
+}
+
+// instantOutDestinations resolves the destinations of an instant out request
+// into the address that receives the remainder of the swap and the outputs
+// that receive fixed amounts. A nil address means that the remainder is sent
+// to a new wallet address. If deriveAccountAddrs isn't set, account
+// destinations are resolved to a placeholder address of the account's address
+// type, which is sufficient for fee estimates.
+func (s *swapClientServer) instantOutDestinations(ctx context.Context,
+	destAddr string, destinations []*looprpc.InstantOutDestination,
+	deriveAccountAddrs bool) (btcutil.Address, []instantout.SweepOutput,
+	error) {
+
+	var (
+		sweepAddr    btcutil.Address
+		sweepOutputs []instantout.SweepOutput
+		err          error
+	)
+	if destAddr != "" {
+		sweepAddr, err = btcutil.DecodeAddress(
+			destAddr, s.lnd.ChainParams,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decode address: %v", err)
+		}
+	}
+
+	for _, dest := range destinations {
+		var addr btcutil.Address
+		switch {
+		case dest.Addr != "" && dest.Account != "":
+			return nil, nil, fmt.Errorf("destination address and " +
+				"account cannot be set at the same time")
+
+		case dest.Addr != "":
+			addr, err = btcutil.DecodeAddress(
+				dest.Addr, s.lnd.ChainParams,
+			)
+			if err != nil {
+				return nil, nil, fmt.Errorf("decode address: "+
+					"%v", err)
+			}
+
+		case dest.Account != "" && !deriveAccountAddrs:
+			addr, err = placeholderAddr(
+				dest.AccountAddrType, s.lnd.ChainParams,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+		case dest.Account != "":
+			addr, err = s.accountAddr(
+				ctx, dest.Account, dest.AccountAddrType,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+		default:
+			return nil, nil, fmt.Errorf("destination requires " +
+				"an address or an account")
+		}
+
+		if dest.Amt != 0 {
+			sweepOutputs = append(
+				sweepOutputs, instantout.SweepOutput{
+					Address: addr,
+					Value:   btcutil.Amount(dest.Amt),
+				},
+			)
+
+			continue
+		}
+
+		// Only one destination can receive the remainder of the swap.
+		if sweepAddr != nil {
+			return nil, nil, fmt.Errorf("only one destination " +
+				"can receive the remainder of the swap")
+		}
+
+		sweepAddr = addr
+	}
+
+	return sweepAddr, sweepOutputs, nil
+}
+
+// InstantOutQuote returns a quote for an instant out swap with the provided
+// parameters.
+func (s *swapClientServer) InstantOutQuote(ctx context.Context,
+	req *looprpc.InstantOutQuoteRequest) (
+	*looprpc.InstantOutQuoteResponse, error) {
+
+	reservationIds, err := parseReservationIds(req.ReservationIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// The quote only needs the outputs of the destinations, so we don't
+	// derive new addresses from accounts.
+	_, sweepOutputs, err := s.instantOutDestinations(
+		ctx, "", req.Destinations, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.instantOutManager.GetInstantOutQuote(
+		ctx, btcutil.Amount(req.Amt), int(req.NumReservations),
+		reservationIds, sweepOutputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.InstantOutQuoteResponse{
+		ServiceFeeSat: int64(quote.ServiceFee),
+		SweepFeeSat:   int64(quote.OnChainFee),
+		ChangeAmtSat:  int64(quote.ChangeAmt),
+	}, nil
+}
+
+// parseReservationIds parses the passed rpc reservation ids.
+func parseReservationIds(ids [][]byte) ([]reservation.ID, error) {
+	reservationIds := make([]reservation.ID, len(ids))
+	for i, id := range ids {
+		if len(id) != reservation.IdLength {
+			return nil, fmt.Errorf("invalid reservation id: "+
+				"expected %v bytes, got %d",
+				reservation.IdLength, len(id))
+		}
+
+		copy(reservationIds[i][:], id)
+	}
+
+	return reservationIds, nil
+}
+
+// ListInstantOuts returns a list of all currently known instant out swaps and
+// their current status.
+func (s *swapClientServer) ListInstantOuts(ctx context.Context,
+	_ *looprpc.ListInstantOutsRequest) (
+	*looprpc.ListInstantOutsResponse, error) {
+
+	instantOuts, err := s.instantOutManager.ListInstantOuts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSwaps := make([]*looprpc.InstantOut, 0, len(instantOuts))
+	for _, instantOut := range instantOuts {
+		rpcSwaps = append(rpcSwaps, rpcInstantOut(instantOut))
+	}
+
+	return &looprpc.ListInstantOutsResponse{
+		Swaps: rpcSwaps,
+	}, nil
+}
+
+// CancelInstantOut cancels an instant out swap that hasn't sent its htlc
+// signatures to the server yet.
+func (s *swapClientServer) CancelInstantOut(ctx context.Context,
+	req *looprpc.CancelInstantOutRequest) (
+	*looprpc.CancelInstantOutResponse, error) {
+
+	if s.instantOutManager == nil {
+		return nil, status.Error(codes.Unimplemented,
+			"Restart loop with --experimental")
+	}
+
+	swapHash, err := lntypes.MakeHash(req.SwapHash)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+	}
+
+	err = s.instantOutManager.CancelInstantOut(ctx, swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.CancelInstantOutResponse{}, nil
+}
+
+// MonitorInstantOuts returns a stream of instant out updates. All known
+// instant outs are sent first, followed by the updates of their state
+// machines.
+func (s *swapClientServer) MonitorInstantOuts(
+	_ *looprpc.MonitorInstantOutsRequest,
+	server looprpc.SwapClient_MonitorInstantOutsServer) error {
+
+	if s.instantOutManager == nil {
+		return status.Error(codes.Unimplemented,
+			"Restart loop with --experimental")
+	}
+
+	log.Infof("Monitor instant outs request received")
+
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+
+	// Subscribe before fetching the known instant outs, so that we don't
+	// miss any update in between. A client may therefore receive the
+	// same state of an instant out twice.
+	updates := s.instantOutManager.SubscribeInstantOutUpdates(ctx)
+
+	instantOuts, err := s.instantOutManager.ListInstantOuts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, instantOut := range instantOuts {
+		err := server.Send(rpcInstantOut(instantOut))
+		if err != nil {
+			return err
+		}
+	}
+
+	// As long as the client is connected, keep passing through instant
+	// out updates.
+	for {
+		select {
+		case instantOut := <-updates:
+			err := server.Send(rpcInstantOut(instantOut))
+			if err != nil {
+				return err
+			}
+
+		// The client cancels the subscription.
+		case <-ctx.Done():
+			return nil
+
+		// The server is shutting down.
+		case <-s.mainCtx.Done():
+			return fmt.Errorf("server is shutting down")
+		}
+	}
+}
+
+func rpcInstantOut(instantOut *instantout.InstantOut) *looprpc.InstantOut {
+	var sweepTxId string
+	if instantOut.SweepTxHash != nil {
+		sweepTxId = instantOut.SweepTxHash.String()
+	}
+
+	reservations := make([][]byte, len(instantOut.Reservations))
+	for i, res := range instantOut.Reservations {
+		reservations[i] = res.ID[:]
+	}
+
+	var changeReservationId []byte
+	if instantOut.ChangeReservation != nil {
+		changeReservationId = instantOut.ChangeReservation.ID[:]
+	}
+
+	return &looprpc.InstantOut{
+		SwapHash:            instantOut.SwapHash[:],
+		State:               string(instantOut.State),
+		Amount:              uint64(instantOut.Value),
+		SweepTxId:           sweepTxId,
+		ReservationIds:      reservations,
+		ChangeReservationId: changeReservationId,
+	}
+}
+
+// FundLoopInPsbt creates a funded but unsigned PSBT that pays the htlc of a
+// pending external loop in swap.
+func (s *swapClientServer) FundLoopInPsbt(ctx context.Context,
+	req *looprpc.FundLoopInPsbtRequest) (*looprpc.FundLoopInPsbtResponse,
+	error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+	}
+
+	if req.SatPerVbyte != 0 && req.ConfTarget != 0 {
+		return nil, errors.New("only one of sat_per_vbyte and " +
+			"conf_target can be set")
+	}
+
+	if req.ConfTarget != 0 && req.ConfTarget < minConfTarget {
+		return nil, fmt.Errorf("a confirmation target of at least %v "+
+			"is required", minConfTarget)
+	}
+
+	utxos := make([]wire.OutPoint, 0, len(req.Outpoints))
+	for _, outpoint := range req.Outpoints {
+		op, err := wire.NewOutPointFromString(outpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid outpoint %v: %v",
+				outpoint, err)
+		}
+
+		utxos = append(utxos, *op)
+	}
+
+	resp, err := s.impl.FundLoopInPsbt(ctx, &loop.FundLoopInPsbtRequest{
+		SwapHash:    swapHash,
+		Account:     req.Account,
+		Utxos:       utxos,
+		SatPerVbyte: req.SatPerVbyte,
+		ConfTarget:  req.ConfTarget,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := resp.Packet.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	return &looprpc.FundLoopInPsbtResponse{
+		Psbt:              buf.Bytes(),
+		HtlcOutputIndex:   resp.HtlcOutputIndex,
+		ChangeOutputIndex: resp.ChangeOutputIndex,
+		FeeSat:            int64(resp.Fee),
+	}, nil
+}
+
+// PublishLoopInPsbt verifies and publishes a signed PSBT that pays the htlc of
+// a pending external loop in swap.
+func (s *swapClientServer) PublishLoopInPsbt(ctx context.Context,
+	req *looprpc.PublishLoopInPsbtRequest) (
+	*looprpc.PublishLoopInPsbtResponse, error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+	}
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.SignedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing psbt: %v", err)
+	}
+
+	tx, err := s.impl.PublishLoopInPsbt(ctx, swapHash, packet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.PublishLoopInPsbtResponse{
+		Txid: tx.TxHash().String(),
+	}, nil
+}
+
+// GetStateMachine returns the live state of the state machine of an active
+// swap, reservation or instant out.
+func (s *swapClientServer) GetStateMachine(_ context.Context,
+	req *looprpc.GetStateMachineRequest) (*looprpc.GetStateMachineResponse,
+	error) {
+
+	if len(req.Id) != lntypes.HashSize {
+		return nil, status.Errorf(codes.InvalidArgument, "state "+
+			"machine id must be %d bytes", lntypes.HashSize)
+	}
+
+	var (
+		kind    string
+		machine fsm.Introspector
+	)
+
+	var swapHash lntypes.Hash
+	copy(swapHash[:], req.Id)
+
+	swapType, swapFSM, err := s.impl.GetSwapStateMachine(swapHash)
+	if err == nil {
+		kind, machine = "loopout", swapFSM
+		if swapType == swap.TypeIn {
+			kind = "loopin"
+		}
+	}
+
+	if machine == nil && s.reservationManager != nil {
+		var id reservation.ID
+		copy(id[:], req.Id)
+
+		reservationFSM, err := s.reservationManager.
+			GetActiveReservation(id)
+		if err == nil {
+			kind, machine = "reservation", reservationFSM
+		}
+	}
+
+	if machine == nil && s.instantOutManager != nil {
+		instantOutFSM, err := s.instantOutManager.
+			GetActiveInstantOut(swapHash)
+		if err == nil {
+			kind, machine = "instantout", instantOutFSM
+		}
+	}
+
+	if machine == nil {
+		return nil, status.Errorf(codes.NotFound, "no active state "+
+			"machine with id %x", req.Id)
+	}
+
+	return toStateMachineResponse(
+		kind, req.Id, machine.Snapshot(), req.GraphFormat,
+	)
+}
+
+// toStateMachineResponse converts a state machine snapshot to its rpc
+// representation and renders the state graph in the requested format.
+func toStateMachineResponse(kind string, id []byte, snapshot *fsm.Snapshot,
+	format looprpc.StateMachineGraphFormat) (
+	*looprpc.GetStateMachineResponse, error) {
+
+	resp := &looprpc.GetStateMachineResponse{
+		Kind:          kind,
+		Id:            id,
+		CurrentState:  string(snapshot.CurrentState),
+		PreviousState: string(snapshot.PreviousState),
+	}
+
+	if snapshot.LastActionError != nil {
+		resp.LastActionError = snapshot.LastActionError.Error()
+	}
+
+	for _, entry := range snapshot.History {
+		transition := &looprpc.StateMachineTransition{
+			PreviousState: string(entry.PreviousState),
+			NextState:     string(entry.NextState),
+			Event:         string(entry.Event),
+		}
+		if entry.LastActionError != nil {
+			transition.LastActionError =
+				entry.LastActionError.Error()
+		}
+
+		resp.History = append(resp.History, transition)
+	}
+
+	for _, state := range snapshot.States {
+		resp.States = append(resp.States, string(state))
+	}
+
+	for _, edge := range snapshot.Edges {
+		resp.Edges = append(resp.Edges, &looprpc.StateMachineEdge{
+			FromState: string(edge.From),
+			ToState:   string(edge.To),
+			Event:     string(edge.Event),
+		})
+	}
+
+	switch format {
+	case looprpc.StateMachineGraphFormat_GRAPH_FORMAT_NONE:
+
+	case looprpc.StateMachineGraphFormat_GRAPH_FORMAT_MERMAID:
+		resp.Graph = snapshot.Mermaid()
+
+	case looprpc.StateMachineGraphFormat_GRAPH_FORMAT_DOT:
+		resp.Graph = snapshot.Dot()
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown "+
+			"graph format: %v", format)
+	}
+
+	return resp, nil
+}
+
+func rpcAutoloopReason(reason liquidity.Reason) (looprpc.AutoReason, error) {
+	switch reason {
+	case liquidity.ReasonNone:
+		return looprpc.AutoReason_AUTO_REASON_UNKNOWN, nil
+
+	case liquidity.ReasonBudgetNotStarted:
+		return looprpc.AutoReason_AUTO_REASON_BUDGET_NOT_STARTED, nil
+
+	case liquidity.ReasonSweepFees:
+		return looprpc.AutoReason_AUTO_REASON_SWEEP_FEES, nil
+
+	case liquidity.ReasonBudgetElapsed:
+		return looprpc.AutoReason_AUTO_REASON_BUDGET_ELAPSED, nil
+
+	case liquidity.ReasonInFlight:
+		return looprpc.AutoReason_AUTO_REASON_IN_FLIGHT, nil
+
+	case liquidity.ReasonSwapFee:
+		return looprpc.AutoReason_AUTO_REASON_SWAP_FEE, nil
 
 	case liquidity.ReasonMinerFee:
 		return looprpc.AutoReason_AUTO_REASON_MINER_FEE, nil
@@ -1561,12 +2284,12 @@ func getPublicationDeadline(unixTimestamp uint64) time.Time {
 
 // ToClientReservations converts a slice of server
 // reservations to a slice of client reservations.
-func ToClientReservations(
-	res []*reservation.Reservation) []*looprpc.ClientReservation {
+func ToClientReservations(res []*reservation.Reservation,
+	currentHeight int32) []*looprpc.ClientReservation {
 
 	var result []*looprpc.ClientReservation
 	for _, r := range res {
-		result = append(result, toClientReservation(r))
+		result = append(result, toClientReservation(r, currentHeight))
 	}
 
 	return result
@@ -1574,8 +2297,8 @@ func ToClientReservations(
 
 // toClientReservation converts a server reservation to a
 // client reservation.
-func toClientReservation(
-	res *reservation.Reservation) *looprpc.ClientReservation {
+func toClientReservation(res *reservation.Reservation,
+	currentHeight int32) *looprpc.ClientReservation {
 
 	var (
 		txid string
@@ -1586,12 +2309,261 @@ func toClientReservation(
 		vout = res.Outpoint.Index
 	}
 
+	var spendTxid string
+	if res.SpendTxHash != nil {
+		spendTxid = res.SpendTxHash.String()
+	}
+
 	return &looprpc.ClientReservation{
-		ReservationId: res.ID[:],
-		State:         string(res.State),
-		Amount:        uint64(res.Value),
-		TxId:          txid,
-		Vout:          vout,
-		Expiry:        res.Expiry,
+		ReservationId:      res.ID[:],
+		State:              string(res.State),
+		Amount:             uint64(res.Value),
+		TxId:               txid,
+		Vout:               vout,
+		Expiry:             res.Expiry,
+		ConfirmationHeight: res.ConfirmationHeight,
+		BlocksUntilExpiry:  res.BlocksUntilExpiry(currentHeight),
+		SpendTxId:          spendTxid,
+		SpendHeight:        res.SpendHeight,
+	}
+}
+
+// ExportDatabase exports the swap database into a versioned JSON archive.
+func (s *swapClientServer) ExportDatabase(ctx context.Context,
+	_ *looprpc.ExportDatabaseRequest) (*looprpc.ExportDatabaseResponse,
+	error) {
+
+	archive, err := s.baseDb.ExportArchive(ctx)
+	if err != nil {
+		return nil, err
 	}
+
+	data, err := loopdb.EncodeArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Exported %d loop outs, %d loop ins, %d reservations, %d "+
+		"instant outs and %d sweep batches", len(archive.LoopOuts),
+		len(archive.LoopIns), len(archive.Reservations),
+		len(archive.InstantOuts), len(archive.SweepBatches))
+
+	return &looprpc.ExportDatabaseResponse{
+		Archive: data,
+		Version: archive.Version,
+	}, nil
+}
+
+// ImportDatabase imports an archive created by ExportDatabase into the empty
+// swap database. Pending swaps of the archive are resumed on restart.
+func (s *swapClientServer) ImportDatabase(ctx context.Context,
+	req *looprpc.ImportDatabaseRequest) (*looprpc.ImportDatabaseResponse,
+	error) {
+
+	archive, err := loopdb.DecodeArchive(req.Archive)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.baseDb.ImportArchive(ctx, archive)
+	if errors.Is(err, loopdb.ErrDatabaseNotEmpty) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Imported %d loop outs, %d loop ins, %d reservations, %d "+
+		"instant outs and %d sweep batches, restart loopd to resume "+
+		"pending swaps", len(archive.LoopOuts), len(archive.LoopIns),
+		len(archive.Reservations), len(archive.InstantOuts),
+		len(archive.SweepBatches))
+
+	return &looprpc.ImportDatabaseResponse{
+		LoopOuts:     uint32(len(archive.LoopOuts)),
+		LoopIns:      uint32(len(archive.LoopIns)),
+		Reservations: uint32(len(archive.Reservations)),
+		InstantOuts:  uint32(len(archive.InstantOuts)),
+		SweepBatches: uint32(len(archive.SweepBatches)),
+	}, nil
+}
+
+// CompactSwaps compacts the loop outs and loop ins that reached a final state
+// more than the requested number of days ago.
+func (s *swapClientServer) CompactSwaps(ctx context.Context,
+	req *looprpc.CompactSwapsRequest) (*looprpc.CompactSwapsResponse,
+	error) {
+
+	result, err := compactSwaps(
+		ctx, s.baseDb, req.OlderThanDays, req.Archive,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.CompactSwapsResponse{
+		DeletedUpdates: result.DeletedUpdates,
+	}
+	for _, hash := range result.Swaps {
+		resp.Ids = append(resp.Ids, hash[:])
+	}
+
+	if result.Archive != nil {
+		resp.Archive, err = loopdb.EncodeArchive(result.Archive)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// RecoverSwaps restores the pending swaps of an encrypted swap backup that
+// aren't stored in the database yet and resumes them.
+func (s *swapClientServer) RecoverSwaps(ctx context.Context,
+	req *looprpc.RecoverSwapsRequest) (*looprpc.RecoverSwapsResponse,
+	error) {
+
+	if len(req.Backup) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "backup missing")
+	}
+
+	if s.swapBackup == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition, "swap backup is disabled",
+		)
+	}
+
+	loopOuts, loopIns, err := s.swapBackup.Restore(ctx, req.Backup)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.impl.ResumeSwaps(ctx, loopOuts, loopIns)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.RecoverSwapsResponse{}
+	for _, hash := range loopOuts {
+		resp.LoopOuts = append(resp.LoopOuts, hash.String())
+	}
+	for _, hash := range loopIns {
+		resp.LoopIns = append(resp.LoopIns, hash.String())
+	}
+
+	return resp, nil
+}
+
+// RecoverHtlc creates, signs and optionally publishes a transaction that
+// spends the htlc of a swap to the client's wallet.
+func (s *swapClientServer) RecoverHtlc(ctx context.Context,
+	req *looprpc.RecoverHtlcRequest) (*looprpc.RecoverHtlcResponse,
+	error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+	}
+
+	if req.SatPerVbyte == 0 {
+		return nil, status.Error(
+			codes.InvalidArgument, "sat_per_vbyte missing",
+		)
+	}
+
+	var destAddr btcutil.Address
+	if req.Addr != "" {
+		destAddr, err = btcutil.DecodeAddress(
+			req.Addr, s.lnd.ChainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("decode address: %v", err)
+		}
+
+		if !destAddr.IsForNet(s.lnd.ChainParams) {
+			return nil, fmt.Errorf("%w: Current active network is "+
+				"%s", errIncorrectChain, s.lnd.ChainParams.Name)
+		}
+	}
+
+	resp, err := s.impl.RecoverHtlc(ctx, &loop.RecoverHtlcRequest{
+		SwapHash:    swapHash,
+		DestAddr:    destAddr,
+		SatPerVbyte: req.SatPerVbyte,
+		Publish:     req.Publish,
+	})
+	switch {
+	case errors.Is(err, loop.ErrSwapNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, loop.ErrHtlcNotExpired):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := resp.Tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	return &looprpc.RecoverHtlcResponse{
+		Txid:      resp.Tx.TxHash().String(),
+		TxHex:     hex.EncodeToString(buf.Bytes()),
+		FeeSat:    int64(resp.Fee),
+		Published: resp.Published,
+	}, nil
+}
+
+// GetPresignedTimeouts returns the presigned timeout transactions of the htlcs
+// of pending loop in swaps.
+func (s *swapClientServer) GetPresignedTimeouts(ctx context.Context,
+	req *looprpc.GetPresignedTimeoutsRequest) (
+	*looprpc.GetPresignedTimeoutsResponse, error) {
+
+	var swapHash *lntypes.Hash
+	if len(req.Id) > 0 {
+		hash, err := lntypes.MakeHash(req.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing swap hash: %v",
+				err)
+		}
+		swapHash = &hash
+	}
+
+	timeouts, err := s.impl.PresignedTimeouts(ctx, swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &looprpc.GetPresignedTimeoutsResponse{}
+	for _, timeout := range timeouts {
+		rpcTimeouts := &looprpc.PresignedTimeouts{
+			Id:         timeout.SwapHash.String(),
+			CltvExpiry: timeout.CltvExpiry,
+		}
+
+		for _, timeoutTx := range timeout.Txs {
+			tx := timeoutTx.Tx
+
+			var buf bytes.Buffer
+			if err := tx.Serialize(&buf); err != nil {
+				return nil, err
+			}
+
+			rpcTx := &looprpc.PresignedTimeoutTx{
+				SatPerVbyte: timeoutTx.SatPerVbyte,
+				FeeSat:      int64(timeoutTx.Fee),
+				Txid:        tx.TxHash().String(),
+				TxHex:       hex.EncodeToString(buf.Bytes()),
+			}
+			rpcTimeouts.Txs = append(rpcTimeouts.Txs, rpcTx)
+		}
+
+		resp.Swaps = append(resp.Swaps, rpcTimeouts)
+	}
+
+	return resp, nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
 	mock_lnd "github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -573,3 +574,75 @@ func TestHasBandwidth(t *testing.T) {
 		})
 	}
 }
+
+// TestUnmarshallSwapStates tests that rpc swap states are converted into the
+// matching swap states.
+func TestUnmarshallSwapStates(t *testing.T) {
+	tests := []struct {
+		name        string
+		rpcStates   []looprpc.SwapState
+		pendingOnly bool
+		expected    []loopdb.SwapState
+		expectErr   bool
+	}{
+		{
+			name: "success",
+			rpcStates: []looprpc.SwapState{
+				looprpc.SwapState_SUCCESS,
+			},
+			expected: []loopdb.SwapState{loopdb.StateSuccess},
+		},
+		{
+			name: "failed and pending",
+			rpcStates: []looprpc.SwapState{
+				looprpc.SwapState_FAILED,
+			},
+			pendingOnly: true,
+			expected: []loopdb.SwapState{
+				loopdb.StateFailTemporary,
+				loopdb.StateFailIncorrectHtlcAmt,
+			},
+		},
+		{
+			name:        "pending",
+			pendingOnly: true,
+			expected: []loopdb.SwapState{
+				loopdb.StateInitiated,
+				loopdb.StatePreimageRevealed,
+				loopdb.StateFailTemporary,
+				loopdb.StateHtlcPublished,
+				loopdb.StateInvoiceSettled,
+				loopdb.StateFailIncorrectHtlcAmt,
+			},
+		},
+		{
+			name: "success and pending",
+			rpcStates: []looprpc.SwapState{
+				looprpc.SwapState_SUCCESS,
+			},
+			pendingOnly: true,
+		},
+		{
+			name:      "unknown state",
+			rpcStates: []looprpc.SwapState{99},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			states, err := unmarshallSwapStates(
+				test.rpcStates, test.pendingOnly,
+			)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, states)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package loopd
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -39,6 +40,13 @@ func getClient(cfg *Config, swapDb loopdb.SwapStore,
 			" were specified; they are not allowed together")
 	}
 
+	presignedTimeoutFeeRates, err := parseFeeRates(
+		cfg.PresignedTimeoutFeeRates,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	clientConfig := &loop.ClientConfig{
 		ServerAddress:       cfg.Server.Host,
 		ProxyAddress:        cfg.Server.Proxy,
@@ -50,6 +58,8 @@ func getClient(cfg *Config, swapDb loopdb.SwapStore,
 		LoopOutMaxParts:     cfg.LoopOutMaxParts,
 		TotalPaymentTimeout: cfg.TotalPaymentTimeout,
 		MaxPaymentRetries:   cfg.MaxPaymentRetries,
+
+		PresignedTimeoutFeeRates: presignedTimeoutFeeRates,
 	}
 
 	if cfg.MaxL402Cost == defaultCost && cfg.MaxLSATCost != 0 {
@@ -110,6 +120,23 @@ func openDatabase(cfg *Config, chainParams *chaincfg.Params) (loopdb.SwapStore,
 	return db, &baseDb, nil
 }
 
+// compactSwaps compacts the swaps that reached a final state more than the
+// given number of days ago.
+func compactSwaps(ctx context.Context, db *loopdb.BaseDB, days uint32,
+	archive bool) (*loopdb.CompactionResult, error) {
+
+	before := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	result, err := db.CompactSwaps(ctx, before, archive)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Compacted %d swaps finished before %v, deleted %d swap "+
+		"updates", len(result.Swaps), before, result.DeletedUpdates)
+
+	return result, nil
+}
+
 func getLiquidityManager(client *loop.Client) *liquidity.Manager {
 	mngrCfg := &liquidity.Config{
 		AutoloopTicker: ticker.NewForce(liquidity.DefaultAutoloopTicker),
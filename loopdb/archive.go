@@ -0,0 +1,1205 @@
+package loopdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// ArchiveVersion is the version of the database archive format. It needs to
+// be bumped whenever the format changes in a way that older versions of loopd
+// can't import.
+const ArchiveVersion = 1
+
+var (
+	// ErrUnknownArchiveVersion is returned if an archive was created with
+	// an unknown version of the archive format.
+	ErrUnknownArchiveVersion = errors.New("unknown archive version")
+
+	// ErrDatabaseNotEmpty is returned if an archive is imported into a
+	// database that already contains swaps, reservations or sweep
+	// batches.
+	ErrDatabaseNotEmpty = errors.New("database is not empty")
+)
+
+// HexBytes is a byte slice that is hex encoded in an archive.
+type HexBytes []byte
+
+// MarshalText hex encodes the bytes.
+func (b HexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+// UnmarshalText decodes hex encoded bytes. Empty text decodes to nil, so that
+// optional fields are stored as NULL again.
+func (b *HexBytes) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*b = nil
+		return nil
+	}
+
+	decoded, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	*b = decoded
+
+	return nil
+}
+
+// Archive is a portable copy of the swap database. It contains all swaps with
+// their updates, the presigned timeout transactions of the loop ins, the
+// liquidity parameters, the reservations, the instant outs and the sweep
+// batches.
+type Archive struct {
+	// Version is the version of the archive format.
+	Version uint32 `json:"version"`
+
+	// CreatedAt is the time the archive was created at.
+	CreatedAt time.Time `json:"created_at"`
+
+	// LoopOuts are the loop out swaps.
+	LoopOuts []*ArchivedLoopOut `json:"loop_outs"`
+
+	// LoopIns are the loop in swaps.
+	LoopIns []*ArchivedLoopIn `json:"loop_ins"`
+
+	// LiquidityParams are the serialized liquidity parameters, if set.
+	LiquidityParams HexBytes `json:"liquidity_params,omitempty"`
+
+	// Reservations are the reservations.
+	Reservations []*ArchivedReservation `json:"reservations"`
+
+	// InstantOuts are the instant out swaps.
+	InstantOuts []*ArchivedInstantOut `json:"instant_outs"`
+
+	// SweepBatches are the sweep batches with their sweeps.
+	SweepBatches []*ArchivedSweepBatch `json:"sweep_batches"`
+}
+
+// ArchivedSwap contains the data that all swap types share.
+type ArchivedSwap struct {
+	Hash             HexBytes  `json:"hash"`
+	Preimage         HexBytes  `json:"preimage"`
+	InitiationTime   time.Time `json:"initiation_time"`
+	AmountRequested  int64     `json:"amount_requested"`
+	CltvExpiry       int32     `json:"cltv_expiry"`
+	MaxMinerFee      int64     `json:"max_miner_fee"`
+	MaxSwapFee       int64     `json:"max_swap_fee"`
+	InitiationHeight int32     `json:"initiation_height"`
+	ProtocolVersion  int32     `json:"protocol_version"`
+	Label            string    `json:"label"`
+
+	// HtlcKeys are the keys of the swap htlc.
+	HtlcKeys ArchivedHtlcKeys `json:"htlc_keys"`
+}
+
+// ArchivedHtlcKeys contains the keys of a swap htlc.
+type ArchivedHtlcKeys struct {
+	SenderScriptPubkey     HexBytes `json:"sender_script_pubkey"`
+	ReceiverScriptPubkey   HexBytes `json:"receiver_script_pubkey"`
+	SenderInternalPubkey   HexBytes `json:"sender_internal_pubkey,omitempty"`
+	ReceiverInternalPubkey HexBytes `json:"receiver_internal_pubkey,omitempty"`
+	ClientKeyFamily        int32    `json:"client_key_family"`
+	ClientKeyIndex         int32    `json:"client_key_index"`
+}
+
+// ArchivedSwapUpdate is an update of a loop out or loop in swap.
+type ArchivedSwapUpdate struct {
+	Timestamp    time.Time `json:"timestamp"`
+	State        SwapState `json:"state"`
+	HtlcTxHash   string    `json:"htlc_txhash,omitempty"`
+	ServerCost   int64     `json:"server_cost"`
+	OnchainCost  int64     `json:"onchain_cost"`
+	OffchainCost int64     `json:"offchain_cost"`
+}
+
+// ArchivedLoopOut is a loop out swap with its updates.
+type ArchivedLoopOut struct {
+	Swap                    ArchivedSwap          `json:"swap"`
+	DestAddress             string                `json:"dest_address"`
+	SwapInvoice             string                `json:"swap_invoice"`
+	MaxSwapRoutingFee       int64                 `json:"max_swap_routing_fee"`
+	SweepConfTarget         int32                 `json:"sweep_conf_target"`
+	HtlcConfirmations       int32                 `json:"htlc_confirmations"`
+	OutgoingChanSet         string                `json:"outgoing_chan_set"`
+	PrepayInvoice           string                `json:"prepay_invoice"`
+	MaxPrepayRoutingFee     int64                 `json:"max_prepay_routing_fee"`
+	PublicationDeadline     time.Time             `json:"publication_deadline"`
+	SingleSweep             bool                  `json:"single_sweep"`
+	PaymentTimeout          int32                 `json:"payment_timeout"`
+	SwapRouteHops           string                `json:"swap_route_hops"`
+	SwapRouteIncludeNodes   string                `json:"swap_route_include_nodes"`
+	SwapRouteExcludeNodes   string                `json:"swap_route_exclude_nodes"`
+	PrepayRouteHops         string                `json:"prepay_route_hops"`
+	PrepayRouteIncludeNodes string                `json:"prepay_route_include_nodes"`
+	PrepayRouteExcludeNodes string                `json:"prepay_route_exclude_nodes"`
+	Updates                 []*ArchivedSwapUpdate `json:"updates"`
+}
+
+// ArchivedPresignedTimeoutTx is a presigned timeout transaction of a loop in
+// htlc.
+type ArchivedPresignedTimeoutTx struct {
+	SatPerVbyte int64    `json:"sat_per_vbyte"`
+	Fee         int64    `json:"fee"`
+	RawTx       HexBytes `json:"raw_tx"`
+}
+
+// ArchivedLoopIn is a loop in swap with its updates and its presigned timeout
+// transactions.
+type ArchivedLoopIn struct {
+	Swap                ArchivedSwap                  `json:"swap"`
+	HtlcConfTarget      int32                         `json:"htlc_conf_target"`
+	LastHop             HexBytes                      `json:"last_hop,omitempty"`
+	ExternalHtlc        bool                          `json:"external_htlc"`
+	Updates             []*ArchivedSwapUpdate         `json:"updates"`
+	PresignedTimeoutTxs []*ArchivedPresignedTimeoutTx `json:"presigned_timeout_txs,omitempty"`
+}
+
+// ArchivedStateUpdate is an update of a reservation or instant out.
+type ArchivedStateUpdate struct {
+	Timestamp time.Time `json:"timestamp"`
+	State     string    `json:"state"`
+}
+
+// ArchivedReservation is a reservation with its updates.
+type ArchivedReservation struct {
+	ReservationID      HexBytes               `json:"reservation_id"`
+	ClientPubkey       HexBytes               `json:"client_pubkey"`
+	ServerPubkey       HexBytes               `json:"server_pubkey"`
+	Expiry             int32                  `json:"expiry"`
+	Value              int64                  `json:"value"`
+	ClientKeyFamily    int32                  `json:"client_key_family"`
+	ClientKeyIndex     int32                  `json:"client_key_index"`
+	InitiationHeight   int32                  `json:"initiation_height"`
+	TxHash             HexBytes               `json:"tx_hash,omitempty"`
+	OutIndex           *int32                 `json:"out_index,omitempty"`
+	ConfirmationHeight *int32                 `json:"confirmation_height,omitempty"`
+	SpendTxid          HexBytes               `json:"spend_txid,omitempty"`
+	SpendHeight        *int32                 `json:"spend_height,omitempty"`
+	Updates            []*ArchivedStateUpdate `json:"updates"`
+}
+
+// ArchivedChangeReservation is the change reservation of an instant out.
+type ArchivedChangeReservation struct {
+	ReservationID   HexBytes `json:"reservation_id"`
+	ClientPubkey    HexBytes `json:"client_pubkey"`
+	ServerPubkey    HexBytes `json:"server_pubkey"`
+	Expiry          int32    `json:"expiry"`
+	Value           int64    `json:"value"`
+	ClientKeyFamily int32    `json:"client_key_family"`
+	ClientKeyIndex  int32    `json:"client_key_index"`
+}
+
+// ArchivedSweepOutput is a sweep output of an instant out.
+type ArchivedSweepOutput struct {
+	OutputIndex int32  `json:"output_index"`
+	Address     string `json:"address"`
+	Amount      int64  `json:"amount"`
+}
+
+// ArchivedInstantOut is an instant out swap with its updates.
+type ArchivedInstantOut struct {
+	Swap                      ArchivedSwap               `json:"swap"`
+	SweepAddress              string                     `json:"sweep_address"`
+	OutgoingChanSet           string                     `json:"outgoing_chan_set"`
+	HtlcFeeRate               int64                      `json:"htlc_fee_rate"`
+	ReservationIDs            HexBytes                   `json:"reservation_ids"`
+	SwapInvoice               string                     `json:"swap_invoice"`
+	FinalizedHtlcTx           HexBytes                   `json:"finalized_htlc_tx,omitempty"`
+	SweepTxHash               HexBytes                   `json:"sweep_tx_hash,omitempty"`
+	FinalizedSweeplessSweepTx HexBytes                   `json:"finalized_sweepless_sweep_tx,omitempty"`
+	SweepConfirmationHeight   *int32                     `json:"sweep_confirmation_height,omitempty"`
+	ChangeReservation         *ArchivedChangeReservation `json:"change_reservation,omitempty"`
+	SweepOutputs              []*ArchivedSweepOutput     `json:"sweep_outputs,omitempty"`
+	Updates                   []*ArchivedStateUpdate     `json:"updates"`
+}
+
+// ArchivedSweep is a sweep of a sweep batch.
+type ArchivedSweep struct {
+	SwapHash      HexBytes `json:"swap_hash"`
+	OutpointTxid  HexBytes `json:"outpoint_txid"`
+	OutpointIndex int32    `json:"outpoint_index"`
+	Amt           int64    `json:"amt"`
+	Completed     bool     `json:"completed"`
+}
+
+// ArchivedSweepBatch is a sweep batch with its sweeps. The id of a batch is
+// only informational, imported batches get new ids.
+type ArchivedSweepBatch struct {
+	ID                 int32            `json:"id"`
+	Confirmed          bool             `json:"confirmed"`
+	BatchTxID          *string          `json:"batch_tx_id,omitempty"`
+	BatchPkScript      HexBytes         `json:"batch_pk_script,omitempty"`
+	LastRbfHeight      *int32           `json:"last_rbf_height,omitempty"`
+	LastRbfSatPerKw    *int32           `json:"last_rbf_sat_per_kw,omitempty"`
+	MaxTimeoutDistance int32            `json:"max_timeout_distance"`
+	Sweeps             []*ArchivedSweep `json:"sweeps"`
+}
+
+// isEmpty returns true if the archive contains neither swaps, nor
+// reservations, nor sweep batches.
+func (a *Archive) isEmpty() bool {
+	return len(a.LoopOuts) == 0 && len(a.LoopIns) == 0 &&
+		len(a.Reservations) == 0 && len(a.InstantOuts) == 0 &&
+		len(a.SweepBatches) == 0
+}
+
+// EncodeArchive serializes the archive to JSON.
+func EncodeArchive(archive *Archive) ([]byte, error) {
+	return json.MarshalIndent(archive, "", "    ")
+}
+
+// DecodeArchive deserializes an archive from JSON and checks its version.
+func DecodeArchive(data []byte) (*Archive, error) {
+	archive := &Archive{}
+	if err := json.Unmarshal(data, archive); err != nil {
+		return nil, fmt.Errorf("unable to decode archive: %w", err)
+	}
+
+	if archive.Version != ArchiveVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownArchiveVersion,
+			archive.Version)
+	}
+
+	return archive, nil
+}
+
+// ExportArchive creates an archive of the whole database.
+func (db *BaseDB) ExportArchive(ctx context.Context) (*Archive, error) {
+	var archive *Archive
+
+	err := db.ExecTx(ctx, NewSqlReadOpts(), func(tx *sqlc.Queries) error {
+		var err error
+		archive, err = exportArchive(ctx, tx)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// ImportArchive writes the content of the archive into the database. The
+// database must not contain any swaps, reservations or sweep batches yet. The
+// liquidity parameters of the archive replace the stored ones.
+func (db *BaseDB) ImportArchive(ctx context.Context, archive *Archive) error {
+	if archive.Version != ArchiveVersion {
+		return fmt.Errorf("%w: %d", ErrUnknownArchiveVersion,
+			archive.Version)
+	}
+
+	return db.ExecTx(ctx, NewSqlWriteOpts(), func(tx *sqlc.Queries) error {
+		current, err := exportArchive(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if !current.isEmpty() {
+			return ErrDatabaseNotEmpty
+		}
+
+		return importArchive(ctx, tx, archive)
+	})
+}
+
+// ExportPendingSwaps creates an archive that only contains the loop outs and
+// loop ins that are still pending.
+func (db *BaseDB) ExportPendingSwaps(ctx context.Context) (*Archive, error) {
+	archive := &Archive{
+		Version:   ArchiveVersion,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := db.ExecTx(ctx, NewSqlReadOpts(), func(tx *sqlc.Queries) error {
+		loopOuts, err := exportLoopOuts(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		loopIns, err := exportLoopIns(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		archive.LoopOuts, archive.LoopIns = nil, nil
+		for _, loopOut := range loopOuts {
+			if isPendingSwap(loopOut.Updates) {
+				archive.LoopOuts = append(
+					archive.LoopOuts, loopOut,
+				)
+			}
+		}
+		for _, loopIn := range loopIns {
+			if isPendingSwap(loopIn.Updates) {
+				archive.LoopIns = append(archive.LoopIns, loopIn)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// ImportSwaps adds the loop outs and loop ins of the archive that are not
+// stored in the database yet. The other content of the archive is ignored.
+// The hashes of the added swaps are returned.
+func (db *BaseDB) ImportSwaps(ctx context.Context, archive *Archive) (
+	[]lntypes.Hash, []lntypes.Hash, error) {
+
+	if archive.Version != ArchiveVersion {
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnknownArchiveVersion,
+			archive.Version)
+	}
+
+	var loopOuts, loopIns []lntypes.Hash
+	err := db.ExecTx(ctx, NewSqlWriteOpts(), func(tx *sqlc.Queries) error {
+		loopOuts, loopIns = nil, nil
+
+		for _, loopOut := range archive.LoopOuts {
+			_, err := tx.GetLoopOutSwap(ctx, loopOut.Swap.Hash)
+			switch {
+			case err == nil:
+				continue
+
+			case !errors.Is(err, sql.ErrNoRows):
+				return err
+			}
+
+			hash, err := lntypes.MakeHash(loopOut.Swap.Hash)
+			if err != nil {
+				return err
+			}
+
+			err = importLoopOut(ctx, tx, loopOut)
+			if err != nil {
+				return err
+			}
+			loopOuts = append(loopOuts, hash)
+		}
+
+		for _, loopIn := range archive.LoopIns {
+			_, err := tx.GetLoopInSwap(ctx, loopIn.Swap.Hash)
+			switch {
+			case err == nil:
+				continue
+
+			case !errors.Is(err, sql.ErrNoRows):
+				return err
+			}
+
+			hash, err := lntypes.MakeHash(loopIn.Swap.Hash)
+			if err != nil {
+				return err
+			}
+
+			err = importLoopIn(ctx, tx, loopIn)
+			if err != nil {
+				return err
+			}
+			loopIns = append(loopIns, hash)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return loopOuts, loopIns, nil
+}
+
+// isPendingSwap returns true if the last of the given updates of a swap is a
+// pending state. Swaps without updates are pending.
+func isPendingSwap(updates []*ArchivedSwapUpdate) bool {
+	if len(updates) == 0 {
+		return true
+	}
+
+	return updates[len(updates)-1].State.IsPending()
+}
+
+// exportArchive reads the whole database into an archive.
+func exportArchive(ctx context.Context, tx *sqlc.Queries) (*Archive, error) {
+	archive := &Archive{
+		Version:   ArchiveVersion,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	loopOuts, err := exportLoopOuts(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	archive.LoopOuts = loopOuts
+
+	loopIns, err := exportLoopIns(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	archive.LoopIns = loopIns
+
+	params, err := tx.FetchLiquidityParams(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+
+	case err != nil:
+		return nil, err
+
+	default:
+		archive.LiquidityParams = params
+	}
+
+	reservations, err := tx.GetReservations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range reservations {
+		rows, err := tx.GetReservationUpdates(ctx, row.ReservationID)
+		if err != nil {
+			return nil, err
+		}
+
+		updates := make([]*ArchivedStateUpdate, 0, len(rows))
+		for _, update := range rows {
+			updates = append(updates, &ArchivedStateUpdate{
+				Timestamp: update.UpdateTimestamp,
+				State:     update.UpdateState,
+			})
+		}
+
+		reservation := &ArchivedReservation{
+			ReservationID:    row.ReservationID,
+			ClientPubkey:     row.ClientPubkey,
+			ServerPubkey:     row.ServerPubkey,
+			Expiry:           row.Expiry,
+			Value:            row.Value,
+			ClientKeyFamily:  row.ClientKeyFamily,
+			ClientKeyIndex:   row.ClientKeyIndex,
+			InitiationHeight: row.InitiationHeight,
+			TxHash:           row.TxHash,
+			OutIndex:         fromNullInt32(row.OutIndex),
+			SpendTxid:        row.SpendTxid,
+			SpendHeight:      fromNullInt32(row.SpendHeight),
+			Updates:          updates,
+			ConfirmationHeight: fromNullInt32(
+				row.ConfirmationHeight,
+			),
+		}
+
+		archive.Reservations = append(archive.Reservations, reservation)
+	}
+
+	instantOuts, err := tx.GetInstantOutSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range instantOuts {
+		instantOut, err := exportInstantOut(ctx, tx, row)
+		if err != nil {
+			return nil, err
+		}
+
+		archive.InstantOuts = append(archive.InstantOuts, instantOut)
+	}
+
+	batches, err := tx.GetSweepBatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range batches {
+		sweeps, err := tx.GetBatchSweeps(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		batch := &ArchivedSweepBatch{
+			ID:                 row.ID,
+			Confirmed:          row.Confirmed,
+			BatchPkScript:      row.BatchPkScript,
+			LastRbfHeight:      fromNullInt32(row.LastRbfHeight),
+			LastRbfSatPerKw:    fromNullInt32(row.LastRbfSatPerKw),
+			MaxTimeoutDistance: row.MaxTimeoutDistance,
+		}
+		if row.BatchTxID.Valid {
+			batchTxID := row.BatchTxID.String
+			batch.BatchTxID = &batchTxID
+		}
+
+		for _, sweep := range sweeps {
+			batch.Sweeps = append(batch.Sweeps, &ArchivedSweep{
+				SwapHash:      sweep.SwapHash,
+				OutpointTxid:  sweep.OutpointTxid,
+				OutpointIndex: sweep.OutpointIndex,
+				Amt:           sweep.Amt,
+				Completed:     sweep.Completed,
+			})
+		}
+
+		archive.SweepBatches = append(archive.SweepBatches, batch)
+	}
+
+	return archive, nil
+}
+
+// exportSwapUpdates reads the updates of a loop out or loop in swap.
+func exportSwapUpdates(ctx context.Context, tx *sqlc.Queries,
+	swapHash []byte) ([]*ArchivedSwapUpdate, error) {
+
+	rows, err := tx.GetSwapUpdates(ctx, swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return archiveSwapUpdates(rows), nil
+}
+
+// archiveSwapUpdates converts the stored updates of a loop out or loop in
+// swap.
+func archiveSwapUpdates(rows []sqlc.SwapUpdate) []*ArchivedSwapUpdate {
+	updates := make([]*ArchivedSwapUpdate, 0, len(rows))
+	for _, row := range rows {
+		updates = append(updates, &ArchivedSwapUpdate{
+			Timestamp:    row.UpdateTimestamp,
+			State:        SwapState(row.UpdateState),
+			HtlcTxHash:   row.HtlcTxhash,
+			ServerCost:   row.ServerCost,
+			OnchainCost:  row.OnchainCost,
+			OffchainCost: row.OffchainCost,
+		})
+	}
+
+	return updates
+}
+
+// exportLoopOuts reads all loop out swaps with their updates.
+func exportLoopOuts(ctx context.Context,
+	tx *sqlc.Queries) ([]*ArchivedLoopOut, error) {
+
+	rows, err := tx.GetLoopOutSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var archived []*ArchivedLoopOut
+	for _, row := range rows {
+		updates, err := exportSwapUpdates(ctx, tx, row.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		archived = append(archived, exportLoopOut(row, updates))
+	}
+
+	return archived, nil
+}
+
+// exportLoopOut converts a stored loop out swap with the given updates.
+func exportLoopOut(row sqlc.GetLoopOutSwapsRow,
+	updates []*ArchivedSwapUpdate) *ArchivedLoopOut {
+
+	keys := ArchivedHtlcKeys{
+		SenderScriptPubkey:     row.SenderScriptPubkey,
+		ReceiverScriptPubkey:   row.ReceiverScriptPubkey,
+		SenderInternalPubkey:   row.SenderInternalPubkey,
+		ReceiverInternalPubkey: row.ReceiverInternalPubkey,
+		ClientKeyFamily:        row.ClientKeyFamily,
+		ClientKeyIndex:         row.ClientKeyIndex,
+	}
+
+	swap := ArchivedSwap{
+		Hash:             row.SwapHash,
+		Preimage:         row.Preimage,
+		InitiationTime:   row.InitiationTime,
+		AmountRequested:  row.AmountRequested,
+		CltvExpiry:       row.CltvExpiry,
+		MaxMinerFee:      row.MaxMinerFee,
+		MaxSwapFee:       row.MaxSwapFee,
+		InitiationHeight: row.InitiationHeight,
+		ProtocolVersion:  row.ProtocolVersion,
+		Label:            row.Label,
+		HtlcKeys:         keys,
+	}
+
+	return &ArchivedLoopOut{
+		Swap:                    swap,
+		DestAddress:             row.DestAddress,
+		SwapInvoice:             row.SwapInvoice,
+		MaxSwapRoutingFee:       row.MaxSwapRoutingFee,
+		SweepConfTarget:         row.SweepConfTarget,
+		HtlcConfirmations:       row.HtlcConfirmations,
+		OutgoingChanSet:         row.OutgoingChanSet,
+		PrepayInvoice:           row.PrepayInvoice,
+		MaxPrepayRoutingFee:     row.MaxPrepayRoutingFee,
+		PublicationDeadline:     row.PublicationDeadline,
+		SingleSweep:             row.SingleSweep,
+		PaymentTimeout:          row.PaymentTimeout,
+		SwapRouteHops:           row.SwapRouteHops,
+		SwapRouteIncludeNodes:   row.SwapRouteIncludeNodes,
+		SwapRouteExcludeNodes:   row.SwapRouteExcludeNodes,
+		PrepayRouteHops:         row.PrepayRouteHops,
+		PrepayRouteIncludeNodes: row.PrepayRouteIncludeNodes,
+		PrepayRouteExcludeNodes: row.PrepayRouteExcludeNodes,
+		Updates:                 updates,
+	}
+}
+
+// exportLoopIns reads all loop in swaps with their updates and their presigned
+// timeout transactions.
+func exportLoopIns(ctx context.Context,
+	tx *sqlc.Queries) ([]*ArchivedLoopIn, error) {
+
+	rows, err := tx.GetLoopInSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var archived []*ArchivedLoopIn
+	for _, row := range rows {
+		updates, err := exportSwapUpdates(ctx, tx, row.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		loopIn, err := exportLoopIn(ctx, tx, row, updates)
+		if err != nil {
+			return nil, err
+		}
+
+		archived = append(archived, loopIn)
+	}
+
+	return archived, nil
+}
+
+// exportLoopIn converts a stored loop in swap with the given updates and reads
+// its presigned timeout transactions.
+func exportLoopIn(ctx context.Context, tx *sqlc.Queries,
+	row sqlc.GetLoopInSwapsRow,
+	updates []*ArchivedSwapUpdate) (*ArchivedLoopIn, error) {
+
+	timeoutTxs, err := tx.GetPresignedTimeoutTxs(ctx, row.SwapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var presignedTimeoutTxs []*ArchivedPresignedTimeoutTx
+	for _, timeoutTx := range timeoutTxs {
+		presignedTimeoutTxs = append(
+			presignedTimeoutTxs, &ArchivedPresignedTimeoutTx{
+				SatPerVbyte: timeoutTx.SatPerVbyte,
+				Fee:         timeoutTx.Fee,
+				RawTx:       timeoutTx.RawTx,
+			},
+		)
+	}
+
+	keys := ArchivedHtlcKeys{
+		SenderScriptPubkey:     row.SenderScriptPubkey,
+		ReceiverScriptPubkey:   row.ReceiverScriptPubkey,
+		SenderInternalPubkey:   row.SenderInternalPubkey,
+		ReceiverInternalPubkey: row.ReceiverInternalPubkey,
+		ClientKeyFamily:        row.ClientKeyFamily,
+		ClientKeyIndex:         row.ClientKeyIndex,
+	}
+
+	swap := ArchivedSwap{
+		Hash:             row.SwapHash,
+		Preimage:         row.Preimage,
+		InitiationTime:   row.InitiationTime,
+		AmountRequested:  row.AmountRequested,
+		CltvExpiry:       row.CltvExpiry,
+		MaxMinerFee:      row.MaxMinerFee,
+		MaxSwapFee:       row.MaxSwapFee,
+		InitiationHeight: row.InitiationHeight,
+		ProtocolVersion:  row.ProtocolVersion,
+		Label:            row.Label,
+		HtlcKeys:         keys,
+	}
+
+	return &ArchivedLoopIn{
+		Swap:                swap,
+		HtlcConfTarget:      row.HtlcConfTarget,
+		LastHop:             row.LastHop,
+		ExternalHtlc:        row.ExternalHtlc,
+		Updates:             updates,
+		PresignedTimeoutTxs: presignedTimeoutTxs,
+	}, nil
+}
+
+// exportInstantOut reads an instant out with its updates, sweep outputs and
+// change reservation.
+func exportInstantOut(ctx context.Context, tx *sqlc.Queries,
+	row sqlc.GetInstantOutSwapsRow) (*ArchivedInstantOut, error) {
+
+	keys := ArchivedHtlcKeys{
+		SenderScriptPubkey:     row.SenderScriptPubkey,
+		ReceiverScriptPubkey:   row.ReceiverScriptPubkey,
+		SenderInternalPubkey:   row.SenderInternalPubkey,
+		ReceiverInternalPubkey: row.ReceiverInternalPubkey,
+		ClientKeyFamily:        row.ClientKeyFamily,
+		ClientKeyIndex:         row.ClientKeyIndex,
+	}
+
+	instantOut := &ArchivedInstantOut{
+		Swap: ArchivedSwap{
+			Hash:             row.SwapHash,
+			Preimage:         row.Preimage,
+			InitiationTime:   row.InitiationTime,
+			AmountRequested:  row.AmountRequested,
+			CltvExpiry:       row.CltvExpiry,
+			MaxMinerFee:      row.MaxMinerFee,
+			MaxSwapFee:       row.MaxSwapFee,
+			InitiationHeight: row.InitiationHeight,
+			ProtocolVersion:  row.ProtocolVersion,
+			Label:            row.Label,
+			HtlcKeys:         keys,
+		},
+		SweepAddress:              row.SweepAddress,
+		OutgoingChanSet:           row.OutgoingChanSet,
+		HtlcFeeRate:               row.HtlcFeeRate,
+		ReservationIDs:            row.ReservationIds,
+		SwapInvoice:               row.SwapInvoice,
+		FinalizedHtlcTx:           row.FinalizedHtlcTx,
+		SweepTxHash:               row.SweepTxHash,
+		FinalizedSweeplessSweepTx: row.FinalizedSweeplessSweepTx,
+		SweepConfirmationHeight: fromNullInt32(
+			row.SweepConfirmationHeight,
+		),
+	}
+
+	updates, err := tx.GetInstantOutSwapUpdates(ctx, row.SwapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	instantOut.Updates = make([]*ArchivedStateUpdate, 0, len(updates))
+	for _, update := range updates {
+		instantOut.Updates = append(
+			instantOut.Updates, &ArchivedStateUpdate{
+				Timestamp: update.UpdateTimestamp,
+				State:     update.UpdateState,
+			},
+		)
+	}
+
+	outputs, err := tx.GetInstantOutSweepOutputs(ctx, row.SwapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, output := range outputs {
+		instantOut.SweepOutputs = append(
+			instantOut.SweepOutputs, &ArchivedSweepOutput{
+				OutputIndex: output.OutputIndex,
+				Address:     output.Address,
+				Amount:      output.Amount,
+			},
+		)
+	}
+
+	change, err := tx.GetInstantOutChangeReservation(ctx, row.SwapHash)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+
+	case err != nil:
+		return nil, err
+
+	default:
+		instantOut.ChangeReservation = &ArchivedChangeReservation{
+			ReservationID:   change.ReservationID,
+			ClientPubkey:    change.ClientPubkey,
+			ServerPubkey:    change.ServerPubkey,
+			Expiry:          change.Expiry,
+			Value:           change.Value,
+			ClientKeyFamily: change.ClientKeyFamily,
+			ClientKeyIndex:  change.ClientKeyIndex,
+		}
+	}
+
+	return instantOut, nil
+}
+
+// importArchive writes the content of the archive into the database.
+func importArchive(ctx context.Context, tx *sqlc.Queries,
+	archive *Archive) error {
+
+	for _, loopOut := range archive.LoopOuts {
+		err := importLoopOut(ctx, tx, loopOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, loopIn := range archive.LoopIns {
+		err := importLoopIn(ctx, tx, loopIn)
+		if err != nil {
+			return err
+		}
+	}
+
+	if archive.LiquidityParams != nil {
+		err := tx.UpsertLiquidityParams(ctx, archive.LiquidityParams)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, reservation := range archive.Reservations {
+		err := importReservation(ctx, tx, reservation)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, instantOut := range archive.InstantOuts {
+		err := importInstantOut(ctx, tx, instantOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, batch := range archive.SweepBatches {
+		err := importSweepBatch(ctx, tx, batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importLoopOut inserts a loop out swap with its updates.
+func importLoopOut(ctx context.Context, tx *sqlc.Queries,
+	loopOut *ArchivedLoopOut) error {
+
+	err := importSwap(ctx, tx, &loopOut.Swap)
+	if err != nil {
+		return err
+	}
+
+	err = tx.InsertLoopOut(ctx, sqlc.InsertLoopOutParams{
+		SwapHash:                loopOut.Swap.Hash,
+		DestAddress:             loopOut.DestAddress,
+		SwapInvoice:             loopOut.SwapInvoice,
+		MaxSwapRoutingFee:       loopOut.MaxSwapRoutingFee,
+		SweepConfTarget:         loopOut.SweepConfTarget,
+		HtlcConfirmations:       loopOut.HtlcConfirmations,
+		OutgoingChanSet:         loopOut.OutgoingChanSet,
+		PrepayInvoice:           loopOut.PrepayInvoice,
+		MaxPrepayRoutingFee:     loopOut.MaxPrepayRoutingFee,
+		PublicationDeadline:     loopOut.PublicationDeadline,
+		SingleSweep:             loopOut.SingleSweep,
+		PaymentTimeout:          loopOut.PaymentTimeout,
+		SwapRouteHops:           loopOut.SwapRouteHops,
+		SwapRouteIncludeNodes:   loopOut.SwapRouteIncludeNodes,
+		SwapRouteExcludeNodes:   loopOut.SwapRouteExcludeNodes,
+		PrepayRouteHops:         loopOut.PrepayRouteHops,
+		PrepayRouteIncludeNodes: loopOut.PrepayRouteIncludeNodes,
+		PrepayRouteExcludeNodes: loopOut.PrepayRouteExcludeNodes,
+	})
+	if err != nil {
+		return err
+	}
+
+	return importSwapUpdates(ctx, tx, loopOut.Swap.Hash, loopOut.Updates)
+}
+
+// importLoopIn inserts a loop in swap with its updates and its presigned
+// timeout transactions.
+func importLoopIn(ctx context.Context, tx *sqlc.Queries,
+	loopIn *ArchivedLoopIn) error {
+
+	err := importSwap(ctx, tx, &loopIn.Swap)
+	if err != nil {
+		return err
+	}
+
+	err = tx.InsertLoopIn(ctx, sqlc.InsertLoopInParams{
+		SwapHash:       loopIn.Swap.Hash,
+		HtlcConfTarget: loopIn.HtlcConfTarget,
+		LastHop:        loopIn.LastHop,
+		ExternalHtlc:   loopIn.ExternalHtlc,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, timeoutTx := range loopIn.PresignedTimeoutTxs {
+		err := tx.InsertPresignedTimeoutTx(
+			ctx, sqlc.InsertPresignedTimeoutTxParams{
+				SwapHash:    loopIn.Swap.Hash,
+				SatPerVbyte: timeoutTx.SatPerVbyte,
+				Fee:         timeoutTx.Fee,
+				RawTx:       timeoutTx.RawTx,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return importSwapUpdates(ctx, tx, loopIn.Swap.Hash, loopIn.Updates)
+}
+
+// importSwap inserts the shared data of a swap and its htlc keys.
+func importSwap(ctx context.Context, tx *sqlc.Queries,
+	swap *ArchivedSwap) error {
+
+	err := tx.InsertSwap(ctx, sqlc.InsertSwapParams{
+		SwapHash:         swap.Hash,
+		Preimage:         swap.Preimage,
+		InitiationTime:   swap.InitiationTime,
+		AmountRequested:  swap.AmountRequested,
+		CltvExpiry:       swap.CltvExpiry,
+		MaxMinerFee:      swap.MaxMinerFee,
+		MaxSwapFee:       swap.MaxSwapFee,
+		InitiationHeight: swap.InitiationHeight,
+		ProtocolVersion:  swap.ProtocolVersion,
+		Label:            swap.Label,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert swap %x: %w", swap.Hash,
+			err)
+	}
+
+	return tx.InsertHtlcKeys(ctx, sqlc.InsertHtlcKeysParams{
+		SwapHash:               swap.Hash,
+		SenderScriptPubkey:     swap.HtlcKeys.SenderScriptPubkey,
+		ReceiverScriptPubkey:   swap.HtlcKeys.ReceiverScriptPubkey,
+		SenderInternalPubkey:   swap.HtlcKeys.SenderInternalPubkey,
+		ReceiverInternalPubkey: swap.HtlcKeys.ReceiverInternalPubkey,
+		ClientKeyFamily:        swap.HtlcKeys.ClientKeyFamily,
+		ClientKeyIndex:         swap.HtlcKeys.ClientKeyIndex,
+	})
+}
+
+// importSwapUpdates inserts the updates of a loop out or loop in swap.
+func importSwapUpdates(ctx context.Context, tx *sqlc.Queries,
+	swapHash []byte, updates []*ArchivedSwapUpdate) error {
+
+	for _, update := range updates {
+		err := tx.InsertSwapUpdate(ctx, sqlc.InsertSwapUpdateParams{
+			SwapHash:        swapHash,
+			UpdateTimestamp: update.Timestamp,
+			UpdateState:     int32(update.State),
+			HtlcTxhash:      update.HtlcTxHash,
+			ServerCost:      update.ServerCost,
+			OnchainCost:     update.OnchainCost,
+			OffchainCost:    update.OffchainCost,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importReservation inserts a reservation with its updates.
+func importReservation(ctx context.Context, tx *sqlc.Queries,
+	reservation *ArchivedReservation) error {
+
+	err := tx.CreateReservation(ctx, sqlc.CreateReservationParams{
+		ReservationID:    reservation.ReservationID,
+		ClientPubkey:     reservation.ClientPubkey,
+		ServerPubkey:     reservation.ServerPubkey,
+		Expiry:           reservation.Expiry,
+		Value:            reservation.Value,
+		ClientKeyFamily:  reservation.ClientKeyFamily,
+		ClientKeyIndex:   reservation.ClientKeyIndex,
+		InitiationHeight: reservation.InitiationHeight,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert reservation %x: %w",
+			reservation.ReservationID, err)
+	}
+
+	err = tx.UpdateReservation(ctx, sqlc.UpdateReservationParams{
+		ReservationID:      reservation.ReservationID,
+		TxHash:             reservation.TxHash,
+		OutIndex:           toNullInt32(reservation.OutIndex),
+		ConfirmationHeight: toNullInt32(reservation.ConfirmationHeight),
+		SpendTxid:          reservation.SpendTxid,
+		SpendHeight:        toNullInt32(reservation.SpendHeight),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, update := range reservation.Updates {
+		err := tx.InsertReservationUpdate(
+			ctx, sqlc.InsertReservationUpdateParams{
+				ReservationID:   reservation.ReservationID,
+				UpdateState:     update.State,
+				UpdateTimestamp: update.Timestamp,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importInstantOut inserts an instant out with its updates, sweep outputs and
+// change reservation.
+func importInstantOut(ctx context.Context, tx *sqlc.Queries,
+	instantOut *ArchivedInstantOut) error {
+
+	swapHash := instantOut.Swap.Hash
+
+	err := importSwap(ctx, tx, &instantOut.Swap)
+	if err != nil {
+		return err
+	}
+
+	err = tx.InsertInstantOut(ctx, sqlc.InsertInstantOutParams{
+		SwapHash:        swapHash,
+		Preimage:        instantOut.Swap.Preimage,
+		SweepAddress:    instantOut.SweepAddress,
+		OutgoingChanSet: instantOut.OutgoingChanSet,
+		HtlcFeeRate:     instantOut.HtlcFeeRate,
+		ReservationIds:  instantOut.ReservationIDs,
+		SwapInvoice:     instantOut.SwapInvoice,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.UpdateInstantOut(ctx, sqlc.UpdateInstantOutParams{
+		SwapHash:                  swapHash,
+		FinalizedHtlcTx:           instantOut.FinalizedHtlcTx,
+		SweepTxHash:               instantOut.SweepTxHash,
+		FinalizedSweeplessSweepTx: instantOut.FinalizedSweeplessSweepTx,
+		SweepConfirmationHeight: toNullInt32(
+			instantOut.SweepConfirmationHeight,
+		),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, update := range instantOut.Updates {
+		err := tx.InsertInstantOutUpdate(
+			ctx, sqlc.InsertInstantOutUpdateParams{
+				SwapHash:        swapHash,
+				UpdateState:     update.State,
+				UpdateTimestamp: update.Timestamp,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, output := range instantOut.SweepOutputs {
+		err := tx.InsertInstantOutSweepOutput(
+			ctx, sqlc.InsertInstantOutSweepOutputParams{
+				SwapHash:    swapHash,
+				OutputIndex: output.OutputIndex,
+				Address:     output.Address,
+				Amount:      output.Amount,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	change := instantOut.ChangeReservation
+	if change == nil {
+		return nil
+	}
+
+	return tx.InsertInstantOutChangeReservation(
+		ctx, sqlc.InsertInstantOutChangeReservationParams{
+			SwapHash:        swapHash,
+			ReservationID:   change.ReservationID,
+			ClientPubkey:    change.ClientPubkey,
+			ServerPubkey:    change.ServerPubkey,
+			Expiry:          change.Expiry,
+			Value:           change.Value,
+			ClientKeyFamily: change.ClientKeyFamily,
+			ClientKeyIndex:  change.ClientKeyIndex,
+		},
+	)
+}
+
+// importSweepBatch inserts a sweep batch with its sweeps under a new batch id.
+func importSweepBatch(ctx context.Context, tx *sqlc.Queries,
+	batch *ArchivedSweepBatch) error {
+
+	var batchTxID sql.NullString
+	if batch.BatchTxID != nil {
+		batchTxID = sql.NullString{
+			String: *batch.BatchTxID,
+			Valid:  true,
+		}
+	}
+
+	id, err := tx.InsertBatch(ctx, sqlc.InsertBatchParams{
+		Confirmed:          batch.Confirmed,
+		BatchTxID:          batchTxID,
+		BatchPkScript:      batch.BatchPkScript,
+		LastRbfHeight:      toNullInt32(batch.LastRbfHeight),
+		LastRbfSatPerKw:    toNullInt32(batch.LastRbfSatPerKw),
+		MaxTimeoutDistance: batch.MaxTimeoutDistance,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, sweep := range batch.Sweeps {
+		err := tx.UpsertSweep(ctx, sqlc.UpsertSweepParams{
+			SwapHash:      sweep.SwapHash,
+			BatchID:       id,
+			OutpointTxid:  sweep.OutpointTxid,
+			OutpointIndex: sweep.OutpointIndex,
+			Amt:           sweep.Amt,
+			Completed:     sweep.Completed,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fromNullInt32 converts a nullable integer to a pointer.
+func fromNullInt32(value sql.NullInt32) *int32 {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Int32
+}
+
+// toNullInt32 converts an optional integer to a nullable integer.
+func toNullInt32(value *int32) sql.NullInt32 {
+	if value == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{
+		Int32: *value,
+		Valid: true,
+	}
+}
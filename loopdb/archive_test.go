@@ -0,0 +1,285 @@
+package loopdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestArchive tests that a database can be exported to an archive and
+// imported into an empty database again.
+func TestArchive(t *testing.T) {
+	ctxb := context.Background()
+	store := NewTestDB(t)
+
+	initiationTime := time.Date(2018, 11, 1, 0, 0, 0, 0, time.UTC)
+	swapContract := SwapContract{
+		AmountRequested: 100,
+		Preimage:        testPreimage,
+		CltvExpiry:      144,
+		HtlcKeys: HtlcKeys{
+			SenderScriptKey:        senderKey,
+			ReceiverScriptKey:      receiverKey,
+			SenderInternalPubKey:   senderInternalKey,
+			ReceiverInternalPubKey: receiverInternalKey,
+			ClientScriptKeyLocator: keychain.KeyLocator{
+				Family: 1,
+				Index:  2,
+			},
+		},
+		MaxMinerFee:      10,
+		MaxSwapFee:       20,
+		InitiationHeight: 99,
+		InitiationTime:   initiationTime,
+		ProtocolVersion:  ProtocolVersionMuSig2,
+		Label:            testLabel,
+	}
+
+	loopOut := &LoopOutContract{
+		SwapContract:            swapContract,
+		MaxPrepayRoutingFee:     40,
+		PrepayInvoice:           "prepayinvoice",
+		DestAddr:                test.GetDestAddr(t, 0),
+		SwapInvoice:             "swapinvoice",
+		MaxSwapRoutingFee:       30,
+		SweepConfTarget:         2,
+		HtlcConfirmations:       2,
+		SwapPublicationDeadline: initiationTime,
+		PaymentTimeout:          time.Second * 11,
+		OutgoingChanSet:         ChannelSet{1, 2},
+	}
+	loopOutHash := lntypes.Hash{1}
+	require.NoError(t, store.CreateLoopOut(ctxb, loopOutHash, loopOut))
+	require.NoError(t, store.UpdateLoopOut(
+		ctxb, loopOutHash, initiationTime, SwapStateData{
+			State: StateSuccess,
+			Cost: SwapCost{
+				Server:   1,
+				Onchain:  2,
+				Offchain: 3,
+			},
+		},
+	))
+
+	lastHop := route.Vertex{1, 2, 3}
+	loopIn := &LoopInContract{
+		SwapContract:   swapContract,
+		HtlcConfTarget: 2,
+		LastHop:        &lastHop,
+	}
+	loopIn.Preimage = lntypes.Preimage{9}
+	loopInHash := lntypes.Hash{2}
+	require.NoError(t, store.CreateLoopIn(ctxb, loopInHash, loopIn))
+	require.NoError(t, store.UpdateLoopIn(
+		ctxb, loopInHash, initiationTime, SwapStateData{
+			State:      StateHtlcPublished,
+			HtlcTxHash: &chainhash.Hash{3},
+		},
+	))
+	require.NoError(t, store.Queries.InsertPresignedTimeoutTx(
+		ctxb, sqlc.InsertPresignedTimeoutTxParams{
+			SwapHash:    loopInHash[:],
+			SatPerVbyte: 10,
+			Fee:         1000,
+			RawTx:       []byte{12},
+		},
+	))
+
+	require.NoError(t, store.PutLiquidityParams(ctxb, []byte{1, 2, 3}))
+
+	reservationID := []byte{4, 5, 6}
+	require.NoError(t, store.Queries.CreateReservation(
+		ctxb, sqlc.CreateReservationParams{
+			ReservationID:    reservationID,
+			ClientPubkey:     senderKey[:],
+			ServerPubkey:     receiverKey[:],
+			Expiry:           500,
+			Value:            1000,
+			InitiationHeight: 100,
+		},
+	))
+	require.NoError(t, store.Queries.UpdateReservation(
+		ctxb, sqlc.UpdateReservationParams{
+			ReservationID: reservationID,
+			TxHash:        []byte{7},
+			OutIndex:      sql.NullInt32{Int32: 1, Valid: true},
+		},
+	))
+	require.NoError(t, store.Queries.InsertReservationUpdate(
+		ctxb, sqlc.InsertReservationUpdateParams{
+			ReservationID:   reservationID,
+			UpdateState:     "Confirmed",
+			UpdateTimestamp: initiationTime,
+		},
+	))
+
+	instantOut := &ArchivedInstantOut{
+		Swap: ArchivedSwap{
+			Hash:           []byte{10},
+			Preimage:       []byte{11},
+			InitiationTime: initiationTime,
+			HtlcKeys: ArchivedHtlcKeys{
+				SenderScriptPubkey:   senderKey[:],
+				ReceiverScriptPubkey: receiverKey[:],
+			},
+		},
+		SweepAddress:   "address",
+		ReservationIDs: reservationID,
+		SweepOutputs: []*ArchivedSweepOutput{{
+			Address: "address",
+			Amount:  500,
+		}},
+		Updates: []*ArchivedStateUpdate{{
+			Timestamp: initiationTime,
+			State:     "Init",
+		}},
+	}
+	require.NoError(t, importInstantOut(ctxb, store.Queries, instantOut))
+
+	batchID, err := store.Queries.InsertBatch(
+		ctxb, sqlc.InsertBatchParams{
+			BatchTxID: sql.NullString{
+				String: "txid",
+				Valid:  true,
+			},
+			MaxTimeoutDistance: 10,
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, store.Queries.UpsertSweep(
+		ctxb, sqlc.UpsertSweepParams{
+			SwapHash:     loopOutHash[:],
+			BatchID:      batchID,
+			OutpointTxid: []byte{8},
+			Amt:          100,
+		},
+	))
+
+	archive, err := store.ExportArchive(ctxb)
+	require.NoError(t, err)
+	require.Len(t, archive.LoopOuts, 1)
+	require.Len(t, archive.LoopIns, 1)
+	require.Equal(
+		t, []*ArchivedPresignedTimeoutTx{{
+			SatPerVbyte: 10,
+			Fee:         1000,
+			RawTx:       []byte{12},
+		}}, archive.LoopIns[0].PresignedTimeoutTxs,
+	)
+	require.Len(t, archive.Reservations, 1)
+	require.Equal(t, []*ArchivedInstantOut{instantOut}, archive.InstantOuts)
+	require.Len(t, archive.SweepBatches, 1)
+	require.Len(t, archive.SweepBatches[0].Sweeps, 1)
+
+	data, err := EncodeArchive(archive)
+	require.NoError(t, err)
+
+	decoded, err := DecodeArchive(data)
+	require.NoError(t, err)
+
+	// Importing into a database that already contains swaps fails.
+	err = store.ImportArchive(ctxb, decoded)
+	require.ErrorIs(t, err, ErrDatabaseNotEmpty)
+
+	target := NewTestDB(t)
+	require.NoError(t, target.ImportArchive(ctxb, decoded))
+
+	// The swaps are stored as before.
+	loopOuts, err := target.FetchLoopOutSwaps(ctxb)
+	require.NoError(t, err)
+	require.Len(t, loopOuts, 1)
+	require.Equal(t, loopOut, loopOuts[0].Contract)
+	require.Equal(t, StateSuccess, loopOuts[0].State().State)
+
+	loopIns, err := target.FetchLoopInSwaps(ctxb)
+	require.NoError(t, err)
+	require.Len(t, loopIns, 1)
+	require.Equal(t, loopIn, loopIns[0].Contract)
+	require.Equal(t, StateHtlcPublished, loopIns[0].State().State)
+
+	// A new export contains the same data, apart from the creation time
+	// and the new batch id.
+	imported, err := target.ExportArchive(ctxb)
+	require.NoError(t, err)
+
+	imported.CreatedAt = archive.CreatedAt
+	imported.SweepBatches[0].ID = archive.SweepBatches[0].ID
+	require.Equal(t, archive, imported)
+}
+
+// TestDecodeArchiveVersion tests that archives of unknown versions are
+// rejected.
+func TestDecodeArchiveVersion(t *testing.T) {
+	_, err := DecodeArchive([]byte(`{"version": 2}`))
+	require.ErrorIs(t, err, ErrUnknownArchiveVersion)
+
+	archive, err := DecodeArchive([]byte(`{"version": 1}`))
+	require.NoError(t, err)
+	require.Empty(t, archive.LoopOuts)
+}
+
+// TestImportPendingSwaps tests that only the pending swaps are exported and
+// that importing them skips the swaps that are stored already.
+func TestImportPendingSwaps(t *testing.T) {
+	ctxb := context.Background()
+	store := NewTestDB(t)
+
+	pending := &LoopOutContract{
+		SwapContract: SwapContract{
+			AmountRequested: 100,
+			Preimage:        testPreimage,
+			CltvExpiry:      144,
+			HtlcKeys: HtlcKeys{
+				SenderScriptKey:   senderKey,
+				ReceiverScriptKey: receiverKey,
+			},
+			InitiationTime: time.Unix(1, 0).UTC(),
+		},
+		DestAddr:                test.GetDestAddr(t, 0),
+		SwapPublicationDeadline: time.Unix(2, 0).UTC(),
+	}
+	pendingHash := lntypes.Hash{1}
+	require.NoError(t, store.CreateLoopOut(ctxb, pendingHash, pending))
+
+	finished := *pending
+	finished.Preimage = lntypes.Preimage{2}
+	finishedHash := lntypes.Hash{2}
+	require.NoError(t, store.CreateLoopOut(ctxb, finishedHash, &finished))
+	require.NoError(t, store.UpdateLoopOut(
+		ctxb, finishedHash, time.Unix(3, 0).UTC(), SwapStateData{
+			State: StateSuccess,
+		},
+	))
+
+	archive, err := store.ExportPendingSwaps(ctxb)
+	require.NoError(t, err)
+	require.Len(t, archive.LoopOuts, 1)
+	require.Equal(t, pendingHash[:], []byte(archive.LoopOuts[0].Swap.Hash))
+	require.Empty(t, archive.LoopIns)
+
+	// The swaps of the archive are stored already.
+	loopOuts, loopIns, err := store.ImportSwaps(ctxb, archive)
+	require.NoError(t, err)
+	require.Empty(t, loopOuts)
+	require.Empty(t, loopIns)
+
+	target := NewTestDB(t)
+	loopOuts, loopIns, err = target.ImportSwaps(ctxb, archive)
+	require.NoError(t, err)
+	require.Equal(t, []lntypes.Hash{pendingHash}, loopOuts)
+	require.Empty(t, loopIns)
+
+	loopOut, err := target.FetchLoopOutSwap(ctxb, pendingHash)
+	require.NoError(t, err)
+	require.Equal(t, pending, loopOut.Contract)
+	require.Equal(t, StateInitiated, loopOut.State().State)
+}
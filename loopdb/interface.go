@@ -33,6 +33,9 @@ type SwapStore interface {
 	// FetchLoopInSwaps returns all swaps currently in the store.
 	FetchLoopInSwaps(ctx context.Context) ([]*LoopIn, error)
 
+	// FetchLoopInSwap returns the loop in swap with the given hash.
+	FetchLoopInSwap(ctx context.Context, hash lntypes.Hash) (*LoopIn, error)
+
 	// CreateLoopIn adds an initiated swap to the store.
 	CreateLoopIn(ctx context.Context, hash lntypes.Hash,
 		swap *LoopInContract) error
@@ -47,6 +50,16 @@ type SwapStore interface {
 	UpdateLoopIn(ctx context.Context, hash lntypes.Hash, time time.Time,
 		state SwapStateData) error
 
+	// StorePresignedTimeoutTxs stores the presigned timeout transactions
+	// of the htlc of a loop in swap.
+	StorePresignedTimeoutTxs(ctx context.Context, hash lntypes.Hash,
+		txs []*PresignedTimeoutTx) error
+
+	// FetchPresignedTimeoutTxs returns the presigned timeout transactions
+	// of the htlc of a loop in swap, ordered by fee rate.
+	FetchPresignedTimeoutTxs(ctx context.Context,
+		hash lntypes.Hash) ([]*PresignedTimeoutTx, error)
+
 	// BatchInsertUpdate inserts batch of swap updates to the store.
 	BatchInsertUpdate(ctx context.Context,
 		updateData map[lntypes.Hash][]BatchInsertUpdateData) error
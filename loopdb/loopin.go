@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/coreos/bbolt"
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -45,6 +47,19 @@ func (s *LoopIn) LastUpdateTime() time.Time {
 	return lastUpdate.Time
 }
 
+// PresignedTimeoutTx is a timeout transaction of a loop in htlc that is signed
+// in advance at a fixed fee rate.
+type PresignedTimeoutTx struct {
+	// SatPerVbyte is the fee rate the transaction is signed at.
+	SatPerVbyte uint64
+
+	// Fee is the on-chain fee paid by the transaction.
+	Fee btcutil.Amount
+
+	// Tx is the signed timeout transaction.
+	Tx *wire.MsgTx
+}
+
 // serializeLoopInContract serialize the loop in contract into a byte slice.
 func serializeLoopInContract(swap *LoopInContract) (
 	[]byte, error) {
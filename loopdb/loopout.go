@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/routing/route"
 )
 
 // LoopOutContract contains the data that is serialized to persistent storage
@@ -65,6 +66,126 @@ type LoopOutContract struct {
 	// PaymentTimeout is the timeout for any individual off-chain payment
 	// attempt.
 	PaymentTimeout time.Duration
+
+	// SwapRoutePreferences holds the optional route restrictions for the
+	// swap payment.
+	SwapRoutePreferences RoutePreferences
+
+	// PrepayRoutePreferences holds the optional route restrictions for the
+	// prepayment.
+	PrepayRoutePreferences RoutePreferences
+}
+
+// RoutePreferences describes restrictions on the route that is used for an
+// off-chain payment. If none of the fields are set, lnd is free to pathfind
+// within the outgoing channel set.
+type RoutePreferences struct {
+	// Hops is an explicit list of hops (excluding our own node) that the
+	// payment must take. The last hop must be the payment destination.
+	Hops []route.Vertex
+
+	// IncludeNodes restricts the intermediate nodes of the route to this
+	// set. The payment destination is always allowed.
+	IncludeNodes []route.Vertex
+
+	// ExcludeNodes is a set of nodes that the route must not go through.
+	ExcludeNodes []route.Vertex
+}
+
+// IsSet returns true if any route restriction is set.
+func (r RoutePreferences) IsSet() bool {
+	return len(r.Hops) > 0 || len(r.IncludeNodes) > 0 ||
+		len(r.ExcludeNodes) > 0
+}
+
+// Validate checks that the route preferences are consistent with each other
+// and with the passed payment destination.
+func (r RoutePreferences) Validate(dest route.Vertex) error {
+	excluded := make(map[route.Vertex]struct{}, len(r.ExcludeNodes))
+	for _, node := range r.ExcludeNodes {
+		if node == dest {
+			return fmt.Errorf("payment destination %v cannot be "+
+				"excluded", dest)
+		}
+
+		excluded[node] = struct{}{}
+	}
+
+	included := make(map[route.Vertex]struct{}, len(r.IncludeNodes))
+	for _, node := range r.IncludeNodes {
+		if _, ok := excluded[node]; ok {
+			return fmt.Errorf("node %v is both included and "+
+				"excluded", node)
+		}
+
+		included[node] = struct{}{}
+	}
+
+	if len(r.Hops) == 0 {
+		return nil
+	}
+
+	if r.Hops[len(r.Hops)-1] != dest {
+		return fmt.Errorf("last hop %v is not the payment "+
+			"destination %v", r.Hops[len(r.Hops)-1], dest)
+	}
+
+	seen := make(map[route.Vertex]struct{}, len(r.Hops))
+	for i, hop := range r.Hops {
+		if _, ok := seen[hop]; ok {
+			return fmt.Errorf("duplicate hop %v", hop)
+		}
+		seen[hop] = struct{}{}
+
+		if _, ok := excluded[hop]; ok {
+			return fmt.Errorf("hop %v is excluded", hop)
+		}
+
+		// The destination is always allowed, so we only check the
+		// intermediate hops against the include set.
+		if i == len(r.Hops)-1 || len(included) == 0 {
+			continue
+		}
+
+		if _, ok := included[hop]; !ok {
+			return fmt.Errorf("hop %v is not in the include set",
+				hop)
+		}
+	}
+
+	return nil
+}
+
+// EncodeVertices returns the comma separated hex representation of the passed
+// nodes.
+func EncodeVertices(nodes []route.Vertex) string {
+	nodeStrings := make([]string, len(nodes))
+	for i, node := range nodes {
+		nodeStrings[i] = node.String()
+	}
+
+	return strings.Join(nodeStrings, ",")
+}
+
+// DecodeVertices parses a comma separated list of hex encoded node public
+// keys. An empty string results in a nil slice.
+func DecodeVertices(nodes string) ([]route.Vertex, error) {
+	if nodes == "" {
+		return nil, nil
+	}
+
+	nodeStrings := strings.Split(nodes, ",")
+	vertices := make([]route.Vertex, len(nodeStrings))
+	for i, nodeString := range nodeStrings {
+		vertex, err := route.NewVertexFromStr(nodeString)
+		if err != nil {
+			return nil, err
+		}
+
+		vertices[i] = vertex
+	}
+
+	return vertices, nil
 }
 
 // ChannelSet stores a set of channels.
@@ -0,0 +1,201 @@
+package loopdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// CompactionResult describes the outcome of a swap compaction.
+type CompactionResult struct {
+	// Swaps are the hashes of the compacted swaps.
+	Swaps []lntypes.Hash
+
+	// DeletedUpdates is the number of swap updates that were deleted.
+	DeletedUpdates int64
+
+	// Archive contains the compacted loop outs and loop ins with all their
+	// updates as they were before the compaction. It is only set if an
+	// archive was requested.
+	Archive *Archive
+}
+
+// CompactSwaps compacts the loop outs and loop ins that reached a final state
+// before the given time. Only the final update of such a swap is kept, which
+// holds the final state and the accumulated costs of the swap. The earlier
+// updates and the presigned timeout txs of the swap are deleted. The hash of
+// the htlc tx is carried over to the final update if it is only known from an
+// earlier update. If archive is set, the compacted swaps are exported with all
+// their updates before they are compacted.
+func (db *BaseDB) CompactSwaps(ctx context.Context, before time.Time,
+	archive bool) (*CompactionResult, error) {
+
+	var result *CompactionResult
+	err := db.ExecTx(ctx, NewSqlWriteOpts(), func(tx *sqlc.Queries) error {
+		var err error
+		result, err = compactSwaps(ctx, tx, before, archive)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// compactSwaps compacts the swaps that reached a final state before the given
+// time within the given transaction.
+func compactSwaps(ctx context.Context, tx *sqlc.Queries, before time.Time,
+	archive bool) (*CompactionResult, error) {
+
+	lastUpdates, err := tx.GetLastSwapUpdatesBefore(ctx, before.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CompactionResult{}
+	swapUpdates := make(map[lntypes.Hash][]sqlc.SwapUpdate)
+	for _, lastUpdate := range lastUpdates {
+		if SwapState(lastUpdate.UpdateState).IsPending() {
+			continue
+		}
+
+		updates, err := tx.GetSwapUpdates(ctx, lastUpdate.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		// Swaps with a single update are compacted already.
+		if len(updates) <= 1 {
+			continue
+		}
+
+		hash, err := lntypes.MakeHash(lastUpdate.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Swaps = append(result.Swaps, hash)
+		swapUpdates[hash] = updates
+	}
+
+	if len(result.Swaps) == 0 {
+		return result, nil
+	}
+
+	// The archive needs to be created before the updates are deleted.
+	if archive {
+		result.Archive, err = exportCompactedSwaps(
+			ctx, tx, result.Swaps, swapUpdates,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, hash := range result.Swaps {
+		deleted, err := compactSwap(ctx, tx, hash, swapUpdates[hash])
+		if err != nil {
+			return nil, err
+		}
+
+		result.DeletedUpdates += deleted
+	}
+
+	return result, nil
+}
+
+// compactSwap deletes all of the given updates of a swap except the last one
+// and the presigned timeout txs of the swap. It returns the number of deleted
+// updates.
+func compactSwap(ctx context.Context, tx *sqlc.Queries, hash lntypes.Hash,
+	updates []sqlc.SwapUpdate) (int64, error) {
+
+	// The htlc tx hash isn't necessarily set on the final update, so we
+	// keep the latest one that is known.
+	var htlcTxHash string
+	for _, update := range updates {
+		if update.HtlcTxhash != "" {
+			htlcTxHash = update.HtlcTxhash
+		}
+	}
+
+	last := updates[len(updates)-1]
+	if last.HtlcTxhash == "" && htlcTxHash != "" {
+		err := tx.UpdateSwapUpdateHtlcTxHash(
+			ctx, sqlc.UpdateSwapUpdateHtlcTxHashParams{
+				ID:         last.ID,
+				HtlcTxhash: htlcTxHash,
+			},
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err := tx.DeletePresignedTimeoutTxs(ctx, hash[:])
+	if err != nil {
+		return 0, err
+	}
+
+	return tx.DeleteEarlierSwapUpdates(
+		ctx, sqlc.DeleteEarlierSwapUpdatesParams{
+			SwapHash: hash[:],
+			ID:       last.ID,
+		},
+	)
+}
+
+// exportCompactedSwaps creates an archive of the loop outs and loop ins with
+// the given hashes. The updates of the swaps are passed in, so that they don't
+// need to be read again.
+func exportCompactedSwaps(ctx context.Context, tx *sqlc.Queries,
+	hashes []lntypes.Hash,
+	swapUpdates map[lntypes.Hash][]sqlc.SwapUpdate) (*Archive, error) {
+
+	archive := &Archive{
+		Version:   ArchiveVersion,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	for _, hash := range hashes {
+		updates := archiveSwapUpdates(swapUpdates[hash])
+
+		loopOutRow, err := tx.GetLoopOutSwap(ctx, hash[:])
+		switch {
+		case err == nil:
+			archive.LoopOuts = append(
+				archive.LoopOuts, exportLoopOut(
+					sqlc.GetLoopOutSwapsRow(loopOutRow),
+					updates,
+				),
+			)
+
+			continue
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return nil, err
+		}
+
+		loopInRow, err := tx.GetLoopInSwap(ctx, hash[:])
+		if err != nil {
+			return nil, err
+		}
+
+		loopIn, err := exportLoopIn(
+			ctx, tx, sqlc.GetLoopInSwapsRow(loopInRow), updates,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		archive.LoopIns = append(archive.LoopIns, loopIn)
+	}
+
+	return archive, nil
+}
@@ -0,0 +1,185 @@
+package loopdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCompactSwaps tests that only the swaps that reached a final state before
+// the given time are compacted, and that their final state, costs and htlc tx
+// hash are kept.
+func TestCompactSwaps(t *testing.T) {
+	ctxb := context.Background()
+	store := NewTestDB(t)
+
+	newLoopOut := func(hash lntypes.Hash) {
+		contract := &LoopOutContract{
+			SwapContract: SwapContract{
+				AmountRequested: 100,
+				Preimage:        lntypes.Preimage(hash),
+				CltvExpiry:      144,
+				HtlcKeys: HtlcKeys{
+					SenderScriptKey:   senderKey,
+					ReceiverScriptKey: receiverKey,
+				},
+				InitiationTime: testTime,
+			},
+			DestAddr:                test.GetDestAddr(t, 0),
+			SwapPublicationDeadline: testTime,
+		}
+		require.NoError(t, store.CreateLoopOut(ctxb, hash, contract))
+	}
+
+	htlcTxHash := &chainhash.Hash{9}
+	cost := SwapCost{
+		Server:   10,
+		Onchain:  20,
+		Offchain: 30,
+	}
+
+	// finishSwap adds an update with the htlc tx hash and a final update
+	// with the costs of the swap.
+	finishSwap := func(hash lntypes.Hash, loopIn bool, at time.Time) {
+		update := store.UpdateLoopOut
+		if loopIn {
+			update = store.UpdateLoopIn
+		}
+
+		require.NoError(t, update(
+			ctxb, hash, at.Add(-time.Minute), SwapStateData{
+				State:      StateHtlcPublished,
+				HtlcTxHash: htlcTxHash,
+			},
+		))
+		require.NoError(t, update(
+			ctxb, hash, at, SwapStateData{
+				State: StateSuccess,
+				Cost:  cost,
+			},
+		))
+	}
+
+	// An old finished loop out is compacted.
+	oldLoopOut := lntypes.Hash{1}
+	newLoopOut(oldLoopOut)
+	require.NoError(t, store.UpdateLoopOut(
+		ctxb, oldLoopOut, testTime, SwapStateData{
+			State: StatePreimageRevealed,
+		},
+	))
+	finishSwap(oldLoopOut, false, testTime.Add(time.Hour))
+
+	// An old pending loop out is kept as is.
+	pendingLoopOut := lntypes.Hash{2}
+	newLoopOut(pendingLoopOut)
+	require.NoError(t, store.UpdateLoopOut(
+		ctxb, pendingLoopOut, testTime, SwapStateData{
+			State: StateInitiated,
+		},
+	))
+	require.NoError(t, store.UpdateLoopOut(
+		ctxb, pendingLoopOut, testTime.Add(time.Hour), SwapStateData{
+			State:      StateHtlcPublished,
+			HtlcTxHash: htlcTxHash,
+		},
+	))
+
+	// A loop out that finished recently is kept as is.
+	recentLoopOut := lntypes.Hash{3}
+	newLoopOut(recentLoopOut)
+	finishSwap(recentLoopOut, false, testTime.Add(72*time.Hour))
+
+	// An old finished loop in is compacted together with its presigned
+	// timeout txs.
+	oldLoopIn := lntypes.Hash{4}
+	err := store.CreateLoopIn(ctxb, oldLoopIn, &LoopInContract{
+		SwapContract: SwapContract{
+			AmountRequested: 100,
+			Preimage:        lntypes.Preimage(oldLoopIn),
+			CltvExpiry:      144,
+			InitiationTime:  testTime,
+		},
+	})
+	require.NoError(t, err)
+	finishSwap(oldLoopIn, true, testTime.Add(time.Hour))
+
+	timeoutTx := wire.NewMsgTx(2)
+	timeoutTx.AddTxIn(&wire.TxIn{SignatureScript: []byte{}})
+	err = store.StorePresignedTimeoutTxs(
+		ctxb, oldLoopIn, []*PresignedTimeoutTx{{
+			SatPerVbyte: 2,
+			Fee:         5,
+			Tx:          timeoutTx,
+		}},
+	)
+	require.NoError(t, err)
+
+	before := testTime.Add(24 * time.Hour)
+	result, err := store.CompactSwaps(ctxb, before, true)
+	require.NoError(t, err)
+	require.Equal(t, []lntypes.Hash{oldLoopOut, oldLoopIn}, result.Swaps)
+	require.EqualValues(t, 3, result.DeletedUpdates)
+
+	// The archive only contains the compacted swaps with all their updates
+	// and the presigned timeout txs that are deleted.
+	require.Len(t, result.Archive.LoopOuts, 1)
+	require.Equal(
+		t, HexBytes(oldLoopOut[:]), result.Archive.LoopOuts[0].Swap.Hash,
+	)
+	require.Len(t, result.Archive.LoopOuts[0].Updates, 3)
+	require.Len(t, result.Archive.LoopIns, 1)
+	require.Equal(
+		t, HexBytes(oldLoopIn[:]), result.Archive.LoopIns[0].Swap.Hash,
+	)
+	require.Len(t, result.Archive.LoopIns[0].Updates, 2)
+	require.Len(t, result.Archive.LoopIns[0].PresignedTimeoutTxs, 1)
+
+	expectedUpdates := map[lntypes.Hash]int{
+		oldLoopOut:     1,
+		pendingLoopOut: 2,
+		recentLoopOut:  2,
+	}
+
+	loopOuts, err := store.FetchLoopOutSwaps(ctxb)
+	require.NoError(t, err)
+	require.Len(t, loopOuts, len(expectedUpdates))
+	for _, loopOut := range loopOuts {
+		require.Len(
+			t, loopOut.Events, expectedUpdates[loopOut.Hash],
+		)
+	}
+
+	loopIns, err := store.FetchLoopInSwaps(ctxb)
+	require.NoError(t, err)
+	require.Len(t, loopIns, 1)
+	require.Len(t, loopIns[0].Events, 1)
+
+	// The compacted swaps keep their final state, costs and htlc tx hash.
+	for _, state := range []SwapStateData{
+		loopOuts[0].State(), loopIns[0].State(),
+	} {
+		require.Equal(t, SwapStateData{
+			State:      StateSuccess,
+			Cost:       cost,
+			HtlcTxHash: htlcTxHash,
+		}, state)
+	}
+
+	txs, err := store.FetchPresignedTimeoutTxs(ctxb, oldLoopIn)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	// Compacting again has no effect.
+	result, err = store.CompactSwaps(ctxb, before, true)
+	require.NoError(t, err)
+	require.Empty(t, result.Swaps)
+	require.Zero(t, result.DeletedUpdates)
+	require.Nil(t, result.Archive)
+}
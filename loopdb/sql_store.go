@@ -1,6 +1,7 @@
 package loopdb
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/loop/loopdb/sqlc"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -219,6 +221,39 @@ func (db *BaseDB) FetchLoopInSwaps(ctx context.Context) (
 	return loopIns, nil
 }
 
+// FetchLoopInSwap returns the loop in swap with the given hash.
+func (db *BaseDB) FetchLoopInSwap(ctx context.Context,
+	hash lntypes.Hash) (*LoopIn, error) {
+
+	var loopIn *LoopIn
+
+	err := db.ExecTx(ctx, NewSqlReadOpts(), func(tx *sqlc.Queries) error {
+		swap, err := tx.GetLoopInSwap(ctx, hash[:])
+		if err != nil {
+			return err
+		}
+
+		updates, err := tx.GetSwapUpdates(ctx, swap.SwapHash)
+		if err != nil {
+			return err
+		}
+
+		loopIn, err = db.convertLoopInRow(
+			sqlc.GetLoopInSwapsRow(swap), updates,
+		)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return loopIn, nil
+}
+
 // CreateLoopIn adds an initiated swap to the store.
 func (db *BaseDB) CreateLoopIn(ctx context.Context, hash lntypes.Hash,
 	swap *LoopInContract) error {
@@ -307,6 +342,64 @@ func (db *BaseDB) UpdateLoopIn(ctx context.Context, hash lntypes.Hash,
 	return db.updateLoop(ctx, hash, time, state)
 }
 
+// StorePresignedTimeoutTxs stores the presigned timeout transactions of the
+// htlc of a loop in swap.
+func (db *BaseDB) StorePresignedTimeoutTxs(ctx context.Context,
+	hash lntypes.Hash, txs []*PresignedTimeoutTx) error {
+
+	writeOpts := NewSqlWriteOpts()
+	return db.ExecTx(ctx, writeOpts, func(tx *sqlc.Queries) error {
+		for _, timeoutTx := range txs {
+			var buf bytes.Buffer
+			err := timeoutTx.Tx.Serialize(&buf)
+			if err != nil {
+				return err
+			}
+
+			params := sqlc.InsertPresignedTimeoutTxParams{
+				SwapHash:    hash[:],
+				SatPerVbyte: int64(timeoutTx.SatPerVbyte),
+				Fee:         int64(timeoutTx.Fee),
+				RawTx:       buf.Bytes(),
+			}
+			err = tx.InsertPresignedTimeoutTx(ctx, params)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchPresignedTimeoutTxs returns the presigned timeout transactions of the
+// htlc of a loop in swap, ordered by fee rate.
+func (db *BaseDB) FetchPresignedTimeoutTxs(ctx context.Context,
+	hash lntypes.Hash) ([]*PresignedTimeoutTx, error) {
+
+	rows, err := db.Queries.GetPresignedTimeoutTxs(ctx, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*PresignedTimeoutTx, 0, len(rows))
+	for _, row := range rows {
+		tx := &wire.MsgTx{}
+		err := tx.Deserialize(bytes.NewReader(row.RawTx))
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, &PresignedTimeoutTx{
+			SatPerVbyte: uint64(row.SatPerVbyte),
+			Fee:         btcutil.Amount(row.Fee),
+			Tx:          tx,
+		})
+	}
+
+	return txs, nil
+}
+
 // PutLiquidityParams writes the serialized `manager.Parameters` bytes
 // into the bucket.
 //
@@ -499,6 +592,24 @@ func loopOutToInsertArgs(hash lntypes.Hash,
 		MaxPrepayRoutingFee: int64(loopOut.MaxPrepayRoutingFee),
 		PublicationDeadline: loopOut.SwapPublicationDeadline.UTC(),
 		PaymentTimeout:      int32(loopOut.PaymentTimeout.Seconds()),
+		SwapRouteHops: EncodeVertices(
+			loopOut.SwapRoutePreferences.Hops,
+		),
+		SwapRouteIncludeNodes: EncodeVertices(
+			loopOut.SwapRoutePreferences.IncludeNodes,
+		),
+		SwapRouteExcludeNodes: EncodeVertices(
+			loopOut.SwapRoutePreferences.ExcludeNodes,
+		),
+		PrepayRouteHops: EncodeVertices(
+			loopOut.PrepayRoutePreferences.Hops,
+		),
+		PrepayRouteIncludeNodes: EncodeVertices(
+			loopOut.PrepayRoutePreferences.IncludeNodes,
+		),
+		PrepayRouteExcludeNodes: EncodeVertices(
+			loopOut.PrepayRoutePreferences.ExcludeNodes,
+		),
 	}
 }
 
@@ -610,6 +721,22 @@ func ConvertLoopOutRow(network *chaincfg.Params, row sqlc.GetLoopOutSwapRow,
 		loopOut.Contract.OutgoingChanSet = chanSet
 	}
 
+	loopOut.Contract.SwapRoutePreferences, err = convertRoutePreferences(
+		row.SwapRouteHops, row.SwapRouteIncludeNodes,
+		row.SwapRouteExcludeNodes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	loopOut.Contract.PrepayRoutePreferences, err = convertRoutePreferences(
+		row.PrepayRouteHops, row.PrepayRouteIncludeNodes,
+		row.PrepayRouteExcludeNodes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// If we don't have any updates yet we can return early
 	if len(updates) == 0 {
 		return loopOut, nil
@@ -744,6 +871,34 @@ func ConvertOutgoingChanSet(outgoingChanSet string) (ChannelSet, error) {
 	return NewChannelSet(channels)
 }
 
+// convertRoutePreferences converts the comma separated node lists stored in
+// the database into a RoutePreferences struct.
+func convertRoutePreferences(hops, includeNodes,
+	excludeNodes string) (RoutePreferences, error) {
+
+	var (
+		prefs RoutePreferences
+		err   error
+	)
+
+	prefs.Hops, err = DecodeVertices(hops)
+	if err != nil {
+		return RoutePreferences{}, err
+	}
+
+	prefs.IncludeNodes, err = DecodeVertices(includeNodes)
+	if err != nil {
+		return RoutePreferences{}, err
+	}
+
+	prefs.ExcludeNodes, err = DecodeVertices(excludeNodes)
+	if err != nil {
+		return RoutePreferences{}, err
+	}
+
+	return prefs, nil
+}
+
 // fetchHtlcKeys converts the blob encoded htlc keys into a HtlcKeys struct.
 func fetchHtlcKeys(senderScriptPubkey, receiverScriptPubkey,
 	senderInternalPubkey, receiverInternalPubkey []byte,
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/loop/loopdb/sqlc"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -80,6 +81,18 @@ func TestSqliteLoopOutStore(t *testing.T) {
 	t.Run("labelled swap", func(t *testing.T) {
 		testSqliteLoopOutStore(t, &labelledSwap)
 	})
+
+	routedSwap := unrestrictedSwap
+	routedSwap.SwapRoutePreferences = RoutePreferences{
+		Hops:         []route.Vertex{{1}, {2}},
+		ExcludeNodes: []route.Vertex{{3}},
+	}
+	routedSwap.PrepayRoutePreferences = RoutePreferences{
+		IncludeNodes: []route.Vertex{{1}, {4}},
+	}
+	t.Run("route preferences", func(t *testing.T) {
+		testSqliteLoopOutStore(t, &routedSwap)
+	})
 }
 
 // testSqliteLoopOutStore tests the basic functionality of the current sqlite
@@ -241,6 +254,11 @@ func testSqliteLoopInStore(t *testing.T, pendingSwap LoopInContract) {
 		require.Equal(t, swap, &pendingSwap)
 
 		require.Equal(t, swaps[0].State().State, expectedState)
+
+		loopIn, err := store.FetchLoopInSwap(ctxb, hash)
+		require.NoError(t, err)
+		require.Equal(t, &pendingSwap, loopIn.Contract)
+		require.Equal(t, expectedState, loopIn.State().State)
 	}
 
 	// If we create a new swap, then it should show up as being initialized
@@ -283,6 +301,60 @@ func testSqliteLoopInStore(t *testing.T, pendingSwap LoopInContract) {
 	require.NoError(t, err)
 }
 
+// TestPresignedTimeoutTxs tests that presigned timeout txs of a loop in swap
+// are stored and returned ordered by fee rate.
+func TestPresignedTimeoutTxs(t *testing.T) {
+	ctxb := context.Background()
+	store := NewTestDB(t)
+
+	hash := sha256.Sum256(testPreimage[:])
+	err := store.CreateLoopIn(ctxb, hash, &LoopInContract{
+		SwapContract: SwapContract{
+			AmountRequested: 100,
+			Preimage:        testPreimage,
+			CltvExpiry:      144,
+			InitiationTime:  testTime,
+		},
+	})
+	require.NoError(t, err)
+
+	txs, err := store.FetchPresignedTimeoutTxs(ctxb, hash)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	newTx := func(lockTime uint32) *wire.MsgTx {
+		tx := wire.NewMsgTx(2)
+		tx.LockTime = lockTime
+		tx.AddTxIn(&wire.TxIn{
+			SignatureScript: []byte{},
+			Witness:         wire.TxWitness{{1}, {2}},
+		})
+		tx.AddTxOut(&wire.TxOut{
+			Value:    90,
+			PkScript: []byte{0x51},
+		})
+
+		return tx
+	}
+
+	expected := []*PresignedTimeoutTx{
+		{SatPerVbyte: 2, Fee: 5, Tx: newTx(1)},
+		{SatPerVbyte: 10, Fee: 8, Tx: newTx(2)},
+	}
+	err = store.StorePresignedTimeoutTxs(
+		ctxb, hash, []*PresignedTimeoutTx{expected[1], expected[0]},
+	)
+	require.NoError(t, err)
+
+	txs, err = store.FetchPresignedTimeoutTxs(ctxb, hash)
+	require.NoError(t, err)
+	require.Equal(t, expected, txs)
+
+	// A tx for an existing fee rate is rejected.
+	err = store.StorePresignedTimeoutTxs(ctxb, hash, expected[:1])
+	require.Error(t, err)
+}
+
 // TestSqliteLiquidityParams checks that reading and writing to liquidty bucket are
 // as expected.
 func TestSqliteLiquidityParams(t *testing.T) {
@@ -119,6 +119,46 @@ func (q *Queries) GetParentBatch(ctx context.Context, swapHash []byte) (SweepBat
 	return i, err
 }
 
+const getSweepBatches = `-- name: GetSweepBatches :many
+SELECT
+        id, confirmed, batch_tx_id, batch_pk_script, last_rbf_height, last_rbf_sat_per_kw, max_timeout_distance
+FROM
+        sweep_batches
+ORDER BY
+        id ASC
+`
+
+func (q *Queries) GetSweepBatches(ctx context.Context) ([]SweepBatch, error) {
+	rows, err := q.db.QueryContext(ctx, getSweepBatches)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SweepBatch
+	for rows.Next() {
+		var i SweepBatch
+		if err := rows.Scan(
+			&i.ID,
+			&i.Confirmed,
+			&i.BatchTxID,
+			&i.BatchPkScript,
+			&i.LastRbfHeight,
+			&i.LastRbfSatPerKw,
+			&i.MaxTimeoutDistance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSweepStatus = `-- name: GetSweepStatus :one
 SELECT
     COALESCE(s.completed, f.false_value) AS completed
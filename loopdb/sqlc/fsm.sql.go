@@ -0,0 +1,158 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: fsm.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+)
+
+const getFsmTransitions = `-- name: GetFsmTransitions :many
+SELECT
+    fsm_transitions.id, fsm_transitions.machine_kind, fsm_transitions.machine_id, fsm_transitions.previous_state, fsm_transitions.next_state, fsm_transitions.event, fsm_transitions.event_context, fsm_transitions.transition_timestamp
+FROM
+    fsm_transitions
+WHERE
+    fsm_transitions.machine_kind = $1
+AND
+    fsm_transitions.machine_id = $2
+ORDER BY
+    fsm_transitions.id
+`
+
+type GetFsmTransitionsParams struct {
+	MachineKind string
+	MachineID   string
+}
+
+func (q *Queries) GetFsmTransitions(ctx context.Context, arg GetFsmTransitionsParams) ([]FsmTransition, error) {
+	rows, err := q.db.QueryContext(ctx, getFsmTransitions, arg.MachineKind, arg.MachineID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FsmTransition
+	for rows.Next() {
+		var i FsmTransition
+		if err := rows.Scan(
+			&i.ID,
+			&i.MachineKind,
+			&i.MachineID,
+			&i.PreviousState,
+			&i.NextState,
+			&i.Event,
+			&i.EventContext,
+			&i.TransitionTimestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLastFsmTransitions = `-- name: GetLastFsmTransitions :many
+SELECT
+    fsm_transitions.id, fsm_transitions.machine_kind, fsm_transitions.machine_id, fsm_transitions.previous_state, fsm_transitions.next_state, fsm_transitions.event, fsm_transitions.event_context, fsm_transitions.transition_timestamp
+FROM
+    fsm_transitions
+WHERE
+    fsm_transitions.machine_kind = $1
+AND
+    fsm_transitions.id = (
+        SELECT
+            MAX(latest.id)
+        FROM
+            fsm_transitions AS latest
+        WHERE
+            latest.machine_kind = fsm_transitions.machine_kind
+        AND
+            latest.machine_id = fsm_transitions.machine_id
+    )
+ORDER BY
+    fsm_transitions.id
+`
+
+func (q *Queries) GetLastFsmTransitions(ctx context.Context, machineKind string) ([]FsmTransition, error) {
+	rows, err := q.db.QueryContext(ctx, getLastFsmTransitions, machineKind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FsmTransition
+	for rows.Next() {
+		var i FsmTransition
+		if err := rows.Scan(
+			&i.ID,
+			&i.MachineKind,
+			&i.MachineID,
+			&i.PreviousState,
+			&i.NextState,
+			&i.Event,
+			&i.EventContext,
+			&i.TransitionTimestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertFsmTransition = `-- name: InsertFsmTransition :exec
+INSERT INTO fsm_transitions (
+        machine_kind,
+        machine_id,
+        previous_state,
+        next_state,
+        event,
+        event_context,
+        transition_timestamp
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7
+)
+`
+
+type InsertFsmTransitionParams struct {
+	MachineKind         string
+	MachineID           string
+	PreviousState       string
+	NextState           string
+	Event               string
+	EventContext        []byte
+	TransitionTimestamp time.Time
+}
+
+func (q *Queries) InsertFsmTransition(ctx context.Context, arg InsertFsmTransitionParams) error {
+	_, err := q.db.ExecContext(ctx, insertFsmTransition,
+		arg.MachineKind,
+		arg.MachineID,
+		arg.PreviousState,
+		arg.NextState,
+		arg.Event,
+		arg.EventContext,
+		arg.TransitionTimestamp,
+	)
+	return err
+}
@@ -11,6 +11,31 @@ import (
 	"time"
 )
 
+const getInstantOutChangeReservation = `-- name: GetInstantOutChangeReservation :one
+SELECT
+    instantout_change_reservations.swap_hash, instantout_change_reservations.reservation_id, instantout_change_reservations.client_pubkey, instantout_change_reservations.server_pubkey, instantout_change_reservations.expiry, instantout_change_reservations.value, instantout_change_reservations.client_key_family, instantout_change_reservations.client_key_index
+FROM
+    instantout_change_reservations
+WHERE
+    instantout_change_reservations.swap_hash = $1
+`
+
+func (q *Queries) GetInstantOutChangeReservation(ctx context.Context, swapHash []byte) (InstantoutChangeReservation, error) {
+	row := q.db.QueryRowContext(ctx, getInstantOutChangeReservation, swapHash)
+	var i InstantoutChangeReservation
+	err := row.Scan(
+		&i.SwapHash,
+		&i.ReservationID,
+		&i.ClientPubkey,
+		&i.ServerPubkey,
+		&i.Expiry,
+		&i.Value,
+		&i.ClientKeyFamily,
+		&i.ClientKeyIndex,
+	)
+	return i, err
+}
+
 const getInstantOutSwap = `-- name: GetInstantOutSwap :one
 SELECT
     swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
@@ -232,6 +257,46 @@ func (q *Queries) GetInstantOutSwaps(ctx context.Context) ([]GetInstantOutSwapsR
 	return items, nil
 }
 
+const getInstantOutSweepOutputs = `-- name: GetInstantOutSweepOutputs :many
+SELECT
+    instantout_sweep_outputs.id, instantout_sweep_outputs.swap_hash, instantout_sweep_outputs.output_index, instantout_sweep_outputs.address, instantout_sweep_outputs.amount
+FROM
+    instantout_sweep_outputs
+WHERE
+    instantout_sweep_outputs.swap_hash = $1
+ORDER BY
+    instantout_sweep_outputs.output_index
+`
+
+func (q *Queries) GetInstantOutSweepOutputs(ctx context.Context, swapHash []byte) ([]InstantoutSweepOutput, error) {
+	rows, err := q.db.QueryContext(ctx, getInstantOutSweepOutputs, swapHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstantoutSweepOutput
+	for rows.Next() {
+		var i InstantoutSweepOutput
+		if err := rows.Scan(
+			&i.ID,
+			&i.SwapHash,
+			&i.OutputIndex,
+			&i.Address,
+			&i.Amount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertInstantOut = `-- name: InsertInstantOut :exec
 INSERT INTO instantout_swaps (
         swap_hash,
@@ -275,6 +340,84 @@ func (q *Queries) InsertInstantOut(ctx context.Context, arg InsertInstantOutPara
 	return err
 }
 
+const insertInstantOutChangeReservation = `-- name: InsertInstantOutChangeReservation :exec
+INSERT INTO instantout_change_reservations (
+        swap_hash,
+        reservation_id,
+        client_pubkey,
+        server_pubkey,
+        expiry,
+        value,
+        client_key_family,
+        client_key_index
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8
+)
+`
+
+type InsertInstantOutChangeReservationParams struct {
+	SwapHash        []byte
+	ReservationID   []byte
+	ClientPubkey    []byte
+	ServerPubkey    []byte
+	Expiry          int32
+	Value           int64
+	ClientKeyFamily int32
+	ClientKeyIndex  int32
+}
+
+func (q *Queries) InsertInstantOutChangeReservation(ctx context.Context, arg InsertInstantOutChangeReservationParams) error {
+	_, err := q.db.ExecContext(ctx, insertInstantOutChangeReservation,
+		arg.SwapHash,
+		arg.ReservationID,
+		arg.ClientPubkey,
+		arg.ServerPubkey,
+		arg.Expiry,
+		arg.Value,
+		arg.ClientKeyFamily,
+		arg.ClientKeyIndex,
+	)
+	return err
+}
+
+const insertInstantOutSweepOutput = `-- name: InsertInstantOutSweepOutput :exec
+INSERT INTO instantout_sweep_outputs (
+        swap_hash,
+        output_index,
+        address,
+        amount
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4
+)
+`
+
+type InsertInstantOutSweepOutputParams struct {
+	SwapHash    []byte
+	OutputIndex int32
+	Address     string
+	Amount      int64
+}
+
+func (q *Queries) InsertInstantOutSweepOutput(ctx context.Context, arg InsertInstantOutSweepOutputParams) error {
+	_, err := q.db.ExecContext(ctx, insertInstantOutSweepOutput,
+		arg.SwapHash,
+		arg.OutputIndex,
+		arg.Address,
+		arg.Amount,
+	)
+	return err
+}
+
 const insertInstantOutUpdate = `-- name: InsertInstantOutUpdate :exec
 INSERT INTO instantout_updates (
         swap_hash,
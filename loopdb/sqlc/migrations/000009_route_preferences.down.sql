@@ -0,0 +1,6 @@
+ALTER TABLE loopout_swaps DROP COLUMN prepay_route_exclude_nodes;
+ALTER TABLE loopout_swaps DROP COLUMN prepay_route_include_nodes;
+ALTER TABLE loopout_swaps DROP COLUMN prepay_route_hops;
+ALTER TABLE loopout_swaps DROP COLUMN swap_route_exclude_nodes;
+ALTER TABLE loopout_swaps DROP COLUMN swap_route_include_nodes;
+ALTER TABLE loopout_swaps DROP COLUMN swap_route_hops;
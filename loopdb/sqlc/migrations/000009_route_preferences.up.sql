@@ -0,0 +1,24 @@
+-- The route preference columns hold comma separated lists of hex encoded node
+-- public keys that restrict the routes used for the swap and prepay payments.
+
+-- swap_route_hops is the explicit route that the swap payment must take.
+ALTER TABLE loopout_swaps ADD swap_route_hops TEXT NOT NULL DEFAULT '';
+
+-- swap_route_include_nodes is the set of nodes that the swap payment may use
+-- as intermediate hops.
+ALTER TABLE loopout_swaps ADD swap_route_include_nodes TEXT NOT NULL DEFAULT '';
+
+-- swap_route_exclude_nodes is the set of nodes that the swap payment must not
+-- go through.
+ALTER TABLE loopout_swaps ADD swap_route_exclude_nodes TEXT NOT NULL DEFAULT '';
+
+-- prepay_route_hops is the explicit route that the prepayment must take.
+ALTER TABLE loopout_swaps ADD prepay_route_hops TEXT NOT NULL DEFAULT '';
+
+-- prepay_route_include_nodes is the set of nodes that the prepayment may use
+-- as intermediate hops.
+ALTER TABLE loopout_swaps ADD prepay_route_include_nodes TEXT NOT NULL DEFAULT '';
+
+-- prepay_route_exclude_nodes is the set of nodes that the prepayment must not
+-- go through.
+ALTER TABLE loopout_swaps ADD prepay_route_exclude_nodes TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS instantout_change_reservations;
@@ -0,0 +1,29 @@
+-- instantout_change_reservations contains the change reservations of instant
+-- out swaps that only swap a part of their input reservations. The change
+-- output of the sweepless sweep or htlc transaction is a new reservation.
+CREATE TABLE IF NOT EXISTS instantout_change_reservations (
+        -- swap_hash is the hash of the instant out swap that created the
+        -- change reservation.
+        swap_hash BLOB PRIMARY KEY REFERENCES instantout_swaps(swap_hash),
+
+        -- reservation_id is the unique identifier of the change reservation.
+        reservation_id BLOB NOT NULL UNIQUE,
+
+        -- client_pubkey is the public key of the client.
+        client_pubkey BLOB NOT NULL,
+
+        -- server_pubkey is the public key of the server.
+        server_pubkey BLOB NOT NULL,
+
+        -- expiry is the absolute expiry height of the change reservation.
+        expiry INTEGER NOT NULL,
+
+        -- value is the value of the change reservation.
+        value BIGINT NOT NULL,
+
+        -- client_key_family is the key family of the client.
+        client_key_family INTEGER NOT NULL,
+
+        -- client_key_index is the key index of the client.
+        client_key_index INTEGER NOT NULL
+);
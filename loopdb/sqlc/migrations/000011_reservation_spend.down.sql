@@ -0,0 +1,2 @@
+ALTER TABLE reservations DROP COLUMN spend_height;
+ALTER TABLE reservations DROP COLUMN spend_txid;
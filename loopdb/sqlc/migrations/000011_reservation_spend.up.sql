@@ -0,0 +1,6 @@
+-- spend_txid is the hash of the transaction that spent the reservation
+-- output, either cooperatively or through the server's expiry path.
+ALTER TABLE reservations ADD spend_txid BLOB;
+
+-- spend_height is the height at which the reservation output was spent.
+ALTER TABLE reservations ADD spend_height INTEGER;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS instantout_sweep_outputs_swap_hash_idx;
+DROP TABLE IF EXISTS instantout_sweep_outputs;
@@ -0,0 +1,26 @@
+-- instantout_sweep_outputs contains the additional outputs of the sweepless
+-- sweep and htlc sweep transactions of instant out swaps that pay fixed
+-- amounts to several destinations. The remainder of the swap is still sent to
+-- the sweep address of the swap.
+CREATE TABLE IF NOT EXISTS instantout_sweep_outputs (
+        -- id is auto incremented for each output.
+        id INTEGER PRIMARY KEY,
+
+        -- swap_hash is the hash of the instant out swap the output belongs
+        -- to.
+        swap_hash BLOB NOT NULL REFERENCES instantout_swaps(swap_hash),
+
+        -- output_index is the position of the output among the additional
+        -- outputs of the swap.
+        output_index INTEGER NOT NULL,
+
+        -- address is the address the output pays to.
+        address TEXT NOT NULL,
+
+        -- amount is the amount in satoshis the output pays.
+        amount BIGINT NOT NULL,
+
+        UNIQUE (swap_hash, output_index)
+);
+
+CREATE INDEX IF NOT EXISTS instantout_sweep_outputs_swap_hash_idx ON instantout_sweep_outputs(swap_hash);
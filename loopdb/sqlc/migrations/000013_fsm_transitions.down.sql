@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS fsm_transitions_machine_idx;
+DROP TABLE IF EXISTS fsm_transitions;
@@ -0,0 +1,34 @@
+-- fsm_transitions contains the transitions of persistent state machines. A
+-- transition is recorded before the action of the new state is executed, so
+-- that the last transition of a state machine describes the action to resume
+-- after a restart.
+CREATE TABLE IF NOT EXISTS fsm_transitions (
+        -- id is auto incremented for each transition.
+        id INTEGER PRIMARY KEY,
+
+        -- machine_kind is the type of the state machine, e.g. the type of
+        -- swap it drives.
+        machine_kind TEXT NOT NULL,
+
+        -- machine_id identifies the state machine within its kind.
+        machine_id TEXT NOT NULL,
+
+        -- previous_state is the state the state machine transitioned from.
+        previous_state TEXT NOT NULL,
+
+        -- next_state is the state the state machine transitioned to.
+        next_state TEXT NOT NULL,
+
+        -- event is the event that triggered the transition.
+        event TEXT NOT NULL,
+
+        -- event_context is the serialized context that is passed to the
+        -- action of the next state.
+        event_context BLOB,
+
+        -- transition_timestamp is the time at which the transition was
+        -- recorded.
+        transition_timestamp TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS fsm_transitions_machine_idx ON fsm_transitions(machine_kind, machine_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS loopin_presigned_timeout_txs_swap_hash_idx;
+DROP TABLE IF EXISTS loopin_presigned_timeout_txs;
@@ -0,0 +1,23 @@
+-- loopin_presigned_timeout_txs stores timeout transactions of loop in htlcs
+-- that are signed in advance at different fee rates. They can be handed to a
+-- third party that publishes them once the htlc expired.
+CREATE TABLE IF NOT EXISTS loopin_presigned_timeout_txs (
+        -- id is the autoincrementing primary key.
+        id INTEGER PRIMARY KEY,
+
+        -- swap_hash is the hash of the loop in swap the htlc belongs to.
+        swap_hash BLOB NOT NULL REFERENCES loopin_swaps(swap_hash),
+
+        -- sat_per_vbyte is the fee rate the transaction was signed at.
+        sat_per_vbyte BIGINT NOT NULL,
+
+        -- fee is the on-chain fee paid by the transaction.
+        fee BIGINT NOT NULL,
+
+        -- raw_tx is the serialized signed transaction.
+        raw_tx BLOB NOT NULL,
+
+        UNIQUE (swap_hash, sat_per_vbyte)
+);
+
+CREATE INDEX IF NOT EXISTS loopin_presigned_timeout_txs_swap_hash_idx ON loopin_presigned_timeout_txs(swap_hash);
@@ -9,6 +9,17 @@ import (
 	"time"
 )
 
+type FsmTransition struct {
+	ID                  int32
+	MachineKind         string
+	MachineID           string
+	PreviousState       string
+	NextState           string
+	Event               string
+	EventContext        []byte
+	TransitionTimestamp time.Time
+}
+
 type HtlcKey struct {
 	SwapHash               []byte
 	SenderScriptPubkey     []byte
@@ -19,6 +30,17 @@ type HtlcKey struct {
 	ClientKeyIndex         int32
 }
 
+type InstantoutChangeReservation struct {
+	SwapHash        []byte
+	ReservationID   []byte
+	ClientPubkey    []byte
+	ServerPubkey    []byte
+	Expiry          int32
+	Value           int64
+	ClientKeyFamily int32
+	ClientKeyIndex  int32
+}
+
 type InstantoutSwap struct {
 	SwapHash                  []byte
 	Preimage                  []byte
@@ -33,6 +55,14 @@ type InstantoutSwap struct {
 	SweepConfirmationHeight   sql.NullInt32
 }
 
+type InstantoutSweepOutput struct {
+	ID          int32
+	SwapHash    []byte
+	OutputIndex int32
+	Address     string
+	Amount      int64
+}
+
 type InstantoutUpdate struct {
 	ID              int32
 	SwapHash        []byte
@@ -45,6 +75,14 @@ type LiquidityParam struct {
 	Params []byte
 }
 
+type LoopinPresignedTimeoutTx struct {
+	ID          int32
+	SwapHash    []byte
+	SatPerVbyte int64
+	Fee         int64
+	RawTx       []byte
+}
+
 type LoopinSwap struct {
 	SwapHash       []byte
 	HtlcConfTarget int32
@@ -53,18 +91,24 @@ type LoopinSwap struct {
 }
 
 type LoopoutSwap struct {
-	SwapHash            []byte
-	DestAddress         string
-	SwapInvoice         string
-	MaxSwapRoutingFee   int64
-	SweepConfTarget     int32
-	HtlcConfirmations   int32
-	OutgoingChanSet     string
-	PrepayInvoice       string
-	MaxPrepayRoutingFee int64
-	PublicationDeadline time.Time
-	SingleSweep         bool
-	PaymentTimeout      int32
+	SwapHash                []byte
+	DestAddress             string
+	SwapInvoice             string
+	MaxSwapRoutingFee       int64
+	SweepConfTarget         int32
+	HtlcConfirmations       int32
+	OutgoingChanSet         string
+	PrepayInvoice           string
+	MaxPrepayRoutingFee     int64
+	PublicationDeadline     time.Time
+	SingleSweep             bool
+	PaymentTimeout          int32
+	SwapRouteHops           string
+	SwapRouteIncludeNodes   string
+	SwapRouteExcludeNodes   string
+	PrepayRouteHops         string
+	PrepayRouteIncludeNodes string
+	PrepayRouteExcludeNodes string
 }
 
 type MigrationTracker struct {
@@ -85,6 +129,8 @@ type Reservation struct {
 	TxHash             []byte
 	OutIndex           sql.NullInt32
 	ConfirmationHeight sql.NullInt32
+	SpendTxid          []byte
+	SpendHeight        sql.NullInt32
 }
 
 type ReservationUpdate struct {
@@ -6,45 +6,62 @@ package sqlc
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
 	ConfirmBatch(ctx context.Context, id int32) error
 	CreateReservation(ctx context.Context, arg CreateReservationParams) error
+	DeleteEarlierSwapUpdates(ctx context.Context, arg DeleteEarlierSwapUpdatesParams) (int64, error)
+	DeletePresignedTimeoutTxs(ctx context.Context, swapHash []byte) error
 	DropBatch(ctx context.Context, id int32) error
 	FetchLiquidityParams(ctx context.Context) ([]byte, error)
 	GetBatchSweeps(ctx context.Context, batchID int32) ([]Sweep, error)
 	GetBatchSweptAmount(ctx context.Context, batchID int32) (int64, error)
+	GetFsmTransitions(ctx context.Context, arg GetFsmTransitionsParams) ([]FsmTransition, error)
+	GetInstantOutChangeReservation(ctx context.Context, swapHash []byte) (InstantoutChangeReservation, error)
 	GetInstantOutSwap(ctx context.Context, swapHash []byte) (GetInstantOutSwapRow, error)
 	GetInstantOutSwapUpdates(ctx context.Context, swapHash []byte) ([]InstantoutUpdate, error)
 	GetInstantOutSwaps(ctx context.Context) ([]GetInstantOutSwapsRow, error)
+	GetInstantOutSweepOutputs(ctx context.Context, swapHash []byte) ([]InstantoutSweepOutput, error)
+	GetLastFsmTransitions(ctx context.Context, machineKind string) ([]FsmTransition, error)
+	GetLastSwapUpdatesBefore(ctx context.Context, updateTimestamp time.Time) ([]SwapUpdate, error)
 	GetLastUpdateID(ctx context.Context, swapHash []byte) (int32, error)
 	GetLoopInSwap(ctx context.Context, swapHash []byte) (GetLoopInSwapRow, error)
 	GetLoopInSwaps(ctx context.Context) ([]GetLoopInSwapsRow, error)
+	GetLoopOutChannelSets(ctx context.Context) ([]string, error)
 	GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopOutSwapRow, error)
 	GetLoopOutSwaps(ctx context.Context) ([]GetLoopOutSwapsRow, error)
 	GetMigration(ctx context.Context, migrationID string) (MigrationTracker, error)
 	GetParentBatch(ctx context.Context, swapHash []byte) (SweepBatch, error)
+	GetPresignedTimeoutTxs(ctx context.Context, swapHash []byte) ([]LoopinPresignedTimeoutTx, error)
 	GetReservation(ctx context.Context, reservationID []byte) (Reservation, error)
 	GetReservationUpdates(ctx context.Context, reservationID []byte) ([]ReservationUpdate, error)
 	GetReservations(ctx context.Context) ([]Reservation, error)
 	GetSwapUpdates(ctx context.Context, swapHash []byte) ([]SwapUpdate, error)
+	GetSweepBatches(ctx context.Context) ([]SweepBatch, error)
 	GetSweepStatus(ctx context.Context, swapHash []byte) (bool, error)
 	GetUnconfirmedBatches(ctx context.Context) ([]SweepBatch, error)
 	InsertBatch(ctx context.Context, arg InsertBatchParams) (int32, error)
+	InsertFsmTransition(ctx context.Context, arg InsertFsmTransitionParams) error
 	InsertHtlcKeys(ctx context.Context, arg InsertHtlcKeysParams) error
 	InsertInstantOut(ctx context.Context, arg InsertInstantOutParams) error
+	InsertInstantOutChangeReservation(ctx context.Context, arg InsertInstantOutChangeReservationParams) error
+	InsertInstantOutSweepOutput(ctx context.Context, arg InsertInstantOutSweepOutputParams) error
 	InsertInstantOutUpdate(ctx context.Context, arg InsertInstantOutUpdateParams) error
 	InsertLoopIn(ctx context.Context, arg InsertLoopInParams) error
 	InsertLoopOut(ctx context.Context, arg InsertLoopOutParams) error
 	InsertMigration(ctx context.Context, arg InsertMigrationParams) error
+	InsertPresignedTimeoutTx(ctx context.Context, arg InsertPresignedTimeoutTxParams) error
 	InsertReservationUpdate(ctx context.Context, arg InsertReservationUpdateParams) error
 	InsertSwap(ctx context.Context, arg InsertSwapParams) error
 	InsertSwapUpdate(ctx context.Context, arg InsertSwapUpdateParams) error
 	OverrideSwapCosts(ctx context.Context, arg OverrideSwapCostsParams) error
+	QuerySwapHashes(ctx context.Context, arg QuerySwapHashesParams) ([]QuerySwapHashesRow, error)
 	UpdateBatch(ctx context.Context, arg UpdateBatchParams) error
 	UpdateInstantOut(ctx context.Context, arg UpdateInstantOutParams) error
 	UpdateReservation(ctx context.Context, arg UpdateReservationParams) error
+	UpdateSwapUpdateHtlcTxHash(ctx context.Context, arg UpdateSwapUpdateHtlcTxHashParams) error
 	UpsertLiquidityParams(ctx context.Context, params []byte) error
 	UpsertSweep(ctx context.Context, arg UpsertSweepParams) error
 }
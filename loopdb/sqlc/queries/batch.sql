@@ -6,6 +6,14 @@ FROM
 WHERE
         confirmed = FALSE;
 
+-- name: GetSweepBatches :many
+SELECT
+        *
+FROM
+        sweep_batches
+ORDER BY
+        id ASC;
+
 -- name: InsertBatch :one
 INSERT INTO sweep_batches (
         confirmed,
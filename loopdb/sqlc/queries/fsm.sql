@@ -0,0 +1,51 @@
+-- name: InsertFsmTransition :exec
+INSERT INTO fsm_transitions (
+        machine_kind,
+        machine_id,
+        previous_state,
+        next_state,
+        event,
+        event_context,
+        transition_timestamp
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7
+);
+
+-- name: GetFsmTransitions :many
+SELECT
+    fsm_transitions.*
+FROM
+    fsm_transitions
+WHERE
+    fsm_transitions.machine_kind = $1
+AND
+    fsm_transitions.machine_id = $2
+ORDER BY
+    fsm_transitions.id;
+
+-- name: GetLastFsmTransitions :many
+SELECT
+    fsm_transitions.*
+FROM
+    fsm_transitions
+WHERE
+    fsm_transitions.machine_kind = $1
+AND
+    fsm_transitions.id = (
+        SELECT
+            MAX(latest.id)
+        FROM
+            fsm_transitions AS latest
+        WHERE
+            latest.machine_kind = fsm_transitions.machine_kind
+        AND
+            latest.machine_id = fsm_transitions.machine_id
+    )
+ORDER BY
+    fsm_transitions.id;
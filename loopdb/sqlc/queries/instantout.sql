@@ -73,3 +73,55 @@ FROM
     instantout_updates
 WHERE
     instantout_updates.swap_hash = $1;
+
+-- name: InsertInstantOutChangeReservation :exec
+INSERT INTO instantout_change_reservations (
+        swap_hash,
+        reservation_id,
+        client_pubkey,
+        server_pubkey,
+        expiry,
+        value,
+        client_key_family,
+        client_key_index
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8
+);
+
+-- name: GetInstantOutChangeReservation :one
+SELECT
+    instantout_change_reservations.*
+FROM
+    instantout_change_reservations
+WHERE
+    instantout_change_reservations.swap_hash = $1;
+
+-- name: InsertInstantOutSweepOutput :exec
+INSERT INTO instantout_sweep_outputs (
+        swap_hash,
+        output_index,
+        address,
+        amount
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4
+);
+
+-- name: GetInstantOutSweepOutputs :many
+SELECT
+    instantout_sweep_outputs.*
+FROM
+    instantout_sweep_outputs
+WHERE
+    instantout_sweep_outputs.swap_hash = $1
+ORDER BY
+    instantout_sweep_outputs.output_index;
@@ -24,7 +24,9 @@ UPDATE reservations
 SET
     tx_hash = $2,
     out_index = $3,
-    confirmation_height = $4
+    confirmation_height = $4,
+    spend_txid = $5,
+    spend_height = $6
 WHERE
     reservations.reservation_id = $1;
 
@@ -106,9 +106,16 @@ INSERT INTO loopout_swaps (
     max_prepay_routing_fee,
     publication_deadline,
     single_sweep,
-    payment_timeout
+    payment_timeout,
+    swap_route_hops,
+    swap_route_include_nodes,
+    swap_route_exclude_nodes,
+    prepay_route_hops,
+    prepay_route_include_nodes,
+    prepay_route_exclude_nodes
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+    $17, $18
 );
 
 -- name: InsertLoopIn :exec
@@ -149,3 +156,133 @@ SET
     offchain_cost = $4
 WHERE id = $1;
 
+
+-- name: InsertPresignedTimeoutTx :exec
+INSERT INTO loopin_presigned_timeout_txs (
+    swap_hash,
+    sat_per_vbyte,
+    fee,
+    raw_tx
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: GetPresignedTimeoutTxs :many
+SELECT
+    *
+FROM
+    loopin_presigned_timeout_txs
+WHERE
+    swap_hash = $1
+ORDER BY
+    sat_per_vbyte;
+
+-- name: DeletePresignedTimeoutTxs :exec
+DELETE FROM loopin_presigned_timeout_txs
+WHERE swap_hash = $1;
+
+-- name: GetLastSwapUpdatesBefore :many
+SELECT
+    swap_updates.*
+FROM
+    swap_updates
+WHERE
+    swap_updates.id = (
+        SELECT
+            MAX(latest.id)
+        FROM
+            swap_updates AS latest
+        WHERE
+            latest.swap_hash = swap_updates.swap_hash
+    )
+AND
+    swap_updates.update_timestamp < $1
+ORDER BY
+    swap_updates.id;
+
+-- name: DeleteEarlierSwapUpdates :execrows
+DELETE FROM swap_updates
+WHERE swap_hash = $1 AND id < $2;
+
+-- name: UpdateSwapUpdateHtlcTxHash :exec
+UPDATE swap_updates
+SET htlc_txhash = $2
+WHERE id = $1;
+
+-- name: QuerySwapHashes :many
+SELECT
+    swaps.swap_hash,
+    loopout_swaps.swap_hash IS NOT NULL AS loop_out
+FROM
+    swaps
+LEFT JOIN
+    loopout_swaps ON swaps.swap_hash = loopout_swaps.swap_hash
+LEFT JOIN
+    loopin_swaps ON swaps.swap_hash = loopin_swaps.swap_hash
+LEFT JOIN
+    swap_updates ON swap_updates.id = (
+        SELECT
+            MAX(latest.id)
+        FROM
+            swap_updates AS latest
+        WHERE
+            latest.swap_hash = swaps.swap_hash
+    )
+WHERE
+    (
+        (sqlc.arg(loop_out) AND loopout_swaps.swap_hash IS NOT NULL) OR
+        (sqlc.arg(loop_in) AND loopin_swaps.swap_hash IS NOT NULL)
+    )
+AND
+    (
+        sqlc.arg(state_mask) &
+        (1 << COALESCE(swap_updates.update_state, 0))
+    ) <> 0
+AND
+    swaps.initiation_time >= sqlc.arg(start_time)
+AND
+    swaps.initiation_time < sqlc.arg(end_time)
+AND
+    swaps.amount_requested >= sqlc.arg(min_amount)
+AND
+    swaps.amount_requested <= sqlc.arg(max_amount)
+AND
+    (
+        REPLACE(swaps.label, sqlc.arg(label), '') <> swaps.label OR
+        sqlc.arg(label) = ''
+    )
+AND
+    (
+        loopout_swaps.swap_hash IS NULL OR
+        REPLACE(
+            sqlc.arg(outgoing_chan_sets),
+            '|' || loopout_swaps.outgoing_chan_set || '|', ''
+        ) <> sqlc.arg(outgoing_chan_sets) OR
+        sqlc.arg(outgoing_chan_sets) = ''
+    )
+AND
+    (
+        loopin_swaps.swap_hash IS NULL OR
+        loopin_swaps.last_hop = sqlc.arg(last_hop) OR
+        COALESCE(LENGTH(sqlc.arg(last_hop)), 0) = 0
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(sort_by_amount) AND NOT sqlc.arg(descending)
+        THEN swaps.amount_requested END ASC,
+    CASE WHEN sqlc.arg(sort_by_amount) AND sqlc.arg(descending)
+        THEN swaps.amount_requested END DESC,
+    CASE WHEN NOT sqlc.arg(descending)
+        THEN swaps.initiation_time END ASC,
+    CASE WHEN sqlc.arg(descending)
+        THEN swaps.initiation_time END DESC,
+    CASE WHEN NOT sqlc.arg(descending) THEN swaps.id END ASC,
+    CASE WHEN sqlc.arg(descending) THEN swaps.id END DESC
+LIMIT sqlc.arg(max_swaps) OFFSET sqlc.arg(skip_swaps);
+
+-- name: GetLoopOutChannelSets :many
+SELECT DISTINCT
+    outgoing_chan_set
+FROM
+    loopout_swaps
+WHERE
+    outgoing_chan_set <> '';
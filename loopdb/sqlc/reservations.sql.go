@@ -60,7 +60,7 @@ func (q *Queries) CreateReservation(ctx context.Context, arg CreateReservationPa
 
 const getReservation = `-- name: GetReservation :one
 SELECT
-    id, reservation_id, client_pubkey, server_pubkey, expiry, value, client_key_family, client_key_index, initiation_height, tx_hash, out_index, confirmation_height
+    id, reservation_id, client_pubkey, server_pubkey, expiry, value, client_key_family, client_key_index, initiation_height, tx_hash, out_index, confirmation_height, spend_txid, spend_height
 FROM
     reservations
 WHERE
@@ -83,6 +83,8 @@ func (q *Queries) GetReservation(ctx context.Context, reservationID []byte) (Res
 		&i.TxHash,
 		&i.OutIndex,
 		&i.ConfirmationHeight,
+		&i.SpendTxid,
+		&i.SpendHeight,
 	)
 	return i, err
 }
@@ -128,7 +130,7 @@ func (q *Queries) GetReservationUpdates(ctx context.Context, reservationID []byt
 
 const getReservations = `-- name: GetReservations :many
 SELECT
-    id, reservation_id, client_pubkey, server_pubkey, expiry, value, client_key_family, client_key_index, initiation_height, tx_hash, out_index, confirmation_height
+    id, reservation_id, client_pubkey, server_pubkey, expiry, value, client_key_family, client_key_index, initiation_height, tx_hash, out_index, confirmation_height, spend_txid, spend_height
 FROM
     reservations
 ORDER BY
@@ -157,6 +159,8 @@ func (q *Queries) GetReservations(ctx context.Context) ([]Reservation, error) {
 			&i.TxHash,
 			&i.OutIndex,
 			&i.ConfirmationHeight,
+			&i.SpendTxid,
+			&i.SpendHeight,
 		); err != nil {
 			return nil, err
 		}
@@ -199,7 +203,9 @@ UPDATE reservations
 SET
     tx_hash = $2,
     out_index = $3,
-    confirmation_height = $4
+    confirmation_height = $4,
+    spend_txid = $5,
+    spend_height = $6
 WHERE
     reservations.reservation_id = $1
 `
@@ -209,6 +215,8 @@ type UpdateReservationParams struct {
 	TxHash             []byte
 	OutIndex           sql.NullInt32
 	ConfirmationHeight sql.NullInt32
+	SpendTxid          []byte
+	SpendHeight        sql.NullInt32
 }
 
 func (q *Queries) UpdateReservation(ctx context.Context, arg UpdateReservationParams) error {
@@ -217,6 +225,8 @@ func (q *Queries) UpdateReservation(ctx context.Context, arg UpdateReservationPa
 		arg.TxHash,
 		arg.OutIndex,
 		arg.ConfirmationHeight,
+		arg.SpendTxid,
+		arg.SpendHeight,
 	)
 	return err
 }
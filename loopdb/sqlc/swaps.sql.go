@@ -10,6 +10,86 @@ import (
 	"time"
 )
 
+const deleteEarlierSwapUpdates = `-- name: DeleteEarlierSwapUpdates :execrows
+DELETE FROM swap_updates
+WHERE swap_hash = $1 AND id < $2
+`
+
+type DeleteEarlierSwapUpdatesParams struct {
+	SwapHash []byte
+	ID       int32
+}
+
+func (q *Queries) DeleteEarlierSwapUpdates(ctx context.Context, arg DeleteEarlierSwapUpdatesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteEarlierSwapUpdates, arg.SwapHash, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deletePresignedTimeoutTxs = `-- name: DeletePresignedTimeoutTxs :exec
+DELETE FROM loopin_presigned_timeout_txs
+WHERE swap_hash = $1
+`
+
+func (q *Queries) DeletePresignedTimeoutTxs(ctx context.Context, swapHash []byte) error {
+	_, err := q.db.ExecContext(ctx, deletePresignedTimeoutTxs, swapHash)
+	return err
+}
+
+const getLastSwapUpdatesBefore = `-- name: GetLastSwapUpdatesBefore :many
+SELECT
+    swap_updates.id, swap_updates.swap_hash, swap_updates.update_timestamp, swap_updates.update_state, swap_updates.htlc_txhash, swap_updates.server_cost, swap_updates.onchain_cost, swap_updates.offchain_cost
+FROM
+    swap_updates
+WHERE
+    swap_updates.id = (
+        SELECT
+            MAX(latest.id)
+        FROM
+            swap_updates AS latest
+        WHERE
+            latest.swap_hash = swap_updates.swap_hash
+    )
+AND
+    swap_updates.update_timestamp < $1
+ORDER BY
+    swap_updates.id
+`
+
+func (q *Queries) GetLastSwapUpdatesBefore(ctx context.Context, updateTimestamp time.Time) ([]SwapUpdate, error) {
+	rows, err := q.db.QueryContext(ctx, getLastSwapUpdatesBefore, updateTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SwapUpdate
+	for rows.Next() {
+		var i SwapUpdate
+		if err := rows.Scan(
+			&i.ID,
+			&i.SwapHash,
+			&i.UpdateTimestamp,
+			&i.UpdateState,
+			&i.HtlcTxhash,
+			&i.ServerCost,
+			&i.OnchainCost,
+			&i.OffchainCost,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getLastUpdateID = `-- name: GetLastUpdateID :one
 SELECT id
 FROM swap_updates
@@ -181,10 +261,42 @@ func (q *Queries) GetLoopInSwaps(ctx context.Context) ([]GetLoopInSwapsRow, erro
 	return items, nil
 }
 
+const getLoopOutChannelSets = `-- name: GetLoopOutChannelSets :many
+SELECT DISTINCT
+    outgoing_chan_set
+FROM
+    loopout_swaps
+WHERE
+    outgoing_chan_set <> ''
+`
+
+func (q *Queries) GetLoopOutChannelSets(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getLoopOutChannelSets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var outgoing_chan_set string
+		if err := rows.Scan(&outgoing_chan_set); err != nil {
+			return nil, err
+		}
+		items = append(items, outgoing_chan_set)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getLoopOutSwap = `-- name: GetLoopOutSwap :one
 SELECT
     swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
-    loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep, loopout_swaps.payment_timeout,
+    loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep, loopout_swaps.payment_timeout, loopout_swaps.swap_route_hops, loopout_swaps.swap_route_include_nodes, loopout_swaps.swap_route_exclude_nodes, loopout_swaps.prepay_route_hops, loopout_swaps.prepay_route_include_nodes, loopout_swaps.prepay_route_exclude_nodes,
     htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM
     swaps
@@ -197,36 +309,42 @@ WHERE
 `
 
 type GetLoopOutSwapRow struct {
-	ID                     int32
-	SwapHash               []byte
-	Preimage               []byte
-	InitiationTime         time.Time
-	AmountRequested        int64
-	CltvExpiry             int32
-	MaxMinerFee            int64
-	MaxSwapFee             int64
-	InitiationHeight       int32
-	ProtocolVersion        int32
-	Label                  string
-	SwapHash_2             []byte
-	DestAddress            string
-	SwapInvoice            string
-	MaxSwapRoutingFee      int64
-	SweepConfTarget        int32
-	HtlcConfirmations      int32
-	OutgoingChanSet        string
-	PrepayInvoice          string
-	MaxPrepayRoutingFee    int64
-	PublicationDeadline    time.Time
-	SingleSweep            bool
-	PaymentTimeout         int32
-	SwapHash_3             []byte
-	SenderScriptPubkey     []byte
-	ReceiverScriptPubkey   []byte
-	SenderInternalPubkey   []byte
-	ReceiverInternalPubkey []byte
-	ClientKeyFamily        int32
-	ClientKeyIndex         int32
+	ID                      int32
+	SwapHash                []byte
+	Preimage                []byte
+	InitiationTime          time.Time
+	AmountRequested         int64
+	CltvExpiry              int32
+	MaxMinerFee             int64
+	MaxSwapFee              int64
+	InitiationHeight        int32
+	ProtocolVersion         int32
+	Label                   string
+	SwapHash_2              []byte
+	DestAddress             string
+	SwapInvoice             string
+	MaxSwapRoutingFee       int64
+	SweepConfTarget         int32
+	HtlcConfirmations       int32
+	OutgoingChanSet         string
+	PrepayInvoice           string
+	MaxPrepayRoutingFee     int64
+	PublicationDeadline     time.Time
+	SingleSweep             bool
+	PaymentTimeout          int32
+	SwapRouteHops           string
+	SwapRouteIncludeNodes   string
+	SwapRouteExcludeNodes   string
+	PrepayRouteHops         string
+	PrepayRouteIncludeNodes string
+	PrepayRouteExcludeNodes string
+	SwapHash_3              []byte
+	SenderScriptPubkey      []byte
+	ReceiverScriptPubkey    []byte
+	SenderInternalPubkey    []byte
+	ReceiverInternalPubkey  []byte
+	ClientKeyFamily         int32
+	ClientKeyIndex          int32
 }
 
 func (q *Queries) GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopOutSwapRow, error) {
@@ -256,6 +374,12 @@ func (q *Queries) GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopO
 		&i.PublicationDeadline,
 		&i.SingleSweep,
 		&i.PaymentTimeout,
+		&i.SwapRouteHops,
+		&i.SwapRouteIncludeNodes,
+		&i.SwapRouteExcludeNodes,
+		&i.PrepayRouteHops,
+		&i.PrepayRouteIncludeNodes,
+		&i.PrepayRouteExcludeNodes,
 		&i.SwapHash_3,
 		&i.SenderScriptPubkey,
 		&i.ReceiverScriptPubkey,
@@ -270,7 +394,7 @@ func (q *Queries) GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopO
 const getLoopOutSwaps = `-- name: GetLoopOutSwaps :many
 SELECT
     swaps.id, swaps.swap_hash, swaps.preimage, swaps.initiation_time, swaps.amount_requested, swaps.cltv_expiry, swaps.max_miner_fee, swaps.max_swap_fee, swaps.initiation_height, swaps.protocol_version, swaps.label,
-    loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep, loopout_swaps.payment_timeout,
+    loopout_swaps.swap_hash, loopout_swaps.dest_address, loopout_swaps.swap_invoice, loopout_swaps.max_swap_routing_fee, loopout_swaps.sweep_conf_target, loopout_swaps.htlc_confirmations, loopout_swaps.outgoing_chan_set, loopout_swaps.prepay_invoice, loopout_swaps.max_prepay_routing_fee, loopout_swaps.publication_deadline, loopout_swaps.single_sweep, loopout_swaps.payment_timeout, loopout_swaps.swap_route_hops, loopout_swaps.swap_route_include_nodes, loopout_swaps.swap_route_exclude_nodes, loopout_swaps.prepay_route_hops, loopout_swaps.prepay_route_include_nodes, loopout_swaps.prepay_route_exclude_nodes,
     htlc_keys.swap_hash, htlc_keys.sender_script_pubkey, htlc_keys.receiver_script_pubkey, htlc_keys.sender_internal_pubkey, htlc_keys.receiver_internal_pubkey, htlc_keys.client_key_family, htlc_keys.client_key_index
 FROM
     swaps
@@ -283,36 +407,42 @@ ORDER BY
 `
 
 type GetLoopOutSwapsRow struct {
-	ID                     int32
-	SwapHash               []byte
-	Preimage               []byte
-	InitiationTime         time.Time
-	AmountRequested        int64
-	CltvExpiry             int32
-	MaxMinerFee            int64
-	MaxSwapFee             int64
-	InitiationHeight       int32
-	ProtocolVersion        int32
-	Label                  string
-	SwapHash_2             []byte
-	DestAddress            string
-	SwapInvoice            string
-	MaxSwapRoutingFee      int64
-	SweepConfTarget        int32
-	HtlcConfirmations      int32
-	OutgoingChanSet        string
-	PrepayInvoice          string
-	MaxPrepayRoutingFee    int64
-	PublicationDeadline    time.Time
-	SingleSweep            bool
-	PaymentTimeout         int32
-	SwapHash_3             []byte
-	SenderScriptPubkey     []byte
-	ReceiverScriptPubkey   []byte
-	SenderInternalPubkey   []byte
-	ReceiverInternalPubkey []byte
-	ClientKeyFamily        int32
-	ClientKeyIndex         int32
+	ID                      int32
+	SwapHash                []byte
+	Preimage                []byte
+	InitiationTime          time.Time
+	AmountRequested         int64
+	CltvExpiry              int32
+	MaxMinerFee             int64
+	MaxSwapFee              int64
+	InitiationHeight        int32
+	ProtocolVersion         int32
+	Label                   string
+	SwapHash_2              []byte
+	DestAddress             string
+	SwapInvoice             string
+	MaxSwapRoutingFee       int64
+	SweepConfTarget         int32
+	HtlcConfirmations       int32
+	OutgoingChanSet         string
+	PrepayInvoice           string
+	MaxPrepayRoutingFee     int64
+	PublicationDeadline     time.Time
+	SingleSweep             bool
+	PaymentTimeout          int32
+	SwapRouteHops           string
+	SwapRouteIncludeNodes   string
+	SwapRouteExcludeNodes   string
+	PrepayRouteHops         string
+	PrepayRouteIncludeNodes string
+	PrepayRouteExcludeNodes string
+	SwapHash_3              []byte
+	SenderScriptPubkey      []byte
+	ReceiverScriptPubkey    []byte
+	SenderInternalPubkey    []byte
+	ReceiverInternalPubkey  []byte
+	ClientKeyFamily         int32
+	ClientKeyIndex          int32
 }
 
 func (q *Queries) GetLoopOutSwaps(ctx context.Context) ([]GetLoopOutSwapsRow, error) {
@@ -348,6 +478,12 @@ func (q *Queries) GetLoopOutSwaps(ctx context.Context) ([]GetLoopOutSwapsRow, er
 			&i.PublicationDeadline,
 			&i.SingleSweep,
 			&i.PaymentTimeout,
+			&i.SwapRouteHops,
+			&i.SwapRouteIncludeNodes,
+			&i.SwapRouteExcludeNodes,
+			&i.PrepayRouteHops,
+			&i.PrepayRouteIncludeNodes,
+			&i.PrepayRouteExcludeNodes,
 			&i.SwapHash_3,
 			&i.SenderScriptPubkey,
 			&i.ReceiverScriptPubkey,
@@ -369,6 +505,46 @@ func (q *Queries) GetLoopOutSwaps(ctx context.Context) ([]GetLoopOutSwapsRow, er
 	return items, nil
 }
 
+const getPresignedTimeoutTxs = `-- name: GetPresignedTimeoutTxs :many
+SELECT
+    id, swap_hash, sat_per_vbyte, fee, raw_tx
+FROM
+    loopin_presigned_timeout_txs
+WHERE
+    swap_hash = $1
+ORDER BY
+    sat_per_vbyte
+`
+
+func (q *Queries) GetPresignedTimeoutTxs(ctx context.Context, swapHash []byte) ([]LoopinPresignedTimeoutTx, error) {
+	rows, err := q.db.QueryContext(ctx, getPresignedTimeoutTxs, swapHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LoopinPresignedTimeoutTx
+	for rows.Next() {
+		var i LoopinPresignedTimeoutTx
+		if err := rows.Scan(
+			&i.ID,
+			&i.SwapHash,
+			&i.SatPerVbyte,
+			&i.Fee,
+			&i.RawTx,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSwapUpdates = `-- name: GetSwapUpdates :many
 SELECT
     id, swap_hash, update_timestamp, update_state, htlc_txhash, server_cost, onchain_cost, offchain_cost
@@ -490,25 +666,38 @@ INSERT INTO loopout_swaps (
     max_prepay_routing_fee,
     publication_deadline,
     single_sweep,
-    payment_timeout
+    payment_timeout,
+    swap_route_hops,
+    swap_route_include_nodes,
+    swap_route_exclude_nodes,
+    prepay_route_hops,
+    prepay_route_include_nodes,
+    prepay_route_exclude_nodes
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+    $17, $18
 )
 `
 
 type InsertLoopOutParams struct {
-	SwapHash            []byte
-	DestAddress         string
-	SwapInvoice         string
-	MaxSwapRoutingFee   int64
-	SweepConfTarget     int32
-	HtlcConfirmations   int32
-	OutgoingChanSet     string
-	PrepayInvoice       string
-	MaxPrepayRoutingFee int64
-	PublicationDeadline time.Time
-	SingleSweep         bool
-	PaymentTimeout      int32
+	SwapHash                []byte
+	DestAddress             string
+	SwapInvoice             string
+	MaxSwapRoutingFee       int64
+	SweepConfTarget         int32
+	HtlcConfirmations       int32
+	OutgoingChanSet         string
+	PrepayInvoice           string
+	MaxPrepayRoutingFee     int64
+	PublicationDeadline     time.Time
+	SingleSweep             bool
+	PaymentTimeout          int32
+	SwapRouteHops           string
+	SwapRouteIncludeNodes   string
+	SwapRouteExcludeNodes   string
+	PrepayRouteHops         string
+	PrepayRouteIncludeNodes string
+	PrepayRouteExcludeNodes string
 }
 
 func (q *Queries) InsertLoopOut(ctx context.Context, arg InsertLoopOutParams) error {
@@ -525,6 +714,40 @@ func (q *Queries) InsertLoopOut(ctx context.Context, arg InsertLoopOutParams) er
 		arg.PublicationDeadline,
 		arg.SingleSweep,
 		arg.PaymentTimeout,
+		arg.SwapRouteHops,
+		arg.SwapRouteIncludeNodes,
+		arg.SwapRouteExcludeNodes,
+		arg.PrepayRouteHops,
+		arg.PrepayRouteIncludeNodes,
+		arg.PrepayRouteExcludeNodes,
+	)
+	return err
+}
+
+const insertPresignedTimeoutTx = `-- name: InsertPresignedTimeoutTx :exec
+INSERT INTO loopin_presigned_timeout_txs (
+    swap_hash,
+    sat_per_vbyte,
+    fee,
+    raw_tx
+) VALUES (
+    $1, $2, $3, $4
+)
+`
+
+type InsertPresignedTimeoutTxParams struct {
+	SwapHash    []byte
+	SatPerVbyte int64
+	Fee         int64
+	RawTx       []byte
+}
+
+func (q *Queries) InsertPresignedTimeoutTx(ctx context.Context, arg InsertPresignedTimeoutTxParams) error {
+	_, err := q.db.ExecContext(ctx, insertPresignedTimeoutTx,
+		arg.SwapHash,
+		arg.SatPerVbyte,
+		arg.Fee,
+		arg.RawTx,
 	)
 	return err
 }
@@ -637,3 +860,150 @@ func (q *Queries) OverrideSwapCosts(ctx context.Context, arg OverrideSwapCostsPa
 	)
 	return err
 }
+
+const querySwapHashes = `-- name: QuerySwapHashes :many
+SELECT
+    swaps.swap_hash,
+    loopout_swaps.swap_hash IS NOT NULL AS loop_out
+FROM
+    swaps
+LEFT JOIN
+    loopout_swaps ON swaps.swap_hash = loopout_swaps.swap_hash
+LEFT JOIN
+    loopin_swaps ON swaps.swap_hash = loopin_swaps.swap_hash
+LEFT JOIN
+    swap_updates ON swap_updates.id = (
+        SELECT
+            MAX(latest.id)
+        FROM
+            swap_updates AS latest
+        WHERE
+            latest.swap_hash = swaps.swap_hash
+    )
+WHERE
+    (
+        ($1 AND loopout_swaps.swap_hash IS NOT NULL) OR
+        ($2 AND loopin_swaps.swap_hash IS NOT NULL)
+    )
+AND
+    (
+        $3 &
+        (1 << COALESCE(swap_updates.update_state, 0))
+    ) <> 0
+AND
+    swaps.initiation_time >= $4
+AND
+    swaps.initiation_time < $5
+AND
+    swaps.amount_requested >= $6
+AND
+    swaps.amount_requested <= $7
+AND
+    (
+        REPLACE(swaps.label, $8, '') <> swaps.label OR
+        $8 = ''
+    )
+AND
+    (
+        loopout_swaps.swap_hash IS NULL OR
+        REPLACE(
+            $9,
+            '|' || loopout_swaps.outgoing_chan_set || '|', ''
+        ) <> $9 OR
+        $9 = ''
+    )
+AND
+    (
+        loopin_swaps.swap_hash IS NULL OR
+        loopin_swaps.last_hop = $10 OR
+        COALESCE(LENGTH($10), 0) = 0
+    )
+ORDER BY
+    CASE WHEN $11 AND NOT $12
+        THEN swaps.amount_requested END ASC,
+    CASE WHEN $11 AND $12
+        THEN swaps.amount_requested END DESC,
+    CASE WHEN NOT $12
+        THEN swaps.initiation_time END ASC,
+    CASE WHEN $12
+        THEN swaps.initiation_time END DESC,
+    CASE WHEN NOT $12 THEN swaps.id END ASC,
+    CASE WHEN $12 THEN swaps.id END DESC
+LIMIT $13 OFFSET $14
+`
+
+type QuerySwapHashesParams struct {
+	LoopOut          bool
+	LoopIn           bool
+	StateMask        int64
+	StartTime        time.Time
+	EndTime          time.Time
+	MinAmount        int64
+	MaxAmount        int64
+	Label            string
+	OutgoingChanSets string
+	LastHop          []byte
+	SortByAmount     bool
+	Descending       bool
+	MaxSwaps         int32
+	SkipSwaps        int32
+}
+
+type QuerySwapHashesRow struct {
+	SwapHash []byte
+	LoopOut  bool
+}
+
+func (q *Queries) QuerySwapHashes(ctx context.Context, arg QuerySwapHashesParams) ([]QuerySwapHashesRow, error) {
+	rows, err := q.db.QueryContext(ctx, querySwapHashes,
+		arg.LoopOut,
+		arg.LoopIn,
+		arg.StateMask,
+		arg.StartTime,
+		arg.EndTime,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Label,
+		arg.OutgoingChanSets,
+		arg.LastHop,
+		arg.SortByAmount,
+		arg.Descending,
+		arg.MaxSwaps,
+		arg.SkipSwaps,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QuerySwapHashesRow
+	for rows.Next() {
+		var i QuerySwapHashesRow
+		if err := rows.Scan(&i.SwapHash, &i.LoopOut); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSwapUpdateHtlcTxHash = `-- name: UpdateSwapUpdateHtlcTxHash :exec
+UPDATE swap_updates
+SET htlc_txhash = $2
+WHERE id = $1
+`
+
+type UpdateSwapUpdateHtlcTxHashParams struct {
+	ID         int32
+	HtlcTxhash string
+}
+
+func (q *Queries) UpdateSwapUpdateHtlcTxHash(ctx context.Context, arg UpdateSwapUpdateHtlcTxHashParams) error {
+	_, err := q.db.ExecContext(ctx, updateSwapUpdateHtlcTxHash, arg.ID, arg.HtlcTxhash)
+	return err
+}
@@ -460,6 +460,37 @@ func (s *boltSwapStore) FetchLoopInSwaps(ctx context.Context) ([]*LoopIn,
 	return swaps, nil
 }
 
+// FetchLoopInSwap returns the loop in swap with the given hash.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchLoopInSwap(ctx context.Context,
+	hash lntypes.Hash) (*LoopIn, error) {
+
+	var swap *LoopIn
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		// First, we'll grab our main loop in bucket key.
+		rootBucket := tx.Bucket(loopInBucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		loop, err := s.fetchLoopInSwap(rootBucket, hash[:])
+		if err != nil {
+			return err
+		}
+
+		swap = loop
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swap, nil
+}
+
 // createLoopBucket creates the bucket for a particular swap.
 func createLoopBucket(tx *bbolt.Tx, swapTypeKey []byte, hash lntypes.Hash) (
 	*bbolt.Bucket, error) {
@@ -1010,6 +1041,22 @@ func (b *boltSwapStore) BatchInsertUpdate(ctx context.Context,
 	return errUnimplemented
 }
 
+// StorePresignedTimeoutTxs stores the presigned timeout transactions of the
+// htlc of a loop in swap.
+func (b *boltSwapStore) StorePresignedTimeoutTxs(ctx context.Context,
+	hash lntypes.Hash, txs []*PresignedTimeoutTx) error {
+
+	return errUnimplemented
+}
+
+// FetchPresignedTimeoutTxs returns the presigned timeout transactions of the
+// htlc of a loop in swap.
+func (b *boltSwapStore) FetchPresignedTimeoutTxs(ctx context.Context,
+	hash lntypes.Hash) ([]*PresignedTimeoutTx, error) {
+
+	return nil, errUnimplemented
+}
+
 // BatchUpdateLoopOutSwapCosts updates the swap costs for a batch of loop out
 // swaps.
 func (b *boltSwapStore) BatchUpdateLoopOutSwapCosts(ctx context.Context,
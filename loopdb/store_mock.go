@@ -27,6 +27,8 @@ type StoreMock struct {
 	loopInStoreChan  chan LoopInContract
 	loopInUpdateChan chan SwapStateData
 
+	PresignedTimeoutTxs map[lntypes.Hash][]*PresignedTimeoutTx
+
 	migrations map[string]struct{}
 
 	t *testing.T
@@ -44,8 +46,13 @@ func NewStoreMock(t *testing.T) *StoreMock {
 		loopInUpdateChan: make(chan SwapStateData, 1),
 		LoopInSwaps:      make(map[lntypes.Hash]*LoopInContract),
 		LoopInUpdates:    make(map[lntypes.Hash][]SwapStateData),
-		migrations:       make(map[string]struct{}),
-		t:                t,
+
+		PresignedTimeoutTxs: make(
+			map[lntypes.Hash][]*PresignedTimeoutTx,
+		),
+
+		migrations: make(map[string]struct{}),
+		t:          t,
 	}
 }
 
@@ -165,6 +172,39 @@ func (s *StoreMock) FetchLoopInSwaps(ctx context.Context) ([]*LoopIn,
 	return result, nil
 }
 
+// FetchLoopInSwap returns the loop in swap with the given hash.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) FetchLoopInSwap(ctx context.Context,
+	hash lntypes.Hash) (*LoopIn, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	contract, ok := s.LoopInSwaps[hash]
+	if !ok {
+		return nil, errors.New("swap not found")
+	}
+
+	updates := s.LoopInUpdates[hash]
+	events := make([]*LoopEvent, len(updates))
+	for i, u := range updates {
+		events[i] = &LoopEvent{
+			SwapStateData: u,
+		}
+	}
+
+	swap := &LoopIn{
+		Loop: Loop{
+			Hash:   hash,
+			Events: events,
+		},
+		Contract: contract,
+	}
+
+	return swap, nil
+}
+
 // CreateLoopIn adds an initiated loop in swap to the store.
 //
 // NOTE: Part of the SwapStore interface.
@@ -390,6 +430,36 @@ func (s *StoreMock) BatchUpdateLoopOutSwapCosts(ctx context.Context,
 	return nil
 }
 
+// StorePresignedTimeoutTxs stores the presigned timeout transactions of the
+// htlc of a loop in swap.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) StorePresignedTimeoutTxs(ctx context.Context,
+	hash lntypes.Hash, txs []*PresignedTimeoutTx) error {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.PresignedTimeoutTxs[hash] = append(
+		s.PresignedTimeoutTxs[hash], txs...,
+	)
+
+	return nil
+}
+
+// FetchPresignedTimeoutTxs returns the presigned timeout transactions of the
+// htlc of a loop in swap.
+//
+// NOTE: Part of the SwapStore interface.
+func (s *StoreMock) FetchPresignedTimeoutTxs(ctx context.Context,
+	hash lntypes.Hash) ([]*PresignedTimeoutTx, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.PresignedTimeoutTxs[hash], nil
+}
+
 // HasMigration returns true if the migration with the given ID has been done.
 func (s *StoreMock) HasMigration(ctx context.Context, migrationID string) (
 	bool, error) {
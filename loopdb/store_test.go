@@ -286,6 +286,11 @@ func testLoopInStore(t *testing.T, pendingSwap LoopInContract) {
 		require.Equal(t, swap, &pendingSwap)
 
 		require.Equal(t, swaps[0].State().State, expectedState)
+
+		loopIn, err := store.FetchLoopInSwap(ctxb, hash)
+		require.NoError(t, err)
+		require.Equal(t, &pendingSwap, loopIn.Contract)
+		require.Equal(t, expectedState, loopIn.State().State)
 	}
 
 	// If we create a new swap, then it should show up as being initialized
@@ -0,0 +1,212 @@
+package loopdb
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// maxQueryTime is the upper bound of the initiation time that is used
+	// if a swap query has no end time.
+	maxQueryTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// SwapQuery selects, sorts and paginates loop out and loop in swaps. The zero
+// values of the fields don't restrict the result.
+type SwapQuery struct {
+	// LoopOutOnly restricts the result to loop out swaps.
+	LoopOutOnly bool
+
+	// LoopInOnly restricts the result to loop in swaps.
+	LoopInOnly bool
+
+	// States restricts the result to swaps whose last stored update has
+	// one of the given states. Swaps without updates are in the initiated
+	// state.
+	States []SwapState
+
+	// StartTime restricts the result to swaps that were initiated at or
+	// after this time.
+	StartTime time.Time
+
+	// EndTime restricts the result to swaps that were initiated before
+	// this time.
+	EndTime time.Time
+
+	// MinAmount is the minimum requested amount of the returned swaps.
+	MinAmount btcutil.Amount
+
+	// MaxAmount is the maximum requested amount of the returned swaps.
+	MaxAmount btcutil.Amount
+
+	// Label restricts the result to swaps whose label contains it.
+	Label string
+
+	// OutgoingChanSet restricts the loop outs of the result to those that
+	// were restricted to exactly the channels of this set, in any order.
+	// Loop ins aren't affected.
+	OutgoingChanSet ChannelSet
+
+	// LastHop restricts the loop ins of the result to those with this last
+	// hop. Loop outs aren't affected.
+	LastHop *route.Vertex
+
+	// SortByAmount sorts the swaps by their requested amount instead of
+	// their initiation time. Swaps with the same amount are sorted by their
+	// initiation time.
+	SortByAmount bool
+
+	// Descending reverses the sort order.
+	Descending bool
+
+	// Offset is the number of swaps that are skipped.
+	Offset uint32
+
+	// Limit is the maximum number of returned swaps.
+	Limit uint32
+}
+
+// QueriedSwap is a swap that matches a swap query.
+type QueriedSwap struct {
+	// Hash is the hash of the swap.
+	Hash lntypes.Hash
+
+	// LoopOut is true for loop out swaps and false for loop in swaps.
+	LoopOut bool
+}
+
+// QuerySwaps returns the loop out and loop in swaps that match the query, in
+// the order and range that is requested. The swaps are selected by the state
+// of their last stored update.
+func (db *BaseDB) QuerySwaps(ctx context.Context,
+	query *SwapQuery) ([]QueriedSwap, error) {
+
+	params := sqlc.QuerySwapHashesParams{
+		LoopOut:      !query.LoopInOnly,
+		LoopIn:       !query.LoopOutOnly,
+		StateMask:    math.MaxInt32,
+		StartTime:    query.StartTime.UTC(),
+		EndTime:      maxQueryTime,
+		MinAmount:    int64(query.MinAmount),
+		MaxAmount:    math.MaxInt64,
+		Label:        query.Label,
+		SortByAmount: query.SortByAmount,
+		Descending:   query.Descending,
+		MaxSwaps:     math.MaxInt32,
+		SkipSwaps:    int32(min(query.Offset, math.MaxInt32)),
+	}
+
+	if len(query.OutgoingChanSet) > 0 {
+		chanSets, err := db.matchingChannelSets(
+			ctx, query.OutgoingChanSet,
+		)
+		if err != nil {
+			return nil, err
+		}
+		params.OutgoingChanSets = chanSets
+	}
+
+	if len(query.States) > 0 {
+		params.StateMask = 0
+		for _, state := range query.States {
+			params.StateMask |= 1 << state
+		}
+	}
+
+	if !query.EndTime.IsZero() {
+		params.EndTime = query.EndTime.UTC()
+	}
+
+	if query.MaxAmount != 0 {
+		params.MaxAmount = int64(query.MaxAmount)
+	}
+
+	if query.LastHop != nil {
+		params.LastHop = query.LastHop[:]
+	}
+
+	if query.Limit != 0 {
+		params.MaxSwaps = int32(min(query.Limit, math.MaxInt32))
+	}
+
+	rows, err := db.Queries.QuerySwapHashes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	swaps := make([]QueriedSwap, 0, len(rows))
+	for _, row := range rows {
+		hash, err := lntypes.MakeHash(row.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		swaps = append(swaps, QueriedSwap{
+			Hash:    hash,
+			LoopOut: row.LoopOut,
+		})
+	}
+
+	return swaps, nil
+}
+
+// matchingChannelSets returns the stored loop out channel sets that have the
+// same channels as the passed set, regardless of their order. The sets are
+// formatted as a list in which each set is enclosed by '|', so that the query
+// can match a stored set by searching for it in the list. If no stored set
+// matches, a list that doesn't contain any set is returned.
+func (db *BaseDB) matchingChannelSets(ctx context.Context,
+	set ChannelSet) (string, error) {
+
+	filter, err := NewChannelSet(set)
+	if err != nil {
+		return "", err
+	}
+
+	chanIDs := make(map[uint64]struct{}, len(filter))
+	for _, chanID := range filter {
+		chanIDs[chanID] = struct{}{}
+	}
+
+	storedSets, err := db.Queries.GetLoopOutChannelSets(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, storedSet := range storedSets {
+		chanSet, err := ConvertOutgoingChanSet(storedSet)
+		if err != nil {
+			return "", err
+		}
+
+		if len(chanSet) != len(chanIDs) {
+			continue
+		}
+
+		match := true
+		for _, chanID := range chanSet {
+			if _, ok := chanIDs[chanID]; !ok {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			matches = append(matches, storedSet)
+		}
+	}
+
+	if len(matches) == 0 {
+		return "|", nil
+	}
+
+	return "|" + strings.Join(matches, "|") + "|", nil
+}
@@ -0,0 +1,215 @@
+package loopdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQuerySwaps tests that swaps are filtered, sorted and paginated by the
+// swap query.
+func TestQuerySwaps(t *testing.T) {
+	ctxb := context.Background()
+	store := NewTestDB(t)
+
+	lastHop := route.Vertex{1}
+
+	contract := func(hash lntypes.Hash, amt btcutil.Amount, hours int,
+		label string) SwapContract {
+
+		return SwapContract{
+			AmountRequested: amt,
+			Preimage:        lntypes.Preimage(hash),
+			CltvExpiry:      144,
+			HtlcKeys: HtlcKeys{
+				SenderScriptKey:   senderKey,
+				ReceiverScriptKey: receiverKey,
+			},
+			InitiationTime: testTime.Add(
+				time.Duration(hours) * time.Hour,
+			),
+			Label: label,
+		}
+	}
+
+	newLoopOut := func(hash lntypes.Hash, amt btcutil.Amount, hours int,
+		label string, chanSet ChannelSet) {
+
+		swapContract := contract(hash, amt, hours, label)
+		swap := &LoopOutContract{
+			SwapContract:            swapContract,
+			DestAddr:                test.GetDestAddr(t, 0),
+			SwapPublicationDeadline: testTime,
+			OutgoingChanSet:         chanSet,
+		}
+		require.NoError(t, store.CreateLoopOut(ctxb, hash, swap))
+	}
+
+	newLoopIn := func(hash lntypes.Hash, amt btcutil.Amount, hours int,
+		label string, lastHop *route.Vertex) {
+
+		err := store.CreateLoopIn(ctxb, hash, &LoopInContract{
+			SwapContract: contract(hash, amt, hours, label),
+			LastHop:      lastHop,
+		})
+		require.NoError(t, err)
+	}
+
+	out1, out2, out3 := lntypes.Hash{1}, lntypes.Hash{2}, lntypes.Hash{3}
+	in1, in2 := lntypes.Hash{4}, lntypes.Hash{5}
+
+	// The channel set of out2 is stored unsorted.
+	newLoopOut(out1, 300, 0, "", nil)
+	newLoopOut(out2, 100, 1, "rebalance a", ChannelSet{5, 3})
+	newLoopOut(out3, 500, 2, "", ChannelSet{3})
+	newLoopIn(in1, 200, 3, "rebalance b", &lastHop)
+	newLoopIn(in2, 400, 4, "", nil)
+
+	require.NoError(t, store.UpdateLoopOut(
+		ctxb, out1, testTime, SwapStateData{State: StateSuccess},
+	))
+	require.NoError(t, store.UpdateLoopIn(
+		ctxb, in1, testTime, SwapStateData{State: StateFailTimeout},
+	))
+	require.NoError(t, store.UpdateLoopIn(
+		ctxb, in2, testTime, SwapStateData{State: StateHtlcPublished},
+	))
+
+	tests := []struct {
+		name     string
+		query    SwapQuery
+		expected []lntypes.Hash
+	}{
+		{
+			name:     "all",
+			expected: []lntypes.Hash{out1, out2, out3, in1, in2},
+		},
+		{
+			name: "loop outs",
+			query: SwapQuery{
+				LoopOutOnly: true,
+			},
+			expected: []lntypes.Hash{out1, out2, out3},
+		},
+		{
+			name: "loop ins",
+			query: SwapQuery{
+				LoopInOnly: true,
+			},
+			expected: []lntypes.Hash{in1, in2},
+		},
+		{
+			name: "states",
+			query: SwapQuery{
+				States: []SwapState{
+					StateInitiated, StateHtlcPublished,
+				},
+			},
+			expected: []lntypes.Hash{out2, out3, in2},
+		},
+		{
+			name: "time range",
+			query: SwapQuery{
+				StartTime: testTime.Add(time.Hour),
+				EndTime:   testTime.Add(3 * time.Hour),
+			},
+			expected: []lntypes.Hash{out2, out3},
+		},
+		{
+			name: "amount range",
+			query: SwapQuery{
+				MinAmount: 200,
+				MaxAmount: 400,
+			},
+			expected: []lntypes.Hash{out1, in1, in2},
+		},
+		{
+			name: "label",
+			query: SwapQuery{
+				Label: "rebalance",
+			},
+			expected: []lntypes.Hash{out2, in1},
+		},
+		{
+			name: "outgoing channel set",
+			query: SwapQuery{
+				OutgoingChanSet: ChannelSet{3, 5},
+			},
+			expected: []lntypes.Hash{out2, in1, in2},
+		},
+		{
+			name: "outgoing channel subset",
+			query: SwapQuery{
+				OutgoingChanSet: ChannelSet{5},
+			},
+			expected: []lntypes.Hash{in1, in2},
+		},
+		{
+			name: "last hop",
+			query: SwapQuery{
+				LastHop: &lastHop,
+			},
+			expected: []lntypes.Hash{out1, out2, out3, in1},
+		},
+		{
+			name: "sort by amount",
+			query: SwapQuery{
+				SortByAmount: true,
+			},
+			expected: []lntypes.Hash{out2, in1, out1, in2, out3},
+		},
+		{
+			name: "descending",
+			query: SwapQuery{
+				Descending: true,
+			},
+			expected: []lntypes.Hash{in2, in1, out3, out2, out1},
+		},
+		{
+			name: "sort by amount descending",
+			query: SwapQuery{
+				SortByAmount: true,
+				Descending:   true,
+			},
+			expected: []lntypes.Hash{out3, in2, out1, in1, out2},
+		},
+		{
+			name: "pagination",
+			query: SwapQuery{
+				Offset: 1,
+				Limit:  2,
+			},
+			expected: []lntypes.Hash{out2, out3},
+		},
+		{
+			name: "offset beyond swaps",
+			query: SwapQuery{
+				Offset: 5,
+			},
+			expected: []lntypes.Hash{},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			swaps, err := store.QuerySwaps(ctxb, &testCase.query)
+			require.NoError(t, err)
+
+			hashes := make([]lntypes.Hash, 0, len(swaps))
+			for _, swap := range swaps {
+				hashes = append(hashes, swap.Hash)
+
+				loopOut := swap.Hash == out1 ||
+					swap.Hash == out2 || swap.Hash == out3
+				require.Equal(t, loopOut, swap.LoopOut)
+			}
+			require.Equal(t, testCase.expected, hashes)
+		})
+	}
+}
@@ -15,9 +15,11 @@ import (
 	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/sweepbatcher"
 	"github.com/lightninglabs/loop/utils"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	invpkg "github.com/lightningnetwork/lnd/invoices"
@@ -73,10 +75,37 @@ type loopInSwap struct {
 	// htlcTxHash is the confirmed htlc tx id.
 	htlcTxHash *chainhash.Hash
 
+	// htlcBump tracks fee bumps of our unconfirmed htlc tx.
+	htlcBump *htlcBump
+
 	timeoutAddr btcutil.Address
 
 	abandonChan chan struct{}
 
+	// externalHtlcChan receives the externally signed htlc tx of an
+	// external loop in swap that the swap publishes and persists.
+	externalHtlcChan chan *externalHtlcTx
+
+	// stateMachine is the state machine that executes the swap.
+	stateMachine *fsm.TypedStateMachine[context.Context]
+
+	// persistErr is the error of the last attempt to persist the swap
+	// state when the state machine transitioned.
+	persistErr error
+
+	// htlcFeeRate is the fee rate that our htlc tx is published with.
+	htlcFeeRate chainfee.SatPerKWeight
+
+	// htlcOutpoint is the outpoint of the confirmed htlc.
+	htlcOutpoint *wire.OutPoint
+
+	// htlcValue is the value of the confirmed htlc.
+	htlcValue btcutil.Amount
+
+	// spendWatch holds the subscriptions used to wait for the completion
+	// of the swap.
+	spendWatch *htlcSpendWatch
+
 	wg sync.WaitGroup
 }
 
@@ -316,6 +345,7 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	}
 
 	swap.abandonChan = make(chan struct{}, 1)
+	swap.externalHtlcChan = make(chan *externalHtlcTx)
 
 	return &loopInInitResult{
 		swap:          swap,
@@ -425,6 +455,7 @@ func resumeLoopInSwap(_ context.Context, cfg *swapConfig,
 	// Upon restoring the swap we also need to assign a new abandon channel
 	// that the client can use to signal that the swap should be abandoned.
 	swap.abandonChan = make(chan struct{}, 1)
+	swap.externalHtlcChan = make(chan *externalHtlcTx)
 
 	return swap, nil
 }
@@ -574,88 +605,64 @@ func (s *loopInSwap) execute(mainCtx context.Context,
 	return nil
 }
 
-// executeSwap executes the swap.
+// executeSwap executes the swap by running its state machine until the swap
+// reaches a final state or a temporary error occurs.
 func (s *loopInSwap) executeSwap(globalCtx context.Context) error {
-	var err error
-
 	// If the swap is already in a final state, we can return immediately.
 	if s.state.IsFinal() {
 		return ErrSwapFinalized
 	}
 
 	// For loop in, the client takes the first step by publishing the
-	// on-chain htlc. Only do this if we haven't already done so in a
-	// previous run.
-	if s.state == loopdb.StateInitiated {
-		if s.ExternalHtlc {
-			// If an external htlc was indicated, we can move to the
-			// HtlcPublished state directly and wait for
-			// confirmation.
-			s.setState(loopdb.StateHtlcPublished)
-			err = s.persistAndAnnounceState(globalCtx)
-			if err != nil {
-				return err
-			}
-		} else {
-			published, err := s.publishOnChainHtlc(globalCtx)
-			if err != nil {
-				return err
-			}
-			if !published {
-				return nil
-			}
-		}
-	}
-
-	// Wait for the htlc to confirm. After a restart, this will pick up a
-	// previously published tx.
-	conf, err := s.waitForHtlcConf(globalCtx)
-	if err != nil {
-		return err
+	// on-chain htlc. Swaps that already got further in a previous run
+	// resume in the state that matches their stored swap state.
+	event := onStart
+	current := fsm.EmptyState
+	if s.state != loopdb.StateInitiated {
+		event = onRecover
+		current = loopInResumeState(s.state)
 	}
 
-	// Determine the htlc outpoint by inspecting the htlc tx.
-	htlcOutpoint, htlcValue, err := swap.GetScriptOutput(
-		conf.Tx, s.htlc.PkScript,
+	s.stateMachine = fsm.NewTypedStateMachineWithState(
+		s.getStates(), current, defaultObserverSize,
 	)
-	if err != nil {
-		return err
-	}
-
-	// Verify that the confirmed (external) htlc value matches the swap
-	// amount. If the amounts mismatch we update the swap state to indicate
-	// this, but end processing the swap. Instead, we continue to wait for
-	// the htlc to expire and publish a timeout tx to reclaim the funds. We
-	// skip this part if the swap was recovered from this state.
-	if s.state != loopdb.StateFailIncorrectHtlcAmt &&
-		htlcValue != s.LoopInContract.AmountRequested {
+	s.stateMachine.RegisterObserver(s)
+	defer s.stopSpendWatch()
 
-		s.setState(loopdb.StateFailIncorrectHtlcAmt)
-		err = s.persistAndAnnounceState(globalCtx)
-		if err != nil {
-			log.Errorf("Error persisting state: %v", err)
-		}
+	if s.trackStateMachine != nil {
+		defer s.trackStateMachine(
+			s.hash, s.swapType, s.stateMachine,
+		)()
 	}
 
-	// The server is expected to see the htlc on-chain and know that it can
-	// sweep that htlc with the preimage, it should pay our swap invoice,
-	// receive the preimage and sweep the htlc. We are waiting for this to
-	// happen and simultaneously watch the htlc expiry height. When the htlc
-	// expires, we will publish a timeout tx to reclaim the funds.
-	err = s.waitForSwapComplete(globalCtx, htlcOutpoint, htlcValue)
+	err := s.stateMachine.SendEvent(event, globalCtx)
 	if err != nil {
 		return err
 	}
 
-	// Persist swap outcome.
-	if err := s.persistAndAnnounceState(globalCtx); err != nil {
-		return err
+	switch {
+	// If there are insufficient confirmed funds to publish the swap, we
+	// finalize its state so a new swap will be published if funds become
+	// available.
+	case s.state == loopdb.StateFailInsufficientConfirmedBalance:
+		return ErrInsufficientBalance
+
+	case s.state == loopdb.StateFailAbandoned:
+		return fmt.Errorf("swap hash "+
+			"abandoned by client, "+
+			"swap ID: %v, %v",
+			s.hash, s.persistErr)
+
+	case s.stateMachine.LastActionError != nil:
+		return s.stateMachine.LastActionError
 	}
 
-	return nil
+	// The swap outcome is persisted by the observer of the state machine.
+	return s.persistErr
 }
 
-// waitForHtlcConf watches the chain until the htlc confirms.
+// waitForHtlcConf watches the chain until the htlc confirms. It returns nil if
+// the swap was abandoned while waiting.
 func (s *loopInSwap) waitForHtlcConf(globalCtx context.Context) (
 	*chainntnfs.TxConfirmation, error) {
 
@@ -722,14 +729,22 @@ func (s *loopInSwap) waitForHtlcConf(globalCtx context.Context) (
 		case err := <-confErrP2TR:
 			return nil, err
 
-		// Keep up with block height.
+		// Keep up with block height and bump the htlc tx fee if it
+		// doesn't confirm in time.
 		case notification := <-s.blockEpochChan:
 			s.height = notification.(int32)
+			s.maybeBumpHtlc(ctx)
 
 		// If the client requested the swap to be abandoned, we override
 		// the status in the database.
 		case <-s.abandonChan:
-			return nil, s.setStateAbandoned(ctx)
+			return nil, nil
+
+		// The user hands us the externally published htlc tx.
+		case htlcTx := <-s.externalHtlcChan:
+			htlcTx.errChan <- s.publishExternalHtlcTx(
+				ctx, htlcTx.tx,
+			)
 
 		// Cancel.
 		case <-globalCtx.Done():
@@ -751,18 +766,20 @@ func (s *loopInSwap) waitForHtlcConf(globalCtx context.Context) (
 	return conf, nil
 }
 
-// publishOnChainHtlc checks whether there are still enough blocks left and if
-// so, it publishes the htlc and advances the swap state.
-func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
-	var err error
+// initiatedAction checks whether there are still enough blocks left to publish
+// the htlc and estimates its fee rate. External htlcs are published by the
+// user, so we can wait for their confirmation directly.
+func (s *loopInSwap) initiatedAction(ctx context.Context) fsm.EventType {
+	if s.ExternalHtlc {
+		return onHtlcPublished
+	}
 
 	blocksRemaining := s.CltvExpiry - s.height
 	s.log.Infof("Blocks left until on-chain expiry: %v", blocksRemaining)
 
 	// Verify whether it still makes sense to publish the htlc.
 	if blocksRemaining < MinLoopInPublishDelta {
-		s.setState(loopdb.StateFailTimeout)
-		return false, s.persistAndAnnounceState(ctx)
+		return onTimeout
 	}
 
 	// Get fee estimate from lnd.
@@ -770,47 +787,53 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 		ctx, s.LoopInContract.HtlcConfTarget,
 	)
 	if err != nil {
-		return false, fmt.Errorf("estimate fee: %v", err)
+		return s.stateMachine.HandleError(
+			fmt.Errorf("estimate fee: %v", err),
+		)
 	}
+	s.htlcFeeRate = feeRate
 
-	// Transition to state HtlcPublished before calling SendOutputs to
-	// prevent us from ever paying multiple times after a crash.
-	s.setState(loopdb.StateHtlcPublished)
-	err = s.persistAndAnnounceState(ctx)
-	if err != nil {
-		return false, err
+	return onPublishHtlc
+}
+
+// publishHtlcAction publishes the htlc. The state machine transitions to the
+// HtlcPublished swap state before the htlc is published to prevent us from
+// ever paying multiple times after a crash.
+func (s *loopInSwap) publishHtlcAction(ctx context.Context) fsm.EventType {
+	if s.persistErr != nil {
+		return s.stateMachine.HandleError(s.persistErr)
 	}
 
-	s.log.Infof("Publishing on chain HTLC with fee rate %v", feeRate)
+	s.log.Infof("Publishing on chain HTLC with fee rate %v", s.htlcFeeRate)
 
-	var pkScript []byte
-	if IsTaprootSwap(&s.SwapContract) {
-		pkScript = s.htlcP2TR.PkScript
-	} else {
-		pkScript = s.htlcP2WSH.PkScript
-	}
+	pkScript := s.htlcPkScript()
 
-	tx, err := s.lnd.WalletKit.SendOutputs(
-		ctx, []*wire.TxOut{{
+	result, err := s.publishHtlc(ctx, &htlcPublishRequest{
+		swapHash: s.hash,
+		output: &wire.TxOut{
 			PkScript: pkScript,
 			Value:    int64(s.LoopInContract.AmountRequested),
-		}}, feeRate, labels.LoopInHtlcLabel(swap.ShortHash(&s.hash)),
-	)
-	if err != nil {
+		},
+		feeRate: s.htlcFeeRate,
+		maxFee:  s.MaxMinerFee,
+	})
+	switch {
+	// If the swap was abandoned while waiting for its htlc batch, nothing
+	// was published.
+	case errors.Is(err, errHtlcBatchDropped):
+		return s.abandon()
+
+	case err != nil:
 		s.log.Errorf("send outputs: %v", err)
-		s.setState(loopdb.StateFailInsufficientConfirmedBalance)
-
-		// If we cannot send out this update, there is nothing we can
-		// do.
-		_ = s.persistAndAnnounceState(ctx)
 
-		return false, ErrInsufficientBalance
+		return onInsufficientBalance
 	}
 
-	txHash := tx.TxHash()
-	fee := getTxFee(tx, feeRate.FeePerKVByte())
+	txHash := result.tx.TxHash()
+	fee := result.fee
 
-	s.log.Infof("Published on chain HTLC tx %v, fee: %v", txHash, fee)
+	s.log.Infof("Published on chain HTLC tx %v, fee: %v, batch size: %v",
+		txHash, fee, result.batchSize)
 
 	// Persist the htlc hash so that after a restart we are still waiting
 	// for our own htlc. We don't need to announce to clients, because the
@@ -819,15 +842,59 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 
 	// We do not expect any on-chain fees to be recorded yet, and we only
 	// publish our htlc once, so we set our total on-chain costs to equal
-	// the fee for publishing the htlc.
+	// our share of the fee for publishing the htlc.
 	s.cost.Onchain = fee
 
+	// Keep the htlc tx around so we can bump its fee if it doesn't confirm
+	// in time. Htlc txes that are shared with other swaps aren't bumped.
+	s.htlcBump = &htlcBump{tx: result.tx, fee: fee}
+	if result.batchSize == 1 {
+		s.htlcBump = newHtlcBump(result.tx, fee, pkScript)
+	}
+
 	s.lastUpdateTime = time.Now()
 	if err := s.persistState(ctx); err != nil {
-		return false, fmt.Errorf("persist htlc tx: %v", err)
+		return s.stateMachine.HandleError(
+			fmt.Errorf("persist htlc tx: %v", err),
+		)
+	}
+
+	// The swap was abandoned while its htlc batch was being published, so
+	// we abandon it now that the htlc is recorded.
+	if result.abandoned {
+		return s.abandon()
+	}
+
+	return onHtlcPublished
+}
+
+// publishHtlc publishes the htlc described by the passed request. The htlcs
+// of swaps dispatched by autoloop may be published together with the htlcs of
+// other autoloop swaps if an htlc batcher is configured. All other htlcs are
+// published right away in their own transaction.
+func (s *loopInSwap) publishHtlc(ctx context.Context,
+	req *htlcPublishRequest) (*htlcPublishResult, error) {
+
+	if s.htlcBatcher != nil && isAutoloopLabel(s.Label) {
+		return s.htlcBatcher.publish(ctx, req, s.abandonChan)
+	}
+
+	req.resultChan = make(chan *htlcPublishResult, 1)
+	_ = publishHtlcTx(ctx, s.lnd.WalletKit, []*htlcPublishRequest{req})
+
+	result := <-req.resultChan
+	if result.err != nil {
+		return nil, result.err
 	}
 
-	return true, nil
+	return result, nil
+}
+
+// isAutoloopLabel returns true if the passed label marks a loop in swap that
+// was dispatched by autoloop.
+func isAutoloopLabel(label string) bool {
+	return label == labels.AutoloopLabel(swap.TypeIn) ||
+		label == labels.EasyAutoloopLabel(swap.TypeIn)
 }
 
 // getTxFee calculates our fee for a transaction that we have broadcast. We use
@@ -840,114 +907,329 @@ func getTxFee(tx *wire.MsgTx, fee chainfee.SatPerKVByte) btcutil.Amount {
 	return fee.FeeForVSize(lntypes.VByte(vsize))
 }
 
-// waitForSwapComplete waits until a spending tx of the htlc gets confirmed and
-// the swap invoice is either settled or canceled. If the htlc times out, the
-// timeout tx will be published.
-func (s *loopInSwap) waitForSwapComplete(ctx context.Context,
-	htlcOutpoint *wire.OutPoint, htlcValue btcutil.Amount) error {
+// htlcSpendWatch contains the subscriptions used to wait for the completion of
+// a loop in swap. They are kept open while the swap moves from the htlc
+// published state to the invoice settled state.
+type htlcSpendWatch struct {
+	// sweepReq is the request that hands our timeout sweep over to the
+	// sweep batcher.
+	sweepReq *sweepbatcher.SweepRequest
+
+	// quitChan notifies the sweep batcher that we stopped waiting for the
+	// spend.
+	quitChan chan bool
+
+	// spendChan receives the confirmed spend of the htlc.
+	spendChan chan *chainntnfs.SpendDetail
+
+	// spendErrChan receives errors of the spend notification.
+	spendErrChan chan error
+
+	// batchSpendChan receives the spend of the htlc by a timeout batch.
+	batchSpendChan chan *sweepbatcher.SpendDetail
+
+	// batchSpendErrChan receives errors of the sweep batcher.
+	batchSpendErrChan chan error
+
+	// invoiceChan receives updates of the swap invoice.
+	invoiceChan <-chan lndclient.InvoiceUpdate
+
+	// invoiceErrChan receives errors of the swap invoice subscription.
+	invoiceErrChan <-chan error
+
+	// timeoutSweepBatched is set once the timeout sweep was handed over to
+	// the sweep batcher.
+	timeoutSweepBatched bool
+
+	// sweepFee is the fee of our timeout sweep.
+	sweepFee btcutil.Amount
+
+	// outcome is the event that the htlc spend resulted in.
+	outcome fsm.EventType
+
+	// invoiceFinalized is set once the swap invoice is settled or
+	// canceled.
+	invoiceFinalized bool
+
+	// settledAmt is the amount paid to the swap invoice, if its settlement
+	// has yet to be processed.
+	settledAmt *btcutil.Amount
+
+	// htlcKeyRevealed is set once our internal htlc key was pushed to the
+	// server.
+	htlcKeyRevealed bool
+
+	// cancel cancels the subscriptions.
+	cancel func()
+}
+
+// confirmHtlc waits for the htlc to confirm and determines its outpoint. After
+// a restart, this will pick up a previously published tx. The returned event
+// is empty if the htlc confirmed.
+func (s *loopInSwap) confirmHtlc(ctx context.Context) fsm.EventType {
+	conf, err := s.waitForHtlcConf(ctx)
+	if err != nil {
+		return s.stateMachine.HandleError(err)
+	}
+
+	// If the client requested the swap to be abandoned, we override the
+	// status in the database.
+	if conf == nil {
+		return s.abandon()
+	}
+
+	// Determine the htlc outpoint by inspecting the htlc tx.
+	htlcOutpoint, htlcValue, err := swap.GetScriptOutput(
+		conf.Tx, s.htlc.PkScript,
+	)
+	if err != nil {
+		return s.stateMachine.HandleError(err)
+	}
+
+	s.htlcOutpoint = htlcOutpoint
+	s.htlcValue = htlcValue
+
+	// Failing to presign the timeout txs doesn't affect the swap itself,
+	// so we only log the error.
+	if err := s.presignTimeoutTxs(ctx); err != nil {
+		s.log.Warnf("Unable to presign timeout txs: %v", err)
+	}
+
+	return fsm.NoOp
+}
+
+// startSpendWatch registers the htlc spend notification and subscribes to
+// updates of the swap invoice.
+func (s *loopInSwap) startSpendWatch(globalCtx context.Context) error {
+	ctx, cancel := context.WithCancel(globalCtx)
 
 	// Register the htlc spend notification.
-	rpcCtx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	spendChan, spendErr, err := s.lnd.ChainNotifier.RegisterSpendNtfn(
-		rpcCtx, htlcOutpoint, s.htlc.PkScript, s.InitiationHeight,
+	spendChan, spendErrChan, err := s.lnd.ChainNotifier.RegisterSpendNtfn(
+		ctx, s.htlcOutpoint, s.htlc.PkScript, s.InitiationHeight,
 	)
 	if err != nil {
+		cancel()
+
 		return fmt.Errorf("register spend ntfn: %v", err)
 	}
 
 	// Register for swap invoice updates.
-	rpcCtx, cancel = context.WithCancel(ctx)
-	defer cancel()
 	s.log.Infof("Subscribing to swap invoice %v", s.hash)
-	swapInvoiceChan, swapInvoiceErr, err := s.lnd.Invoices.SubscribeSingleInvoice(
-		rpcCtx, s.hash,
+	invoices := s.lnd.Invoices
+	invoiceChan, invoiceErrChan, err := invoices.SubscribeSingleInvoice(
+		ctx, s.hash,
 	)
 	if err != nil {
+		cancel()
+
 		return fmt.Errorf("subscribe to swap invoice: %v", err)
 	}
 
-	// publishTxOnTimeout publishes the timeout tx if the contract has
-	// expired.
-	publishTxOnTimeout := func() (btcutil.Amount, error) {
-		if s.height >= s.LoopInContract.CltvExpiry {
-			return s.publishTimeoutTx(ctx, htlcOutpoint, htlcValue)
+	// If a sweep batcher is available, the timeout sweep is handed over
+	// to it so that it can be batched with the timeout sweeps of other
+	// loop in swaps. The batcher reports the spend of the htlc together
+	// with our share of the batch fee through the notifier. Our share is
+	// limited to the max miner fee of the swap.
+	batchSpendChan := make(chan *sweepbatcher.SpendDetail)
+	batchSpendErrChan := make(chan error, 1)
+	quitChan := make(chan bool, 1)
+
+	s.spendWatch = &htlcSpendWatch{
+		sweepReq: &sweepbatcher.SweepRequest{
+			SwapHash: s.hash,
+			Outpoint: *s.htlcOutpoint,
+			Value:    s.htlcValue,
+			Notifier: &sweepbatcher.SpendNotifier{
+				SpendChan:    batchSpendChan,
+				SpendErrChan: batchSpendErrChan,
+				QuitChan:     quitChan,
+			},
+		},
+		quitChan:          quitChan,
+		spendChan:         spendChan,
+		spendErrChan:      spendErrChan,
+		batchSpendChan:    batchSpendChan,
+		batchSpendErrChan: batchSpendErrChan,
+		invoiceChan:       invoiceChan,
+		invoiceErrChan:    invoiceErrChan,
+		cancel:            cancel,
+	}
+
+	return nil
+}
+
+// stopSpendWatch stops waiting for the completion of the swap, if we are.
+func (s *loopInSwap) stopSpendWatch() {
+	if s.spendWatch == nil {
+		return
+	}
+
+	s.spendWatch.quitChan <- true
+	s.spendWatch.cancel()
+	s.spendWatch = nil
+}
+
+// publishTxOnTimeout publishes the timeout tx if the contract has expired.
+func (s *loopInSwap) publishTxOnTimeout(ctx context.Context) error {
+	if s.height < s.LoopInContract.CltvExpiry {
+		return nil
+	}
+
+	w := s.spendWatch
+	if s.batcher == nil {
+		sweepFee, err := s.publishTimeoutTx(
+			ctx, s.htlcOutpoint, s.htlcValue,
+		)
+		if err != nil {
+			return err
 		}
+		w.sweepFee = sweepFee
 
-		return 0, nil
+		return nil
 	}
 
-	// Check timeout at current height. After a restart we may want to
-	// publish the tx immediately.
-	var sweepFee btcutil.Amount
-	sweepFee, err = publishTxOnTimeout()
-	if err != nil {
-		return err
+	// The batcher keeps republishing the sweep with RBF, so it only needs
+	// to be added once.
+	if !w.timeoutSweepBatched {
+		s.log.Infof("Adding timeout sweep to sweep batcher")
+
+		err := s.batcher.AddSweep(w.sweepReq)
+		if err != nil {
+			return err
+		}
+
+		w.timeoutSweepBatched = true
+	}
+
+	return nil
+}
+
+// invoiceSettled accounts for the settlement of the swap invoice.
+func (s *loopInSwap) invoiceSettled(ctx context.Context,
+	amtPaid btcutil.Amount) {
+
+	w := s.spendWatch
+	w.invoiceFinalized = true
+	w.htlcKeyRevealed = s.tryPushHtlcKey(ctx)
+	s.cost.Server = s.AmountRequested - amtPaid
+}
+
+// waitForSwapComplete waits until a spending tx of the htlc gets confirmed and
+// the swap invoice is either settled or canceled. If the htlc times out, the
+// timeout tx will be published. It returns the event that the htlc spend
+// resulted in, or onInvoiceSettled if the swap invoice got settled before the
+// htlc was spent.
+func (s *loopInSwap) waitForSwapComplete(ctx context.Context) fsm.EventType {
+	// After a restart, we need to find our htlc again.
+	if s.htlcOutpoint == nil {
+		if event := s.confirmHtlc(ctx); event != fsm.NoOp {
+			return event
+		}
+	}
+
+	if s.spendWatch == nil {
+		if err := s.startSpendWatch(ctx); err != nil {
+			return s.stateMachine.HandleError(err)
+		}
+
+		// Check timeout at current height. After a restart we may want
+		// to publish the tx immediately.
+		if err := s.publishTxOnTimeout(ctx); err != nil {
+			return s.stateMachine.HandleError(err)
+		}
 	}
 
-	htlcSpend := false
-	invoiceFinalized := false
-	htlcKeyRevealed := false
-	for !htlcSpend || !invoiceFinalized {
+	w := s.spendWatch
+	for w.outcome == "" || !w.invoiceFinalized {
 		select {
 		// If the client requested the swap to be abandoned, we override
 		// the status in the database.
 		case <-s.abandonChan:
-			return s.setStateAbandoned(ctx)
+			return s.abandon()
+
+		// The htlc is confirmed already, so this only accepts the
+		// confirmed htlc tx.
+		case htlcTx := <-s.externalHtlcChan:
+			htlcTx.errChan <- s.publishExternalHtlcTx(
+				ctx, htlcTx.tx,
+			)
 
 		// Spend notification error.
-		case err := <-spendErr:
-			return err
+		case err := <-w.spendErrChan:
+			return s.stateMachine.HandleError(err)
+
+		// Sweep batcher error.
+		case err := <-w.batchSpendErrChan:
+			return s.stateMachine.HandleError(err)
 
 		// Receive block epochs and start publishing the timeout tx
 		// whenever possible.
 		case notification := <-s.blockEpochChan:
 			s.height = notification.(int32)
 
-			sweepFee, err = publishTxOnTimeout()
-			if err != nil {
-				return err
+			if err := s.publishTxOnTimeout(ctx); err != nil {
+				return s.stateMachine.HandleError(err)
 			}
 
-			if invoiceFinalized && !htlcKeyRevealed {
-				htlcKeyRevealed = s.tryPushHtlcKey(ctx)
+			if w.invoiceFinalized && !w.htlcKeyRevealed {
+				w.htlcKeyRevealed = s.tryPushHtlcKey(ctx)
 			}
 
 		// The htlc spend is confirmed. Inspect the spending tx to
 		// determine the final swap state.
-		case spendDetails := <-spendChan:
+		case spendDetails := <-w.spendChan:
 			s.log.Infof("Htlc spend by tx: %v",
 				spendDetails.SpenderTxHash)
 
-			err := s.processHtlcSpend(ctx, spendDetails, sweepFee)
+			// If our timeout sweep was batched, our share of the
+			// batch fee is reported by the batcher once it
+			// detected the spend as well.
+			inputIndex := spendDetails.SpenderInputIndex
+			htlcInput := spendDetails.SpendingTx.TxIn[inputIndex]
+			if w.timeoutSweepBatched &&
+				!s.htlc.IsSuccessWitness(htlcInput.Witness) {
+
+				sweepFee, err := s.waitForBatchedTimeoutSweep(
+					ctx, w.batchSpendChan,
+					w.batchSpendErrChan,
+				)
+				if err != nil {
+					return s.stateMachine.HandleError(err)
+				}
+				w.sweepFee = sweepFee
+			}
+
+			outcome, err := s.processHtlcSpend(
+				ctx, spendDetails, w.sweepFee,
+			)
 			if err != nil {
-				return err
+				return s.stateMachine.HandleError(err)
 			}
 
-			htlcSpend = true
+			w.outcome = outcome
 
 		// Swap invoice ntfn error.
-		case err, ok := <-swapInvoiceErr:
+		case err, ok := <-w.invoiceErrChan:
 			// If the channel has been closed, the server has
 			// finished sending updates, so we set the channel to
 			// nil because we don't want to constantly select this
 			// case.
 			if !ok {
-				swapInvoiceErr = nil
+				w.invoiceErrChan = nil
 				continue
 			}
 
-			return err
+			return s.stateMachine.HandleError(err)
 
 		// An update to the swap invoice occurred. Check the new state
 		// and update the swap state accordingly.
-		case update, ok := <-swapInvoiceChan:
+		case update, ok := <-w.invoiceChan:
 			// If the channel has been closed, the server has
 			// finished sending updates, so we set the channel to
 			// nil because we don't want to constantly select this
 			// case.
 			if !ok {
-				swapInvoiceChan = nil
+				w.invoiceChan = nil
 				continue
 			}
 
@@ -963,31 +1245,54 @@ func (s *loopInSwap) waitForSwapComplete(ctx context.Context,
 				// swap is complete from the user point of view,
 				// but still incomplete with regards to
 				// accounting data.
-				if s.state == loopdb.StateHtlcPublished {
-					s.setState(loopdb.StateInvoiceSettled)
-					err := s.persistAndAnnounceState(ctx)
-					if err != nil {
-						return err
-					}
+				if s.state == loopdb.StateHtlcPublished &&
+					w.outcome == "" {
+
+					amtPaid := update.AmtPaid
+					w.settledAmt = &amtPaid
+
+					return onInvoiceSettled
 				}
 
-				invoiceFinalized = true
-				htlcKeyRevealed = s.tryPushHtlcKey(ctx)
-				s.cost.Server = s.AmountRequested -
-					update.AmtPaid
+				s.invoiceSettled(ctx, update.AmtPaid)
 
 			// Canceled invoice has no effect on server cost
 			// balance.
 			case invpkg.ContractCanceled:
-				invoiceFinalized = true
+				w.invoiceFinalized = true
 			}
 
 		case <-ctx.Done():
-			return ctx.Err()
+			return s.stateMachine.HandleError(ctx.Err())
 		}
 	}
 
-	return nil
+	s.stopSpendWatch()
+
+	return w.outcome
+}
+
+// waitForBatchedTimeoutSweep waits for the sweep batcher to report the spend
+// of the htlc by a timeout batch and returns the swap's share of the batch
+// fee.
+func (s *loopInSwap) waitForBatchedTimeoutSweep(ctx context.Context,
+	spendChan <-chan *sweepbatcher.SpendDetail,
+	spendErrChan <-chan error) (btcutil.Amount, error) {
+
+	select {
+	case spend := <-spendChan:
+		s.log.Infof("Timeout sweep confirmed in batch tx %v, fee "+
+			"portion: %v", spend.Tx.TxHash(),
+			spend.OnChainFeePortion)
+
+		return spend.OnChainFeePortion, nil
+
+	case err := <-spendErrChan:
+		return 0, err
+
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
 }
 
 // tryPushHtlcKey attempts to push the htlc key to the server. If the server
@@ -1020,42 +1325,38 @@ func (s *loopInSwap) tryPushHtlcKey(ctx context.Context) bool {
 	return true
 }
 
+// processHtlcSpend inspects the confirmed spend of the htlc and returns the
+// event that it resulted in.
 func (s *loopInSwap) processHtlcSpend(ctx context.Context,
-	spend *chainntnfs.SpendDetail, sweepFee btcutil.Amount) error {
+	spend *chainntnfs.SpendDetail, sweepFee btcutil.Amount) (fsm.EventType,
+	error) {
 
 	// Determine the htlc input of the spending tx and inspect the witness
 	// to find out whether a success or a timeout tx spent the htlc.
 	htlcInput := spend.SpendingTx.TxIn[spend.SpenderInputIndex]
 
 	if s.htlc.IsSuccessWitness(htlcInput.Witness) {
-		s.setState(loopdb.StateSuccess)
-	} else {
-		// We needed another on chain tx to sweep the timeout clause,
-		// which we now include in our costs.
-		s.cost.Onchain += sweepFee
-
-		// If the swap is in state StateFailIncorrectHtlcAmt we know
-		// that the deposited htlc amount wasn't equal to the contract
-		// amount. We can finalize the swap by setting an appropriate
-		// state.
-		if s.state == loopdb.StateFailIncorrectHtlcAmt {
-			s.setState(loopdb.StateFailIncorrectHtlcAmtSwept)
-		} else {
-			s.setState(loopdb.StateFailTimeout)
-		}
+		return onHtlcSwept, nil
+	}
 
-		// Now that the timeout tx confirmed, we can safely cancel the
-		// swap invoice. We still need to query the final invoice state.
-		// This is not a hodl invoice, so it may be that the invoice was
-		// already settled. This means that the server didn't succeed in
-		// sweeping the htlc after paying the invoice.
-		err := s.lnd.Invoices.CancelInvoice(ctx, s.hash)
-		if err != nil && err != invpkg.ErrInvoiceAlreadySettled {
-			return err
-		}
+	// We needed another on chain tx to sweep the timeout clause, which we
+	// now include in our costs.
+	s.cost.Onchain += sweepFee
+
+	// Now that the timeout tx confirmed, we can safely cancel the swap
+	// invoice. We still need to query the final invoice state. This is not
+	// a hodl invoice, so it may be that the invoice was already settled.
+	// This means that the server didn't succeed in sweeping the htlc after
+	// paying the invoice.
+	err := s.lnd.Invoices.CancelInvoice(ctx, s.hash)
+	if err != nil && err != invpkg.ErrInvoiceAlreadySettled {
+		return "", err
 	}
 
-	return nil
+	// If the swap is in state StateFailIncorrectHtlcAmt we know that the
+	// deposited htlc amount wasn't equal to the contract amount. The state
+	// machine finalizes the swap in an appropriate state for this event.
+	return onHtlcTimeoutSwept, nil
 }
 
 // publishTimeoutTx publishes a timeout tx after the on-chain htlc has expired,
@@ -1086,6 +1387,15 @@ func (s *loopInSwap) publishTimeoutTx(ctx context.Context,
 		return 0, err
 	}
 
+	// The timeout tx doesn't pay more than the max miner fee of the swap,
+	// the same as its share of a batched timeout sweep.
+	if s.MaxMinerFee != 0 && fee > s.MaxMinerFee {
+		s.log.Warnf("Timeout tx fee %v exceeds max miner fee %v, "+
+			"using max miner fee", fee, s.MaxMinerFee)
+
+		fee = s.MaxMinerFee
+	}
+
 	// Create a function that will assemble our timeout witness.
 	witnessFunc := func(sig []byte) (wire.TxWitness, error) {
 		return s.htlc.GenTimeoutWitness(sig)
@@ -1119,31 +1429,6 @@ func (s *loopInSwap) publishTimeoutTx(ctx context.Context,
 	return fee, nil
 }
 
-// setStateAbandoned stores the abandoned state and announces it. It also
-// cancels the swap invoice so the server can't settle it.
-func (s *loopInSwap) setStateAbandoned(ctx context.Context) error {
-	s.log.Infof("Abandoning swap %v...", s.hash)
-
-	if !s.state.IsPending() {
-		return fmt.Errorf("cannot abandon swap in state %v", s.state)
-	}
-
-	s.setState(loopdb.StateFailAbandoned)
-
-	err := s.persistAndAnnounceState(ctx)
-	if err != nil {
-		return err
-	}
-
-	// If the invoice is already settled or canceled, this is a nop.
-	_ = s.lnd.Invoices.CancelInvoice(ctx, s.hash)
-
-	return fmt.Errorf("swap hash "+
-		"abandoned by client, "+
-		"swap ID: %v, %v",
-		s.hash, err)
-}
-
 // persistAndAnnounceState updates the swap state on disk and sends out an
 // update notification.
 func (s *loopInSwap) persistAndAnnounceState(ctx context.Context) error {
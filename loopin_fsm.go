@@ -0,0 +1,287 @@
+package loop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/loop/fsm"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// States of the loop in state machine. The states that are stored in the
+// database are named after the matching loopdb.SwapState.
+const (
+	// loopInInitiated is the state where we check whether the htlc can
+	// still be published.
+	loopInInitiated = fsm.StateType("Initiated")
+
+	// loopInPublishHtlc is the state where the htlc is published.
+	loopInPublishHtlc = fsm.StateType("PublishHtlc")
+
+	// loopInHtlcPublished is the state where the htlc is published and we
+	// wait for it to confirm and for the server to sweep it.
+	loopInHtlcPublished = fsm.StateType("HtlcPublished")
+
+	// loopInInvoiceSettled is the state where the swap invoice is settled
+	// and we wait for the spend of the htlc.
+	loopInInvoiceSettled = fsm.StateType("InvoiceSettled")
+
+	// loopInSuccess is the state where the htlc is swept by the server.
+	loopInSuccess = fsm.StateType("Success")
+
+	// loopInFailTimeout is the state where the htlc timed out, or where it
+	// became too late to publish it.
+	loopInFailTimeout = fsm.StateType("FailTimeout")
+
+	// loopInFailIncorrectHtlcAmt is the state where the value of the
+	// confirmed htlc doesn't match the swap amount. We wait for the htlc
+	// to time out to reclaim the funds.
+	loopInFailIncorrectHtlcAmt = fsm.StateType("FailIncorrectHtlcAmt")
+
+	// loopInFailIncorrectHtlcAmtSwept is the state where the htlc with the
+	// incorrect amount was swept by our timeout tx.
+	loopInFailIncorrectHtlcAmtSwept = fsm.StateType(
+		"FailIncorrectHtlcAmtSwept",
+	)
+
+	// loopInFailAbandoned is the state where the swap was abandoned by the
+	// client.
+	loopInFailAbandoned = fsm.StateType("FailAbandoned")
+
+	// loopInFailInsufficientConfirmedBalance is the state where the htlc
+	// couldn't be published, because the wallet doesn't have enough
+	// confirmed funds.
+	loopInFailInsufficientConfirmedBalance = fsm.StateType(
+		"FailInsufficientConfirmedBalance",
+	)
+
+	// loopInFailTemporary is the state where the execution of the swap was
+	// aborted by an unexpected error. The swap is resumed on restart.
+	loopInFailTemporary = fsm.StateType("FailTemporary")
+)
+
+// Events of the loop in state machine that aren't shared with the loop out
+// state machine.
+const (
+	// onPublishHtlc is sent if the htlc can still be published.
+	onPublishHtlc = fsm.EventType("OnPublishHtlc")
+
+	// onHtlcPublished is sent once the htlc is published.
+	onHtlcPublished = fsm.EventType("OnHtlcPublished")
+
+	// onInsufficientBalance is sent if the htlc couldn't be published.
+	onInsufficientBalance = fsm.EventType("OnInsufficientBalance")
+
+	// onIncorrectHtlcAmt is sent if the value of the confirmed htlc doesn't
+	// match the swap amount.
+	onIncorrectHtlcAmt = fsm.EventType("OnIncorrectHtlcAmt")
+
+	// onInvoiceSettled is sent if the swap invoice is settled before the
+	// htlc is spent.
+	onInvoiceSettled = fsm.EventType("OnInvoiceSettled")
+
+	// onAbandon is sent if the client requested the swap to be abandoned.
+	onAbandon = fsm.EventType("OnAbandon")
+)
+
+// loopInSwapStates maps the states of the loop in state machine to the swap
+// states that are stored in the database.
+var loopInSwapStates = map[fsm.StateType]loopdb.SwapState{
+	loopInInitiated:                        loopdb.StateInitiated,
+	loopInPublishHtlc:                      loopdb.StateHtlcPublished,
+	loopInHtlcPublished:                    loopdb.StateHtlcPublished,
+	loopInInvoiceSettled:                   loopdb.StateInvoiceSettled,
+	loopInSuccess:                          loopdb.StateSuccess,
+	loopInFailTimeout:                      loopdb.StateFailTimeout,
+	loopInFailIncorrectHtlcAmt:             loopdb.StateFailIncorrectHtlcAmt,
+	loopInFailIncorrectHtlcAmtSwept:        loopdb.StateFailIncorrectHtlcAmtSwept,
+	loopInFailAbandoned:                    loopdb.StateFailAbandoned,
+	loopInFailInsufficientConfirmedBalance: loopdb.StateFailInsufficientConfirmedBalance,
+}
+
+// loopInResumeState returns the state of the loop in state machine that a
+// swap in the given pending swap state is resumed in.
+func loopInResumeState(state loopdb.SwapState) fsm.StateType {
+	switch state {
+	case loopdb.StateInvoiceSettled:
+		return loopInInvoiceSettled
+
+	case loopdb.StateFailIncorrectHtlcAmt:
+		return loopInFailIncorrectHtlcAmt
+
+	default:
+		return loopInHtlcPublished
+	}
+}
+
+// LoopInStates returns the states of the loop in state machine.
+func LoopInStates() fsm.TypedStates[context.Context] {
+	return (&loopInSwap{}).getStates()
+}
+
+// getStates returns the states of the loop in state machine. Swaps that are
+// resumed from the database re-enter the state that matches their stored swap
+// state with the onRecover event.
+func (s *loopInSwap) getStates() fsm.TypedStates[context.Context] {
+	noOp := fsm.TypedNoOpAction[context.Context]
+
+	return fsm.TypedStates[context.Context]{
+		fsm.EmptyState: {
+			Transitions: fsm.Transitions{
+				onStart: loopInInitiated,
+			},
+		},
+		loopInInitiated: {
+			Transitions: fsm.Transitions{
+				onHtlcPublished: loopInHtlcPublished,
+				onPublishHtlc:   loopInPublishHtlc,
+				onTimeout:       loopInFailTimeout,
+				fsm.OnError:     loopInFailTemporary,
+			},
+			Action: s.initiatedAction,
+		},
+		loopInPublishHtlc: {
+			Transitions: fsm.Transitions{
+				onHtlcPublished:       loopInHtlcPublished,
+				onInsufficientBalance: loopInFailInsufficientConfirmedBalance,
+				onAbandon:             loopInFailAbandoned,
+				fsm.OnError:           loopInFailTemporary,
+			},
+			Action: s.publishHtlcAction,
+		},
+		loopInHtlcPublished: {
+			Transitions: fsm.Transitions{
+				onRecover:          loopInHtlcPublished,
+				onIncorrectHtlcAmt: loopInFailIncorrectHtlcAmt,
+				onInvoiceSettled:   loopInInvoiceSettled,
+				onHtlcSwept:        loopInSuccess,
+				onHtlcTimeoutSwept: loopInFailTimeout,
+				onAbandon:          loopInFailAbandoned,
+				fsm.OnError:        loopInFailTemporary,
+			},
+			Action: s.htlcPublishedAction,
+		},
+		loopInInvoiceSettled: {
+			Transitions: fsm.Transitions{
+				onRecover:          loopInInvoiceSettled,
+				onHtlcSwept:        loopInSuccess,
+				onHtlcTimeoutSwept: loopInFailTimeout,
+				onAbandon:          loopInFailAbandoned,
+				fsm.OnError:        loopInFailTemporary,
+			},
+			Action: s.invoiceSettledAction,
+		},
+		loopInFailIncorrectHtlcAmt: {
+			Transitions: fsm.Transitions{
+				onRecover:          loopInFailIncorrectHtlcAmt,
+				onHtlcSwept:        loopInSuccess,
+				onHtlcTimeoutSwept: loopInFailIncorrectHtlcAmtSwept,
+				onAbandon:          loopInFailAbandoned,
+				fsm.OnError:        loopInFailTemporary,
+			},
+			Action: s.waitForSwapComplete,
+		},
+		loopInSuccess: {
+			Action: noOp,
+		},
+		loopInFailTimeout: {
+			Action: noOp,
+		},
+		loopInFailIncorrectHtlcAmtSwept: {
+			Action: noOp,
+		},
+		loopInFailAbandoned: {
+			Action: s.abandonedAction,
+		},
+		loopInFailInsufficientConfirmedBalance: {
+			Action: noOp,
+		},
+		loopInFailTemporary: {
+			Action: noOp,
+		},
+	}
+}
+
+// Notify implements the fsm.TypedObserver interface. It persists the swap
+// state if the state machine transitioned into a state that is stored in the
+// database and announces it.
+func (s *loopInSwap) Notify(n fsm.TypedNotification[context.Context]) {
+	state, ok := loopInSwapStates[n.NextState]
+	if !ok || state == s.state {
+		return
+	}
+
+	s.setState(state)
+	s.persistErr = s.persistAndAnnounceState(n.EventContext)
+	if s.persistErr != nil {
+		s.log.Errorf("Unable to persist state %v: %v", state,
+			s.persistErr)
+	}
+}
+
+// htlcPublishedAction waits for the htlc to confirm and verifies its value.
+// Then it waits for the server to sweep the htlc.
+func (s *loopInSwap) htlcPublishedAction(ctx context.Context) fsm.EventType {
+	if s.persistErr != nil {
+		return s.stateMachine.HandleError(s.persistErr)
+	}
+
+	if event := s.confirmHtlc(ctx); event != fsm.NoOp {
+		return event
+	}
+
+	// Verify that the confirmed (external) htlc value matches the swap
+	// amount. If the amounts mismatch we update the swap state to indicate
+	// this, but end processing the swap. Instead, we continue to wait for
+	// the htlc to expire and publish a timeout tx to reclaim the funds.
+	if s.htlcValue != s.LoopInContract.AmountRequested {
+		return onIncorrectHtlcAmt
+	}
+
+	// The server is expected to see the htlc on-chain and know that it can
+	// sweep that htlc with the preimage, it should pay our swap invoice,
+	// receive the preimage and sweep the htlc. We are waiting for this to
+	// happen and simultaneously watch the htlc expiry height. When the htlc
+	// expires, we will publish a timeout tx to reclaim the funds.
+	return s.waitForSwapComplete(ctx)
+}
+
+// invoiceSettledAction accounts for the just settled swap invoice and
+// continues to wait for the spend of the htlc.
+func (s *loopInSwap) invoiceSettledAction(ctx context.Context) fsm.EventType {
+	if s.persistErr != nil {
+		return s.stateMachine.HandleError(s.persistErr)
+	}
+
+	if w := s.spendWatch; w != nil && w.settledAmt != nil {
+		s.invoiceSettled(ctx, *w.settledAmt)
+		w.settledAmt = nil
+	}
+
+	return s.waitForSwapComplete(ctx)
+}
+
+// abandon returns the event that abandons the swap, if it is still pending.
+func (s *loopInSwap) abandon() fsm.EventType {
+	s.log.Infof("Abandoning swap %v...", s.hash)
+
+	if !s.state.IsPending() {
+		return s.stateMachine.HandleError(
+			fmt.Errorf("cannot abandon swap in state %v", s.state),
+		)
+	}
+
+	return onAbandon
+}
+
+// abandonedAction cancels the swap invoice of the abandoned swap.
+func (s *loopInSwap) abandonedAction(ctx context.Context) fsm.EventType {
+	if s.persistErr != nil {
+		return fsm.NoOp
+	}
+
+	// If the invoice is already settled or canceled, this is a nop.
+	_ = s.lnd.Invoices.CancelInvoice(ctx, s.hash)
+
+	return fsm.NoOp
+}
@@ -0,0 +1,182 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/fsm"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	invpkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckLoopInStates statically checks the loop in states.
+func TestCheckLoopInStates(t *testing.T) {
+	var finalStates []fsm.StateType
+	for state, swapState := range loopInSwapStates {
+		if swapState.IsFinal() {
+			finalStates = append(finalStates, state)
+		}
+	}
+	finalStates = append(finalStates, loopInFailTemporary)
+
+	issues := fsm.CheckStates(LoopInStates(), &fsm.CheckConfig{
+		FinalStates: finalStates,
+		Events: []fsm.EventType{
+			onStart, onRecover, onPublishHtlc, onHtlcPublished,
+			onTimeout, onInsufficientBalance, onIncorrectHtlcAmt,
+			onInvoiceSettled, onHtlcSwept, onHtlcTimeoutSwept,
+			onAbandon,
+		},
+	})
+	require.Empty(t, issues)
+}
+
+// TestLoopInResumeState tests that pending loop in swaps are resumed in a
+// state that matches their stored swap state.
+func TestLoopInResumeState(t *testing.T) {
+	states := LoopInStates()
+
+	for _, swapState := range []loopdb.SwapState{
+		loopdb.StateHtlcPublished,
+		loopdb.StateInvoiceSettled,
+		loopdb.StateFailIncorrectHtlcAmt,
+	} {
+		state := loopInResumeState(swapState)
+		require.Equal(t, swapState, loopInSwapStates[state])
+
+		// The resumed state must accept the recover event.
+		require.Equal(
+			t, state, states[state].Transitions[onRecover],
+		)
+	}
+}
+
+// TestLoopInStateMachine drives the loop in state machine with lnd and server
+// events and asserts the states it goes through and the swap states that are
+// stored in the database.
+func TestLoopInStateMachine(t *testing.T) {
+	t.Run("success", testLoopInStateMachineSuccess)
+	t.Run("abandoned", testLoopInStateMachineAbandoned)
+}
+
+func testLoopInStateMachineSuccess(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := newLoopInTestContext(t)
+	machines := ctx.trackStateMachines()
+
+	_, err, inSwap := startNewLoopIn(t, ctx, 600)
+	require.NoError(t, err)
+
+	advanceToPublishedHtlc(t, ctx)
+
+	machine := requireTypedStateMachine(t, <-machines)
+	require.NoError(t, machine.DefaultObserver.WaitForState(
+		context.Background(), test.Timeout, loopInHtlcPublished,
+	))
+
+	// The server pays the swap invoice before it sweeps the htlc.
+	ctx.assertSubscribeInvoice(ctx.server.swapHash)
+	ctx.updateInvoiceState(49000, invpkg.ContractSettled)
+
+	ctx.assertState(loopdb.StateInvoiceSettled)
+	ctx.store.AssertLoopInState(loopdb.StateInvoiceSettled)
+
+	witness, err := inSwap.htlc.GenSuccessWitness(
+		[]byte{}, inSwap.contract.Preimage,
+	)
+	require.NoError(t, err)
+
+	successTx := wire.MsgTx{}
+	successTx.AddTxIn(&wire.TxIn{
+		Witness: witness,
+	})
+	ctx.lnd.SpendChannel <- &chainntnfs.SpendDetail{
+		SpendingTx:        &successTx,
+		SpenderInputIndex: 0,
+	}
+
+	ctx.assertState(loopdb.StateSuccess)
+	ctx.store.AssertLoopInState(loopdb.StateSuccess)
+	require.NoError(t, <-ctx.errChan)
+
+	assertStateHistory(
+		t, machine, fsm.EmptyState, loopInInitiated, loopInPublishHtlc,
+		loopInHtlcPublished, loopInInvoiceSettled, loopInSuccess,
+	)
+
+	// The published state is stored twice, the second time with the htlc
+	// tx hash.
+	assertLoopInUpdates(
+		t, ctx.store, inSwap.hash, loopdb.StateHtlcPublished,
+		loopdb.StateHtlcPublished, loopdb.StateInvoiceSettled,
+		loopdb.StateSuccess,
+	)
+}
+
+func testLoopInStateMachineAbandoned(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := newLoopInTestContext(t)
+	machines := ctx.trackStateMachines()
+
+	_, err, inSwap := startNewLoopIn(t, ctx, 600)
+	require.NoError(t, err)
+
+	advanceToPublishedHtlc(t, ctx)
+
+	machine := requireTypedStateMachine(t, <-machines)
+	require.NoError(t, machine.DefaultObserver.WaitForState(
+		context.Background(), test.Timeout, loopInHtlcPublished,
+	))
+
+	inSwap.abandonChan <- struct{}{}
+
+	ctx.assertState(loopdb.StateFailAbandoned)
+	ctx.store.AssertLoopInState(loopdb.StateFailAbandoned)
+	require.Error(t, <-ctx.errChan)
+
+	assertStateHistory(
+		t, machine, fsm.EmptyState, loopInInitiated, loopInPublishHtlc,
+		loopInHtlcPublished, loopInFailAbandoned,
+	)
+	assertLoopInUpdates(
+		t, ctx.store, inSwap.hash, loopdb.StateHtlcPublished,
+		loopdb.StateHtlcPublished, loopdb.StateFailAbandoned,
+	)
+}
+
+// requireTypedStateMachine asserts that the introspector is a loop state
+// machine.
+func requireTypedStateMachine(t *testing.T,
+	introspector fsm.Introspector) *fsm.TypedStateMachine[context.Context] {
+
+	t.Helper()
+
+	machine, ok := introspector.(*fsm.TypedStateMachine[context.Context])
+	require.True(t, ok)
+
+	return machine
+}
+
+// assertLoopInUpdates asserts the swap states that are stored for the loop in
+// swap with the given hash.
+func assertLoopInUpdates(t *testing.T, store *loopdb.StoreMock,
+	hash lntypes.Hash, expected ...loopdb.SwapState) {
+
+	t.Helper()
+
+	store.RLock()
+	defer store.RUnlock()
+
+	var states []loopdb.SwapState
+	for _, update := range store.LoopInUpdates[hash] {
+		states = append(states, update.State)
+	}
+	require.Equal(t, expected, states)
+}
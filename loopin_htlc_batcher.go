@@ -0,0 +1,521 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// defaultHtlcBatchWindow is the time the htlc batcher waits for further
+	// loop in swaps after the first swap of a batch asked for its htlc to
+	// be published.
+	defaultHtlcBatchWindow = 5 * time.Second
+)
+
+var (
+	// errHtlcBatchDropped is returned if a swap dropped out of its htlc
+	// batch before the batch was published.
+	errHtlcBatchDropped = errors.New("swap dropped out of htlc batch")
+
+	// errHtlcBatcherStopped is returned if the htlc batcher shut down
+	// before the htlc was published.
+	errHtlcBatcherStopped = errors.New("htlc batcher stopped")
+)
+
+// htlcPublishRequest is a request of a loop in swap to publish its htlc.
+type htlcPublishRequest struct {
+	// swapHash is the hash of the swap that owns the htlc.
+	swapHash lntypes.Hash
+
+	// output is the htlc output to be published.
+	output *wire.TxOut
+
+	// feeRate is the minimum fee rate the swap wants its htlc to be
+	// published with.
+	feeRate chainfee.SatPerKWeight
+
+	// maxFee is the maximum on-chain fee of the swap. A swap whose share
+	// of the batch fee would exceed it is published on its own. Zero means
+	// no limit.
+	maxFee btcutil.Amount
+
+	// resultChan receives the result of the publication.
+	resultChan chan *htlcPublishResult
+}
+
+// htlcPublishResult is the outcome of publishing a batch of htlcs.
+type htlcPublishResult struct {
+	// tx is the transaction that contains the htlc output. It may be
+	// shared with other swaps.
+	tx *wire.MsgTx
+
+	// fee is the swap's share of the on-chain fee of the transaction.
+	fee btcutil.Amount
+
+	// batchSize is the number of htlcs published in the transaction.
+	batchSize int
+
+	// err is set if the htlc could not be published.
+	err error
+
+	// abandoned is set if the swap was abandoned while its batch was
+	// being published.
+	abandoned bool
+}
+
+// htlcDropRequest is a request to remove a swap from the pending batch.
+type htlcDropRequest struct {
+	swapHash lntypes.Hash
+
+	// droppedChan is sent true if the swap was removed before the batch
+	// was published.
+	droppedChan chan bool
+}
+
+// htlcBatcher combines the htlcs of loop in swaps that are published at
+// roughly the same time into a single transaction with multiple htlc
+// outputs. The first request of a batch opens a window during which further
+// requests join the batch. Once the window closes, all htlcs are published
+// together at the highest fee rate of the batch. Only the swaps dispatched by
+// autoloop use the batcher, all other swaps publish their htlcs right away.
+type htlcBatcher struct {
+	walletKit lndclient.WalletKitClient
+
+	// lightning is used to look up a batch transaction that was published
+	// even though SendOutputs returned an error.
+	lightning lndclient.LightningClient
+
+	// batchWindow is the time to wait for further swaps after the first
+	// swap joined a batch.
+	batchWindow time.Duration
+
+	// timerFactory creates the timer that closes the batch window.
+	timerFactory func(time.Duration) <-chan time.Time
+
+	requestChan chan *htlcPublishRequest
+	dropChan    chan *htlcDropRequest
+
+	// done is closed once the batcher stopped.
+	done chan struct{}
+}
+
+// newHtlcBatcher creates a new htlc batcher.
+func newHtlcBatcher(walletKit lndclient.WalletKitClient,
+	lightning lndclient.LightningClient,
+	batchWindow time.Duration) *htlcBatcher {
+
+	return &htlcBatcher{
+		walletKit:    walletKit,
+		lightning:    lightning,
+		batchWindow:  batchWindow,
+		timerFactory: time.After,
+		requestChan:  make(chan *htlcPublishRequest),
+		dropChan:     make(chan *htlcDropRequest),
+		done:         make(chan struct{}),
+	}
+}
+
+// Run starts the event loop of the batcher. It blocks until the passed
+// context is canceled.
+func (b *htlcBatcher) Run(ctx context.Context) error {
+	defer close(b.done)
+
+	var (
+		pending     []*htlcPublishRequest
+		batchWindow <-chan time.Time
+	)
+
+	for {
+		select {
+		case req := <-b.requestChan:
+			pending = append(pending, req)
+
+			// The first swap of a batch starts the batch window.
+			if batchWindow == nil {
+				batchWindow = b.timerFactory(b.batchWindow)
+			}
+
+		case req := <-b.dropChan:
+			var dropped bool
+			for i, pendingReq := range pending {
+				if pendingReq.swapHash != req.swapHash {
+					continue
+				}
+
+				pending = append(pending[:i], pending[i+1:]...)
+				dropped = true
+
+				break
+			}
+
+			if len(pending) == 0 {
+				batchWindow = nil
+			}
+
+			log.Debugf("Loop in %v drop out of htlc batch: %v",
+				req.swapHash, dropped)
+
+			req.droppedChan <- dropped
+
+		case <-batchWindow:
+			b.publishBatch(ctx, pending)
+
+			pending = nil
+			batchWindow = nil
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// publish adds the htlc of a swap to the pending batch and waits for the
+// batch to be published. If the abandon channel fires before the batch is
+// published, the swap drops out of the batch and errHtlcBatchDropped is
+// returned. If the batch was already published, the result is returned with
+// abandoned set, so that the swap records its htlc before it is abandoned.
+func (b *htlcBatcher) publish(ctx context.Context, req *htlcPublishRequest,
+	abandonChan <-chan struct{}) (*htlcPublishResult, error) {
+
+	req.resultChan = make(chan *htlcPublishResult, 1)
+
+	select {
+	case b.requestChan <- req:
+
+	case <-b.done:
+		return nil, errHtlcBatcherStopped
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case result := <-req.resultChan:
+		if result.err != nil {
+			return nil, result.err
+		}
+
+		return result, nil
+
+	case <-abandonChan:
+		result := b.dropOut(req)
+
+		// Nothing was published, so the swap can be abandoned right
+		// away.
+		if result == nil || result.err != nil {
+			return nil, errHtlcBatchDropped
+		}
+
+		result.abandoned = true
+
+		return result, nil
+
+	case <-ctx.Done():
+		// An htlc that made it to the network must still be recorded
+		// by the swap.
+		result := b.dropOut(req)
+		if result == nil || result.err != nil {
+			return nil, ctx.Err()
+		}
+
+		return result, nil
+	}
+}
+
+// dropOut removes the swap of the passed request from the pending batch. If
+// the batch of the swap was published before the swap could drop out, the
+// result of the publication is returned. Otherwise nil is returned.
+func (b *htlcBatcher) dropOut(req *htlcPublishRequest) *htlcPublishResult {
+	if b.drop(req.swapHash) {
+		// The batcher may have published the batch right before it
+		// stopped, in which case the result is already waiting.
+		select {
+		case result := <-req.resultChan:
+			return result
+
+		default:
+			return nil
+		}
+	}
+
+	// The batch is only published within the event loop of the batcher,
+	// so the result was already delivered when the swap wasn't pending
+	// anymore.
+	return <-req.resultChan
+}
+
+// drop removes the swap from the pending batch and returns true if the swap
+// was removed before the batch was published.
+func (b *htlcBatcher) drop(swapHash lntypes.Hash) bool {
+	req := &htlcDropRequest{
+		swapHash:    swapHash,
+		droppedChan: make(chan bool, 1),
+	}
+
+	select {
+	case b.dropChan <- req:
+
+	case <-b.done:
+		return true
+	}
+
+	select {
+	case dropped := <-req.droppedChan:
+		return dropped
+
+	case <-b.done:
+		return true
+	}
+}
+
+// publishBatch publishes the htlcs of the passed requests in a single
+// transaction and reports the result to every request. Swaps whose share of
+// the batch fee would exceed their maximum fee are published on their own. If
+// the combined transaction can't be published, for example because the wallet
+// balance is insufficient for all htlcs, every htlc is published on its own
+// instead, unless the wallet knows the batch transaction nonetheless.
+func (b *htlcBatcher) publishBatch(ctx context.Context,
+	reqs []*htlcPublishRequest) {
+
+	if len(reqs) == 0 {
+		return
+	}
+
+	batch, single := splitHtlcBatch(reqs)
+	for _, req := range single {
+		log.Infof("Publishing loop in %v htlc on its own as its share "+
+			"of the batch fee exceeds its max miner fee %v",
+			req.swapHash, req.maxFee)
+
+		_ = publishHtlcTx(
+			ctx, b.walletKit, []*htlcPublishRequest{req},
+		)
+	}
+
+	if len(batch) == 0 {
+		return
+	}
+
+	err := publishHtlcTx(ctx, b.walletKit, batch)
+	if err == nil || len(batch) == 1 {
+		return
+	}
+
+	// SendOutputs may fail after the batch transaction was broadcast, in
+	// which case publishing the htlcs individually would pay them twice.
+	// We therefore only fall back if the wallet doesn't know a
+	// transaction that pays the htlcs.
+	tx, lookupErr := findHtlcTx(ctx, b.lightning, batch)
+	switch {
+	case lookupErr != nil:
+		log.Errorf("Unable to publish batch of %v htlcs: %v, unable "+
+			"to look up batch tx: %v", len(batch), err, lookupErr)
+
+		for _, req := range batch {
+			req.resultChan <- &htlcPublishResult{err: err}
+		}
+
+		return
+
+	case tx != nil:
+		log.Warnf("Batch htlc tx %v was published despite error: %v",
+			tx.TxHash, err)
+
+		fee := tx.Fee
+		if fee == 0 {
+			fee = getTxFee(tx.Tx, batchFeeRate(batch).FeePerKVByte())
+		}
+		reportHtlcTx(batch, tx.Tx, fee)
+
+		return
+	}
+
+	log.Warnf("Unable to publish batch of %v htlcs, publishing them "+
+		"individually: %v", len(batch), err)
+
+	for _, req := range batch {
+		_ = publishHtlcTx(
+			ctx, b.walletKit, []*htlcPublishRequest{req},
+		)
+	}
+}
+
+// splitHtlcBatch splits the passed requests into those that are published
+// together and those whose estimated share of the batch fee would exceed
+// their maximum fee. As the batch fee rate and size change when swaps are
+// removed, the shares are estimated again until all remaining swaps can
+// afford their share.
+func splitHtlcBatch(reqs []*htlcPublishRequest) ([]*htlcPublishRequest,
+	[]*htlcPublishRequest) {
+
+	var (
+		batch  = reqs
+		single []*htlcPublishRequest
+	)
+	for len(batch) > 1 {
+		totalFee := estimateHtlcBatchFee(batch, batchFeeRate(batch))
+
+		// The first swap also pays the remainder of the split, so we
+		// check every swap against the largest share.
+		numReqs := btcutil.Amount(len(batch))
+		share := totalFee - totalFee/numReqs*(numReqs-1)
+
+		remaining := make([]*htlcPublishRequest, 0, len(batch))
+		for _, req := range batch {
+			if req.maxFee != 0 && share > req.maxFee {
+				single = append(single, req)
+				continue
+			}
+
+			remaining = append(remaining, req)
+		}
+
+		if len(remaining) == len(batch) {
+			break
+		}
+
+		batch = remaining
+	}
+
+	return batch, single
+}
+
+// estimateHtlcBatchFee estimates the fee of a transaction that publishes the
+// htlcs of the passed requests at the given fee rate. The estimate is
+// conservative as it assumes that every htlc is funded by its own input.
+func estimateHtlcBatchFee(reqs []*htlcPublishRequest,
+	feeRate chainfee.SatPerKWeight) btcutil.Amount {
+
+	var weightEstimate input.TxWeightEstimator
+	for _, req := range reqs {
+		weightEstimate.AddP2WKHInput()
+		weightEstimate.AddOutput(req.output.PkScript)
+	}
+
+	// Add the change output.
+	weightEstimate.AddP2TROutput()
+
+	return feeRate.FeeForWeight(weightEstimate.Weight())
+}
+
+// batchFeeRate returns the highest fee rate of the passed requests, so that
+// every swap gets at least the fee rate it asked for.
+func batchFeeRate(reqs []*htlcPublishRequest) chainfee.SatPerKWeight {
+	var feeRate chainfee.SatPerKWeight
+	for _, req := range reqs {
+		if req.feeRate > feeRate {
+			feeRate = req.feeRate
+		}
+	}
+
+	return feeRate
+}
+
+// findHtlcTx looks for a wallet transaction that pays all htlcs of the passed
+// requests. It returns nil if there is no such transaction.
+func findHtlcTx(ctx context.Context, lightning lndclient.LightningClient,
+	reqs []*htlcPublishRequest) (*lndclient.Transaction, error) {
+
+	txs, err := lightning.ListTransactions(ctx, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, tx := range txs {
+		if tx.Tx == nil {
+			continue
+		}
+
+		pkScripts := make(map[string]struct{}, len(tx.Tx.TxOut))
+		for _, txOut := range tx.Tx.TxOut {
+			pkScripts[string(txOut.PkScript)] = struct{}{}
+		}
+
+		paysAll := true
+		for _, req := range reqs {
+			_, ok := pkScripts[string(req.output.PkScript)]
+			if !ok {
+				paysAll = false
+				break
+			}
+		}
+
+		if paysAll {
+			return &txs[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// publishHtlcTx publishes the htlcs of the passed requests in a single
+// transaction. The result is only reported to the requests if the transaction
+// was published or if there is just a single request, as batches that failed
+// are retried individually.
+func publishHtlcTx(ctx context.Context, walletKit lndclient.WalletKitClient,
+	reqs []*htlcPublishRequest) error {
+
+	var (
+		outputs     = make([]*wire.TxOut, 0, len(reqs))
+		shortHashes = make([]string, 0, len(reqs))
+		feeRate     = batchFeeRate(reqs)
+	)
+	for _, req := range reqs {
+		outputs = append(outputs, req.output)
+		shortHashes = append(shortHashes, swap.ShortHash(&req.swapHash))
+	}
+
+	label := labels.LoopInHtlcLabel(shortHashes[0])
+	if len(reqs) > 1 {
+		label = labels.LoopInBatchHtlcLabel(shortHashes)
+	}
+
+	tx, err := walletKit.SendOutputs(ctx, outputs, feeRate, label)
+	if err != nil {
+		if len(reqs) == 1 {
+			reqs[0].resultChan <- &htlcPublishResult{err: err}
+		}
+
+		return err
+	}
+
+	reportHtlcTx(reqs, tx, getTxFee(tx, feeRate.FeePerKVByte()))
+
+	return nil
+}
+
+// reportHtlcTx reports the published transaction that pays the htlcs of the
+// passed requests to every request. The fee is split evenly between the swaps
+// of the batch. Any remainder is charged to the first swap.
+func reportHtlcTx(reqs []*htlcPublishRequest, tx *wire.MsgTx,
+	totalFee btcutil.Amount) {
+
+	feeShare := totalFee / btcutil.Amount(len(reqs))
+	remainder := totalFee - feeShare*btcutil.Amount(len(reqs))
+
+	log.Infof("Published htlc tx %v for %v loop in swaps (fee: %v)",
+		tx.TxHash(), len(reqs), totalFee)
+
+	for i, req := range reqs {
+		fee := feeShare
+		if i == 0 {
+			fee += remainder
+		}
+
+		req.resultChan <- &htlcPublishResult{
+			tx:        tx,
+			fee:       fee,
+			batchSize: len(reqs),
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestHtlcRequest creates a publish request for an htlc with a unique
+// pkscript.
+func newTestHtlcRequest(id byte,
+	feeRate chainfee.SatPerKWeight) *htlcPublishRequest {
+
+	return &htlcPublishRequest{
+		swapHash: lntypes.Hash{id},
+		output: &wire.TxOut{
+			PkScript: []byte{0x00, 0x20, id},
+			Value:    100000,
+		},
+		feeRate:    feeRate,
+		resultChan: make(chan *htlcPublishResult, 1),
+	}
+}
+
+// TestHtlcBatcher tests that htlcs requested within the batch window are
+// published in a shared transaction and that swaps can drop out of a batch
+// before it is published, but still get the result once it is published.
+func TestHtlcBatcher(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	windowChan := make(chan time.Time)
+
+	batcher := newHtlcBatcher(lnd.WalletKit, lnd.Client, time.Minute)
+	batcher.timerFactory = func(time.Duration) <-chan time.Time {
+		return windowChan
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- batcher.Run(ctx)
+	}()
+
+	// Add three swaps to the batch and let the first one drop out.
+	req1 := newTestHtlcRequest(1, 1000)
+	req2 := newTestHtlcRequest(2, 2000)
+	req3 := newTestHtlcRequest(3, 1500)
+	batcher.requestChan <- req1
+	batcher.requestChan <- req2
+	batcher.requestChan <- req3
+
+	require.True(t, batcher.drop(req1.swapHash))
+
+	// Closing the batch window publishes the remaining htlcs in a single
+	// transaction.
+	windowChan <- time.Now()
+
+	tx := <-lnd.SendOutputsChannel
+	require.Len(t, tx.TxOut, 2)
+	require.Equal(t, req2.output.PkScript, tx.TxOut[0].PkScript)
+	require.Equal(t, req3.output.PkScript, tx.TxOut[1].PkScript)
+
+	result2 := <-req2.resultChan
+	result3 := <-req3.resultChan
+	require.NoError(t, result2.err)
+	require.NoError(t, result3.err)
+	require.Equal(t, tx.TxHash(), result2.tx.TxHash())
+	require.Equal(t, tx.TxHash(), result3.tx.TxHash())
+	require.Equal(t, 2, result2.batchSize)
+
+	// The fee is split between the swaps and based on the highest fee
+	// rate of the batch.
+	totalFee := getTxFee(&tx, chainfee.SatPerKWeight(2000).FeePerKVByte())
+	require.Equal(t, totalFee, result2.fee+result3.fee)
+	require.GreaterOrEqual(t, result2.fee, result3.fee)
+
+	// After the batch was published, swaps can no longer drop out.
+	require.False(t, batcher.drop(req2.swapHash))
+
+	// A swap that drops out of a batch through the abandon channel gets
+	// errHtlcBatchDropped.
+	abandonChan := make(chan struct{}, 1)
+	abandonChan <- struct{}{}
+	_, err := batcher.publish(
+		context.Background(), newTestHtlcRequest(4, 1000), abandonChan,
+	)
+	require.ErrorIs(t, err, errHtlcBatchDropped)
+
+	// A swap that is abandoned while its batch is being published gets
+	// the result of the publication, so that it can record its htlc
+	// before it is abandoned.
+	abandonChan = make(chan struct{})
+	resultChan := make(chan *htlcPublishResult, 1)
+	go func() {
+		result, err := batcher.publish(
+			context.Background(), newTestHtlcRequest(6, 1000),
+			abandonChan,
+		)
+		require.NoError(t, err)
+		resultChan <- result
+	}()
+
+	windowChan <- time.Now()
+	abandonChan <- struct{}{}
+	tx = <-lnd.SendOutputsChannel
+
+	result := <-resultChan
+	require.True(t, result.abandoned)
+	require.Equal(t, tx.TxHash(), result.tx.TxHash())
+
+	// The same applies to a swap that shuts down while its batch is being
+	// published.
+	publishCtx, publishCancel := context.WithCancel(context.Background())
+	go func() {
+		result, err := batcher.publish(
+			publishCtx, newTestHtlcRequest(7, 1000), nil,
+		)
+		require.NoError(t, err)
+		resultChan <- result
+	}()
+
+	windowChan <- time.Now()
+	publishCancel()
+	tx = <-lnd.SendOutputsChannel
+
+	result = <-resultChan
+	require.False(t, result.abandoned)
+	require.Equal(t, tx.TxHash(), result.tx.TxHash())
+
+	cancel()
+	require.ErrorIs(t, <-runErr, context.Canceled)
+
+	// Once the batcher stopped, new htlcs are rejected.
+	_, err = batcher.publish(
+		context.Background(), newTestHtlcRequest(5, 1000), nil,
+	)
+	require.ErrorIs(t, err, errHtlcBatcherStopped)
+}
+
+// failingSendOutputsWalletKit is a wallet kit whose SendOutputs fails for
+// transactions with more than one output. If published is set, the
+// transaction is published before the error is returned.
+type failingSendOutputsWalletKit struct {
+	lndclient.WalletKitClient
+
+	published bool
+}
+
+// SendOutputs fails for batch transactions.
+func (w *failingSendOutputsWalletKit) SendOutputs(ctx context.Context,
+	outputs []*wire.TxOut, feeRate chainfee.SatPerKWeight,
+	label string) (*wire.MsgTx, error) {
+
+	if len(outputs) == 1 {
+		return w.WalletKitClient.SendOutputs(
+			ctx, outputs, feeRate, label,
+		)
+	}
+
+	if w.published {
+		_, err := w.WalletKitClient.SendOutputs(
+			ctx, outputs, feeRate, label,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, errors.New("send outputs failed")
+}
+
+// TestHtlcBatchPublishFailure tests that the htlcs of a batch that failed to
+// be published are only published individually if the wallet doesn't know the
+// batch transaction.
+func TestHtlcBatchPublishFailure(t *testing.T) {
+	defer test.Guard(t)()
+
+	tests := []struct {
+		name      string
+		published bool
+	}{
+		{
+			name:      "batch not published",
+			published: false,
+		},
+		{
+			name:      "batch published",
+			published: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			lnd := test.NewMockLnd()
+			walletKit := &failingSendOutputsWalletKit{
+				WalletKitClient: lnd.WalletKit,
+				published:       testCase.published,
+			}
+			batcher := newHtlcBatcher(
+				walletKit, lnd.Client, time.Minute,
+			)
+
+			req1 := newTestHtlcRequest(1, 1000)
+			req2 := newTestHtlcRequest(2, 1000)
+
+			done := make(chan struct{})
+			go func() {
+				batcher.publishBatch(
+					context.Background(),
+					[]*htlcPublishRequest{req1, req2},
+				)
+				close(done)
+			}()
+
+			if testCase.published {
+				// The batch tx is published once and shared by
+				// both swaps.
+				tx := <-lnd.SendOutputsChannel
+				require.Len(t, tx.TxOut, 2)
+				<-done
+
+				result1 := <-req1.resultChan
+				result2 := <-req2.resultChan
+				require.NoError(t, result1.err)
+				require.NoError(t, result2.err)
+				require.Equal(t, tx.TxHash(), result1.tx.TxHash())
+				require.Equal(t, tx.TxHash(), result2.tx.TxHash())
+				require.Equal(t, 2, result1.batchSize)
+
+				return
+			}
+
+			// Both htlcs are published on their own.
+			tx1 := <-lnd.SendOutputsChannel
+			tx2 := <-lnd.SendOutputsChannel
+			<-done
+
+			require.Len(t, tx1.TxOut, 1)
+			require.Len(t, tx2.TxOut, 1)
+
+			result1 := <-req1.resultChan
+			result2 := <-req2.resultChan
+			require.Equal(t, tx1.TxHash(), result1.tx.TxHash())
+			require.Equal(t, tx2.TxHash(), result2.tx.TxHash())
+			require.Equal(t, 1, result1.batchSize)
+		})
+	}
+}
+
+// TestSplitHtlcBatch tests that swaps whose share of the batch fee exceeds
+// their maximum fee are removed from the batch.
+func TestSplitHtlcBatch(t *testing.T) {
+	req1 := newTestHtlcRequest(1, 1000)
+	req2 := newTestHtlcRequest(2, 5000)
+	req3 := newTestHtlcRequest(3, 1000)
+
+	// The share of the batch fee at the highest fee rate exceeds the max
+	// fee of the first swap.
+	share := estimateHtlcBatchFee(
+		[]*htlcPublishRequest{req1, req2, req3}, 5000,
+	) / 3
+	req1.maxFee = share / 2
+
+	// The last swap can afford its share once the first swap left.
+	req3.maxFee = share * 2
+
+	batch, single := splitHtlcBatch(
+		[]*htlcPublishRequest{req1, req2, req3},
+	)
+	require.Equal(t, []*htlcPublishRequest{req2, req3}, batch)
+	require.Equal(t, []*htlcPublishRequest{req1}, single)
+
+	// A swap that can't afford its share of any batch is published on its
+	// own as well.
+	req3.maxFee = btcutil.Amount(1)
+	batch, single = splitHtlcBatch(
+		[]*htlcPublishRequest{req1, req2, req3},
+	)
+	require.Equal(t, []*htlcPublishRequest{req2}, batch)
+	require.ElementsMatch(t, []*htlcPublishRequest{req1, req3}, single)
+}
@@ -0,0 +1,424 @@
+package loop
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// htlcBumpDelta is the number of blocks before the on-chain htlc
+	// expiry from which on the fee of an unconfirmed htlc tx is bumped.
+	htlcBumpDelta = int32(144)
+
+	// htlcBumpConfTarget is the confirmation target that is used to
+	// estimate the fee rate of a bumped htlc tx.
+	htlcBumpConfTarget = int32(2)
+)
+
+var (
+	// errHtlcFeeBumperUnavailable is returned if an htlc tx should be
+	// bumped, but no connection to lnd's wallet kit is available that
+	// allows us to do so.
+	errHtlcFeeBumperUnavailable = errors.New("htlc fee bumping is not " +
+		"available")
+)
+
+// htlcFeeBumper is the interface that is used to bump the fee of an htlc tx
+// by spending one of its outputs in a child tx.
+type htlcFeeBumper interface {
+	// BumpFee asks lnd's sweeper to spend the passed outpoint right away
+	// at the given starting fee rate. The fee of the child tx never
+	// exceeds the passed budget.
+	BumpFee(ctx context.Context, outpoint wire.OutPoint,
+		feeRate chainfee.SatPerKWeight, budget btcutil.Amount) error
+}
+
+// lndHtlcFeeBumper implements the htlcFeeBumper interface using lnd's wallet
+// kit sub-server directly, as lndclient doesn't expose the budget of a fee
+// bump.
+type lndHtlcFeeBumper struct {
+	lnd       *lndclient.LndServices
+	walletKit walletrpc.WalletKitClient
+}
+
+// newLndHtlcFeeBumper creates a new htlc fee bumper that uses the gRPC
+// connection of the passed lnd services.
+func newLndHtlcFeeBumper(lnd *lndclient.LndServices) *lndHtlcFeeBumper {
+	bumper := &lndHtlcFeeBumper{
+		lnd: lnd,
+	}
+
+	if lnd.ClientConn != nil {
+		bumper.walletKit = walletrpc.NewWalletKitClient(lnd.ClientConn)
+	}
+
+	return bumper
+}
+
+// BumpFee asks lnd's sweeper to spend the passed outpoint right away at the
+// given starting fee rate. The fee of the child tx never exceeds the passed
+// budget.
+//
+// NOTE: This is part of the htlcFeeBumper interface.
+func (l *lndHtlcFeeBumper) BumpFee(ctx context.Context, outpoint wire.OutPoint,
+	feeRate chainfee.SatPerKWeight, budget btcutil.Amount) error {
+
+	if l.walletKit == nil {
+		return errHtlcFeeBumperUnavailable
+	}
+
+	rpcCtx, err := l.lnd.WithMacaroonAuthForService(
+		ctx, lndclient.WalletKitServiceMac,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = l.walletKit.BumpFee(rpcCtx, &walletrpc.BumpFeeRequest{
+		Outpoint: &lnrpc.OutPoint{
+			TxidBytes:   outpoint.Hash[:],
+			OutputIndex: outpoint.Index,
+		},
+		SatPerVbyte: uint64(feeRate.FeePerVByte()),
+		Immediate:   true,
+		Budget:      uint64(budget),
+	})
+
+	return err
+}
+
+// htlcBump tracks the fee bumps of an unconfirmed htlc tx. The htlc tx is
+// bumped by spending its change output in a child tx (CPFP) that pays for
+// both transactions.
+type htlcBump struct {
+	// tx is the htlc tx.
+	tx *wire.MsgTx
+
+	// fee is the fee paid by the htlc tx itself.
+	fee btcutil.Amount
+
+	// changeOutpoint is the wallet controlled output of the htlc tx that
+	// the child tx spends. It is nil if the htlc tx can't be bumped.
+	changeOutpoint *wire.OutPoint
+
+	// changeValue is the value of the change output.
+	changeValue btcutil.Amount
+
+	// childFee is the fee of the latest child tx. Every new child
+	// replaces the previous one, so only the latest fee is paid.
+	childFee btcutil.Amount
+
+	// requestedFee is the child fee of the latest fee bump request. A
+	// replacement is only requested if it pays more than that.
+	requestedFee btcutil.Amount
+
+	// requestTime is the time of the latest fee bump request.
+	requestTime time.Time
+}
+
+// newHtlcBump inspects the passed htlc tx and returns the bump state for it.
+// Only htlc txes that contain our htlc and exactly one change output can be
+// bumped. The caller needs to make sure that the tx isn't shared with other
+// swaps, as their htlcs would be mistaken for change. Batched htlc txes are
+// therefore never bumped, as none of the swaps could charge the child fee to
+// its own max miner fee.
+func newHtlcBump(tx *wire.MsgTx, fee btcutil.Amount,
+	htlcPkScript []byte) *htlcBump {
+
+	bump := &htlcBump{
+		tx:  tx,
+		fee: fee,
+	}
+
+	if len(tx.TxOut) != 2 {
+		return bump
+	}
+
+	for i, txOut := range tx.TxOut {
+		if bytes.Equal(txOut.PkScript, htlcPkScript) {
+			continue
+		}
+
+		bump.changeOutpoint = &wire.OutPoint{
+			Hash:  tx.TxHash(),
+			Index: uint32(i),
+		}
+		bump.changeValue = btcutil.Amount(txOut.Value)
+	}
+
+	return bump
+}
+
+// childWeight returns the estimated weight of a child tx that sweeps the
+// change output of the htlc tx back into the wallet.
+func (b *htlcBump) childWeight() lntypes.WeightUnit {
+	var estimator input.TxWeightEstimator
+
+	changeScript := b.tx.TxOut[b.changeOutpoint.Index].PkScript
+	if txscript.IsPayToTaproot(changeScript) {
+		estimator.AddTaprootKeySpendInput(txscript.SigHashDefault)
+	} else {
+		estimator.AddP2WKHInput()
+	}
+	estimator.AddP2TROutput()
+
+	return estimator.Weight()
+}
+
+// maybeBumpHtlc bumps the fee of our unconfirmed htlc tx if the htlc expiry
+// approaches and the current fee estimate exceeds the fee rate the htlc tx
+// is currently paying for. The total fee is bounded by the maximum miner fee
+// of the swap and the additional cost is added to the on-chain cost of the
+// swap. Htlc txes that are shared with other swaps of an htlc batch are not
+// bumped. Bumping is best effort, so failures are only logged.
+func (s *loopInSwap) maybeBumpHtlc(ctx context.Context) {
+	// External htlcs are not published by us, so we can't bump them.
+	if s.htlcFeeBumper == nil || s.ExternalHtlc || s.htlcTxHash == nil ||
+		s.state != loopdb.StateHtlcPublished {
+
+		return
+	}
+
+	blocksRemaining := s.CltvExpiry - s.height
+	if blocksRemaining > htlcBumpDelta ||
+		blocksRemaining < MinLoopInPublishDelta {
+
+		return
+	}
+
+	if s.htlcBump == nil {
+		bump, err := s.lookupHtlcBump(ctx)
+		if err != nil {
+			s.log.Warnf("Unable to look up htlc tx for fee "+
+				"bumping: %v", err)
+
+			return
+		}
+
+		s.htlcBump = bump
+	}
+
+	if s.htlcBump.changeOutpoint == nil {
+		s.log.Infof("Htlc tx %v has no change output that can be "+
+			"used to bump its fee", s.htlcTxHash)
+
+		return
+	}
+
+	err := s.bumpHtlc(ctx)
+	if err != nil {
+		s.log.Warnf("Unable to bump htlc tx fee: %v", err)
+	}
+}
+
+// lookupHtlcBump fetches our htlc tx from the wallet. This is needed for swaps
+// that were resumed after a restart, as the htlc tx is only kept in memory.
+func (s *loopInSwap) lookupHtlcBump(ctx context.Context) (*htlcBump, error) {
+	txs, err := s.lnd.Client.ListTransactions(ctx, s.InitiationHeight, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		if tx.Tx == nil || tx.Tx.TxHash() != *s.htlcTxHash {
+			continue
+		}
+
+		bump := newHtlcBump(tx.Tx, tx.Fee, s.htlcPkScript())
+		if bump.changeOutpoint == nil {
+			return bump, nil
+		}
+
+		// The htlc tx may have been shared with other swaps, so we
+		// make sure that the presumed change output is ours.
+		utxos, err := s.lnd.WalletKit.ListUnspent(ctx, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		isChange := false
+		for _, utxo := range utxos {
+			if utxo.OutPoint == *bump.changeOutpoint {
+				isChange = true
+				break
+			}
+		}
+		if !isChange {
+			bump.changeOutpoint = nil
+		}
+
+		return bump, nil
+	}
+
+	return nil, fmt.Errorf("htlc tx %v not found in wallet", s.htlcTxHash)
+}
+
+// htlcPkScript returns the pk script of the htlc that we published.
+func (s *loopInSwap) htlcPkScript() []byte {
+	if IsTaprootSwap(&s.SwapContract) {
+		return s.htlcP2TR.PkScript
+	}
+
+	return s.htlcP2WSH.PkScript
+}
+
+// bumpHtlc publishes a child tx for the htlc tx that raises the fee rate of
+// the package to the current fee estimate, if that is higher than the fee
+// rate already paid. The child is published by lnd's sweeper with a budget of
+// the max miner fee that the htlc tx left over, and the fee it actually paid
+// is read back from the wallet.
+func (s *loopInSwap) bumpHtlc(ctx context.Context) error {
+	bump := s.htlcBump
+
+	// The sweeper may have published the previous child after we
+	// recorded its fee, so we first update the cost with the actual fee.
+	if bump.childFee != 0 {
+		err := s.updateHtlcChildFee(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	feeRate, err := s.lnd.WalletKit.EstimateFeeRate(
+		ctx, htlcBumpConfTarget,
+	)
+	if err != nil {
+		return fmt.Errorf("estimate fee: %w", err)
+	}
+
+	parentWeight := lntypes.WeightUnit(
+		blockchain.GetTransactionWeight(btcutil.NewTx(bump.tx)),
+	)
+	childWeight := bump.childWeight()
+
+	// The child needs to pay for the weight of both transactions at the
+	// target fee rate, minus what the htlc tx already paid.
+	childFee := feeRate.FeeForWeight(parentWeight+childWeight) - bump.fee
+
+	// Never exceed the maximum miner fee of the swap.
+	maxChildFee := s.MaxMinerFee - bump.fee
+	if childFee > maxChildFee {
+		s.log.Infof("Capping htlc bump fee %v to %v to stay within "+
+			"max miner fee %v", childFee, maxChildFee,
+			s.MaxMinerFee)
+
+		childFee = maxChildFee
+	}
+
+	// A replacement child needs to pay more than the previous one. We
+	// also can't spend more than the change output holds.
+	if childFee <= bump.requestedFee || childFee >= bump.changeValue {
+		return nil
+	}
+
+	childFeeRate := chainfee.SatPerKWeight(
+		childFee * 1000 / btcutil.Amount(childWeight),
+	)
+	if childFeeRate < chainfee.FeePerKwFloor {
+		return nil
+	}
+
+	s.log.Infof("Bumping fee of htlc tx %v via %v at fee rate %v "+
+		"(child fee: %v)", s.htlcTxHash, bump.changeOutpoint,
+		childFeeRate, childFee)
+
+	err = s.htlcFeeBumper.BumpFee(
+		ctx, *bump.changeOutpoint, childFeeRate, maxChildFee,
+	)
+	if err != nil {
+		return fmt.Errorf("bump fee: %w", err)
+	}
+
+	// Until the child shows up in the wallet, we account for the fee we
+	// asked for, which is bounded by the budget of the bump.
+	bump.childFee = childFee
+	bump.requestedFee = childFee
+	bump.requestTime = time.Now()
+
+	return s.updateHtlcChildFee(ctx)
+}
+
+// updateHtlcChildFee reads the fee of the latest child of the htlc tx back
+// from the wallet and stores the on-chain cost of the swap. The new child
+// replaces the previous one, so the on-chain cost is the fee of the htlc tx
+// plus the fee of the latest child. If the child isn't known to the wallet
+// yet, the last requested child fee is used instead.
+func (s *loopInSwap) updateHtlcChildFee(ctx context.Context) error {
+	bump := s.htlcBump
+
+	txs, err := s.lnd.Client.ListTransactions(ctx, s.InitiationHeight, -1)
+	if err != nil {
+		return fmt.Errorf("list transactions: %w", err)
+	}
+
+	// The wallet may still list children that were replaced, so we pick
+	// the confirmed child or otherwise the latest one.
+	var child *lndclient.Transaction
+	for i, tx := range txs {
+		if tx.Tx == nil || tx.Fee == 0 || !spendsOutpoint(
+			tx.Tx, *bump.changeOutpoint,
+		) {
+
+			continue
+		}
+
+		switch {
+		case child == nil:
+			child = &txs[i]
+
+		case child.Confirmations > 0:
+
+		case tx.Confirmations > 0 || tx.Timestamp.After(child.Timestamp):
+			child = &txs[i]
+		}
+	}
+
+	// A child that was published before our latest request doesn't
+	// reflect the requested fee yet. Wallet timestamps only have second
+	// precision.
+	if child != nil && (child.Confirmations > 0 || !child.Timestamp.Before(
+		bump.requestTime.Truncate(time.Second),
+	)) {
+
+		bump.childFee = child.Fee
+	}
+
+	onchainCost := bump.fee + bump.childFee
+	if onchainCost == s.cost.Onchain {
+		return nil
+	}
+
+	s.log.Infof("On-chain cost of htlc tx %v and its child: %v",
+		s.htlcTxHash, onchainCost)
+
+	s.cost.Onchain = onchainCost
+	s.lastUpdateTime = time.Now()
+
+	return s.persistState(ctx)
+}
+
+// spendsOutpoint returns true if the passed tx spends the given outpoint.
+func spendsOutpoint(tx *wire.MsgTx, outpoint wire.OutPoint) bool {
+	for _, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint == outpoint {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,190 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// mockHtlcFeeBumper is an htlcFeeBumper that forwards bump requests to the
+// mock lnd services and publishes a child that pays the requested fee rate
+// minus a discount.
+type mockHtlcFeeBumper struct {
+	lnd *test.LndMockServices
+
+	// discount is subtracted from the requested fee to obtain the fee
+	// the child actually pays.
+	discount btcutil.Amount
+
+	// budget is the budget of the latest bump request.
+	budget btcutil.Amount
+}
+
+// BumpFee records the budget and publishes a child tx that spends the passed
+// outpoint.
+//
+// NOTE: This is part of the htlcFeeBumper interface.
+func (m *mockHtlcFeeBumper) BumpFee(_ context.Context, outpoint wire.OutPoint,
+	feeRate chainfee.SatPerKWeight, budget btcutil.Amount) error {
+
+	m.budget = budget
+
+	var estimator input.TxWeightEstimator
+	estimator.AddP2WKHInput()
+	estimator.AddP2TROutput()
+	fee := feeRate.FeeForWeight(estimator.Weight()) - m.discount
+
+	child := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: outpoint,
+		}},
+	}
+	m.lnd.Transactions = append(m.lnd.Transactions, lndclient.Transaction{
+		Tx:        child,
+		Fee:       fee,
+		Timestamp: time.Now(),
+	})
+
+	m.lnd.BumpFeeChannel <- test.BumpFeeRequest{
+		Outpoint: outpoint,
+		FeeRate:  feeRate,
+	}
+
+	return nil
+}
+
+// TestLoopInHtlcBump tests that an unconfirmed htlc tx is bumped via its change
+// output once the htlc expiry approaches and that the total fee is bounded by
+// the max miner fee of the swap.
+func TestLoopInHtlcBump(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := newLoopInTestContext(t)
+	cfg := newSwapConfig(&ctx.lnd.LndServices, ctx.store, ctx.server)
+
+	initResult, err := newLoopInSwap(
+		context.Background(), cfg, 600, &testLoopInRequest,
+	)
+	require.NoError(t, err)
+	ctx.store.AssertLoopInStored()
+
+	s := initResult.swap
+	s.MaxMinerFee = 20000
+
+	bumper := &mockHtlcFeeBumper{
+		lnd:      ctx.lnd,
+		discount: 10,
+	}
+	s.htlcFeeBumper = bumper
+
+	// Create an htlc tx that pays a low fee and has a change output.
+	const htlcTxFee = btcutil.Amount(500)
+	changeScript := make([]byte, input.P2WPKHSize)
+	changeScript[0], changeScript[1] = 0x00, 0x14
+
+	htlcTx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: 1},
+			Witness:          [][]byte{make([]byte, 72), {2}},
+		}},
+		TxOut: []*wire.TxOut{
+			{
+				Value:    int64(s.AmountRequested),
+				PkScript: s.htlcPkScript(),
+			},
+			{
+				Value:    100000,
+				PkScript: changeScript,
+			},
+		},
+	}
+	htlcTxHash := htlcTx.TxHash()
+
+	s.htlcBump = newHtlcBump(htlcTx, htlcTxFee, s.htlcPkScript())
+	s.htlcTxHash = &htlcTxHash
+	s.state = loopdb.StateHtlcPublished
+	s.cost.Onchain = htlcTxFee
+
+	// maybeBump attempts to bump the htlc tx and returns the bump request
+	// if one was made.
+	maybeBump := func() *test.BumpFeeRequest {
+		done := make(chan struct{})
+		go func() {
+			s.maybeBumpHtlc(context.Background())
+			close(done)
+		}()
+
+		select {
+		case req := <-ctx.lnd.BumpFeeChannel:
+			<-done
+			ctx.store.AssertLoopInState(loopdb.StateHtlcPublished)
+
+			return &req
+
+		case <-done:
+			return nil
+		}
+	}
+
+	// Far from the htlc expiry we don't bump the htlc tx.
+	ctx.lnd.SetFeeEstimate(htlcBumpConfTarget, 10000)
+	s.height = s.CltvExpiry - htlcBumpDelta - 1
+	require.Nil(t, maybeBump())
+
+	// Once we're within the bump delta, the change output is spent by a
+	// child that pays for both transactions at the estimated fee rate.
+	s.height = s.CltvExpiry - htlcBumpDelta
+	req := maybeBump()
+	require.NotNil(t, req)
+	require.Equal(t, wire.OutPoint{Hash: htlcTxHash, Index: 1}, req.Outpoint)
+
+	var estimator input.TxWeightEstimator
+	estimator.AddP2WKHInput()
+	estimator.AddP2TROutput()
+	childWeight := estimator.Weight()
+	parentWeight := lntypes.WeightUnit(
+		blockchain.GetTransactionWeight(btcutil.NewTx(htlcTx)),
+	)
+	packageFee := chainfee.SatPerKWeight(10000).FeeForWeight(
+		parentWeight + childWeight,
+	)
+
+	require.Equal(t, chainfee.SatPerKWeight(
+		(packageFee-htlcTxFee)*1000/btcutil.Amount(childWeight),
+	), req.FeeRate)
+
+	// The budget of the child is what the htlc tx left of the max miner
+	// fee and the on-chain cost is based on the fee that the child
+	// actually paid.
+	childFee := req.FeeRate.FeeForWeight(childWeight) - bumper.discount
+	require.Equal(t, s.MaxMinerFee-htlcTxFee, bumper.budget)
+	require.Equal(t, htlcTxFee+childFee, s.cost.Onchain)
+
+	// Without a higher fee estimate there is nothing to replace.
+	require.Nil(t, maybeBump())
+
+	// A spike in fees is capped at the max miner fee of the swap.
+	ctx.lnd.SetFeeEstimate(htlcBumpConfTarget, 100000)
+	req = maybeBump()
+	require.NotNil(t, req)
+	childFee = req.FeeRate.FeeForWeight(childWeight) - bumper.discount
+	require.LessOrEqual(t, childFee, s.MaxMinerFee-htlcTxFee)
+	require.Equal(t, htlcTxFee+childFee, s.cost.Onchain)
+
+	// Once we're too close to the expiry, we no longer bump.
+	ctx.lnd.SetFeeEstimate(htlcBumpConfTarget, 200000)
+	s.height = s.CltvExpiry - MinLoopInPublishDelta + 1
+	require.Nil(t, maybeBump())
+}
@@ -0,0 +1,141 @@
+package loop
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// presignTimeoutTxs signs timeout txs of the confirmed htlc at the configured
+// fee rates and stores them, unless this happened before. The txs can be
+// handed to a third party that publishes one of them once the htlc expired,
+// in case we are offline at that time.
+func (s *loopInSwap) presignTimeoutTxs(ctx context.Context) error {
+	if len(s.presignedTimeoutFeeRates) == 0 {
+		return nil
+	}
+
+	stored, err := s.store.FetchPresignedTimeoutTxs(ctx, s.hash)
+	if err != nil {
+		return err
+	}
+	if len(stored) > 0 {
+		return nil
+	}
+
+	// The presigned txs pay to the same address as our own timeout tx.
+	if s.timeoutAddr == nil {
+		s.timeoutAddr, err = s.lnd.WalletKit.NextAddr(
+			ctx, "", walletrpc.AddressType_WITNESS_PUBKEY_HASH,
+			false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	pkScript, err := txscript.PayToAddrScript(s.timeoutAddr)
+	if err != nil {
+		return err
+	}
+	dustLimit := lnwallet.DustLimitForSize(len(pkScript))
+
+	senderKey, err := btcec.ParsePubKey(s.HtlcKeys.SenderScriptKey[:])
+	if err != nil {
+		return err
+	}
+
+	info := &clientHtlc{
+		htlc: s.htlc,
+		keyDesc: keychain.KeyDescriptor{
+			PubKey: senderKey,
+		},
+		isLoopIn: true,
+		contract: &s.SwapContract,
+	}
+
+	var txs []*loopdb.PresignedTimeoutTx
+	for _, satPerVbyte := range s.presignedTimeoutFeeRates {
+		fee, err := htlcSpendFee(info, s.timeoutAddr, satPerVbyte)
+		if err != nil {
+			return err
+		}
+
+		// The fee rates are sorted, so higher fee rates would leave
+		// even less for the output.
+		if s.htlcValue-fee < dustLimit {
+			break
+		}
+
+		tx, err := createHtlcSpendTx(
+			ctx, s.lnd.Signer, info, *s.htlcOutpoint, s.htlcValue,
+			fee, s.timeoutAddr, s.height,
+		)
+		if err != nil {
+			return err
+		}
+
+		txs = append(txs, &loopdb.PresignedTimeoutTx{
+			SatPerVbyte: satPerVbyte,
+			Fee:         fee,
+			Tx:          tx,
+		})
+	}
+
+	if len(txs) == 0 {
+		s.log.Warnf("Htlc value %v too low to presign timeout txs",
+			s.htlcValue)
+
+		return nil
+	}
+
+	s.log.Infof("Presigned %v timeout txs to addr %v", len(txs),
+		s.timeoutAddr)
+
+	return s.store.StorePresignedTimeoutTxs(ctx, s.hash, txs)
+}
+
+// PresignedTimeouts returns the presigned timeout txs of the htlcs of all
+// pending loop in swaps. If a swap hash is given, only the txs of that swap
+// are returned.
+func (s *Client) PresignedTimeouts(ctx context.Context,
+	swapHash *lntypes.Hash) ([]*PresignedTimeouts, error) {
+
+	loopIns, err := s.Store.FetchLoopInSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var timeouts []*PresignedTimeouts
+	for _, loopIn := range loopIns {
+		if swapHash != nil && loopIn.Hash != *swapHash {
+			continue
+		}
+
+		if swapHash == nil && !loopIn.State().State.IsPending() {
+			continue
+		}
+
+		txs, err := s.Store.FetchPresignedTimeoutTxs(ctx, loopIn.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if len(txs) == 0 {
+			continue
+		}
+
+		timeouts = append(timeouts, &PresignedTimeouts{
+			SwapHash:   loopIn.Hash,
+			CltvExpiry: loopIn.Contract.CltvExpiry,
+			Txs:        txs,
+		})
+	}
+
+	return timeouts, nil
+}
@@ -0,0 +1,86 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPresignTimeoutTxs tests that timeout txs of a confirmed loop in htlc are
+// presigned once at all fee rates that leave a non-dust output, and that they
+// are returned for the pending swap.
+func TestPresignTimeoutTxs(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctxb := context.Background()
+	client, lnd, store, htlc := newExternalLoopInTest(t)
+
+	hash := testPreimage.Hash()
+	contract := store.LoopInSwaps[hash]
+
+	s := &loopInSwap{
+		swapKit: swapKit{
+			hash: hash,
+			log: &swap.PrefixLog{
+				Hash:   hash,
+				Logger: log,
+			},
+			swapConfig: swapConfig{
+				lnd:   &lnd.LndServices,
+				store: store,
+			},
+		},
+		executeConfig: executeConfig{
+			// The highest fee rate exceeds the htlc value.
+			presignedTimeoutFeeRates: []uint64{2, 10, 1000},
+		},
+		LoopInContract: *contract,
+		htlc:           htlc,
+		htlcOutpoint:   &wire.OutPoint{Index: 1},
+		htlcValue:      contract.AmountRequested,
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.presignTimeoutTxs(ctxb)
+	}()
+
+	for i := 0; i < 2; i++ {
+		signReq := <-lnd.SignOutputRawChannel
+		require.Equal(
+			t, htlc.TimeoutScript(),
+			signReq.SignDescriptors[0].WitnessScript,
+		)
+	}
+	require.NoError(t, <-errChan)
+
+	txs := store.PresignedTimeoutTxs[hash]
+	require.Len(t, txs, 2)
+	for i, satPerVbyte := range []uint64{2, 10} {
+		tx := txs[i].Tx
+
+		require.Equal(t, satPerVbyte, txs[i].SatPerVbyte)
+		require.EqualValues(t, contract.CltvExpiry, tx.LockTime)
+		require.Equal(t, *s.htlcOutpoint, tx.TxIn[0].PreviousOutPoint)
+		require.EqualValues(
+			t, s.htlcValue-txs[i].Fee, tx.TxOut[0].Value,
+		)
+	}
+	require.Less(t, txs[0].Fee, txs[1].Fee)
+
+	// The timeout txs are only signed once.
+	require.NoError(t, s.presignTimeoutTxs(ctxb))
+	require.Len(t, store.PresignedTimeoutTxs[hash], 2)
+
+	timeouts, err := client.PresignedTimeouts(ctxb, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*PresignedTimeouts{{
+		SwapHash:   hash,
+		CltvExpiry: contract.CltvExpiry,
+		Txs:        txs,
+	}}, timeouts)
+}
@@ -0,0 +1,381 @@
+package loop
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// ErrNotExternalLoopIn is returned if a PSBT is requested for a swap
+	// that is not an external loop in swap.
+	ErrNotExternalLoopIn = errors.New("swap is not an external loop in " +
+		"swap")
+
+	// ErrHtlcAlreadyPublished is returned if the htlc of an external loop
+	// in swap has already been published.
+	ErrHtlcAlreadyPublished = errors.New("htlc already published")
+
+	// ErrHtlcFeeTooHigh is returned if the on-chain fee of a PSBT funding
+	// the htlc exceeds the maximum miner fee of the swap.
+	ErrHtlcFeeTooHigh = errors.New("htlc transaction fee exceeds " +
+		"maximum miner fee")
+)
+
+// FundLoopInPsbt creates a funded but unsigned PSBT that pays the htlc of a
+// pending external loop in swap. The inputs of the PSBT are locked in lnd's
+// wallet until the signed PSBT is published or the lease expires.
+func (s *Client) FundLoopInPsbt(ctx context.Context,
+	req *FundLoopInPsbtRequest) (*FundLoopInPsbtResponse, error) {
+
+	loopIn, htlc, err := s.fetchExternalLoopIn(ctx, req.SwapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if loopIn.State().HtlcTxHash != nil {
+		return nil, ErrHtlcAlreadyPublished
+	}
+
+	template := &walletrpc.TxTemplate{
+		Outputs: map[string]uint64{
+			htlc.Address.String(): uint64(
+				loopIn.Contract.AmountRequested,
+			),
+		},
+	}
+	for _, utxo := range req.Utxos {
+		template.Inputs = append(template.Inputs, &lnrpc.OutPoint{
+			TxidBytes:   utxo.Hash[:],
+			OutputIndex: utxo.Index,
+		})
+	}
+
+	fundReq := &walletrpc.FundPsbtRequest{
+		Template: &walletrpc.FundPsbtRequest_Raw{
+			Raw: template,
+		},
+		Account: req.Account,
+	}
+
+	// Custom accounts only support taproot change outputs.
+	if req.Account != "" {
+		fundReq.ChangeType =
+			walletrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_P2TR
+	}
+
+	switch {
+	case req.SatPerVbyte != 0:
+		fundReq.Fees = &walletrpc.FundPsbtRequest_SatPerVbyte{
+			SatPerVbyte: req.SatPerVbyte,
+		}
+
+	case req.ConfTarget != 0:
+		fundReq.Fees = &walletrpc.FundPsbtRequest_TargetConf{
+			TargetConf: uint32(req.ConfTarget),
+		}
+
+	default:
+		fundReq.Fees = &walletrpc.FundPsbtRequest_TargetConf{
+			TargetConf: uint32(loopIn.Contract.HtlcConfTarget),
+		}
+	}
+
+	packet, changeIndex, leases, err := s.lndServices.WalletKit.FundPsbt(
+		ctx, fundReq,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund psbt: %w", err)
+	}
+
+	// If the funded PSBT turns out to be unusable, we release the inputs
+	// again so they are available for coin selection right away.
+	fail := func(err error) (*FundLoopInPsbtResponse, error) {
+		s.releaseLeases(ctx, leases)
+
+		return nil, err
+	}
+
+	htlcIndex, err := verifyHtlcOutput(
+		packet.UnsignedTx, htlc, loopIn.Contract.AmountRequested,
+	)
+	if err != nil {
+		return fail(err)
+	}
+
+	fee, err := packet.GetTxFee()
+	if err != nil {
+		return fail(fmt.Errorf("unable to determine psbt fee: %w", err))
+	}
+
+	if fee > loopIn.Contract.MaxMinerFee {
+		return fail(fmt.Errorf("%w: fee %v, maximum %v",
+			ErrHtlcFeeTooHigh, fee, loopIn.Contract.MaxMinerFee))
+	}
+
+	log.Infof("Funded psbt for loop in %v htlc (fee: %v)", req.SwapHash,
+		fee)
+
+	return &FundLoopInPsbtResponse{
+		Packet:            packet,
+		HtlcOutputIndex:   htlcIndex,
+		ChangeOutputIndex: changeIndex,
+		Fee:               fee,
+	}, nil
+}
+
+// PublishLoopInPsbt verifies that the passed signed PSBT pays the htlc of a
+// pending external loop in swap with the exact swap amount, finalizes it and
+// publishes the resulting transaction. The transaction is handed to the
+// running swap which persists it once it is published.
+func (s *Client) PublishLoopInPsbt(ctx context.Context, hash lntypes.Hash,
+	packet *psbt.Packet) (*wire.MsgTx, error) {
+
+	// Hold the lock while verifying and publishing so that concurrent
+	// calls can't fund the same htlc twice.
+	s.loopInPsbtMu.Lock()
+	defer s.loopInPsbtMu.Unlock()
+
+	loopIn, htlc, err := s.fetchExternalLoopIn(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = verifyHtlcOutput(
+		packet.UnsignedTx, htlc, loopIn.Contract.AmountRequested,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := packet.GetTxFee()
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine psbt fee: %w", err)
+	}
+
+	if fee > loopIn.Contract.MaxMinerFee {
+		return nil, fmt.Errorf("%w: fee %v, maximum %v",
+			ErrHtlcFeeTooHigh, fee, loopIn.Contract.MaxMinerFee)
+	}
+
+	// The PSBT may already have been finalized by the signer, in which
+	// case this is a no-op.
+	if err := psbt.MaybeFinalizeAll(packet); err != nil {
+		return nil, fmt.Errorf("unable to finalize psbt: %w", err)
+	}
+
+	tx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract signed tx: %w", err)
+	}
+
+	// Hand the transaction to the swap which publishes it and only then
+	// persists it, so that a failed publish doesn't lock the swap to a
+	// transaction that never made it to the network.
+	if err := s.handOverExternalHtlcTx(ctx, hash, tx); err != nil {
+		return nil, err
+	}
+
+	txHash := tx.TxHash()
+	log.Infof("Published loop in %v htlc tx %v (fee: %v)", hash, txHash,
+		fee)
+
+	return tx, nil
+}
+
+// handOverExternalHtlcTx hands the passed htlc tx to the running loop in swap
+// with the passed hash and waits until the swap published and persisted it.
+func (s *Client) handOverExternalHtlcTx(ctx context.Context, hash lntypes.Hash,
+	tx *wire.MsgTx) error {
+
+	s.executor.Lock()
+	htlcChan, ok := s.externalHtlcChans[hash]
+	s.executor.Unlock()
+
+	if !ok {
+		return fmt.Errorf("loop in swap %v is not running", hash)
+	}
+
+	htlcTx := &externalHtlcTx{
+		tx:      tx,
+		errChan: make(chan error, 1),
+	}
+
+	select {
+	case htlcChan <- htlcTx:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-htlcTx.errChan:
+		return err
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// fetchExternalLoopIn returns the pending external loop in swap with the
+// passed hash together with its htlc.
+func (s *Client) fetchExternalLoopIn(ctx context.Context,
+	hash lntypes.Hash) (*loopdb.LoopIn, *swap.Htlc, error) {
+
+	loopIn, err := s.Store.FetchLoopInSwap(ctx, hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loop in swap %v not found: %w",
+			hash, err)
+	}
+
+	if !loopIn.Contract.ExternalHtlc {
+		return nil, nil, ErrNotExternalLoopIn
+	}
+
+	state := loopIn.State().State
+	if state != loopdb.StateInitiated &&
+		state != loopdb.StateHtlcPublished {
+
+		return nil, nil, fmt.Errorf("cannot fund htlc of swap in "+
+			"state %v", state)
+	}
+
+	htlc, err := utils.GetHtlc(
+		hash, &loopIn.Contract.SwapContract, s.lndServices.ChainParams,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return loopIn, htlc, nil
+}
+
+// externalHtlcTx is an externally signed htlc tx that is handed to the loop in
+// swap to be published.
+type externalHtlcTx struct {
+	tx *wire.MsgTx
+
+	// errChan receives the result of publishing and persisting the tx.
+	errChan chan error
+}
+
+// publishExternalHtlcTx publishes the passed externally signed htlc tx and
+// persists its hash once the publish succeeded. We only allow a single htlc
+// transaction per swap. Handing over the same transaction again is fine
+// though, as it might have been dropped from the mempool and needs to be
+// re-published.
+func (s *loopInSwap) publishExternalHtlcTx(ctx context.Context,
+	tx *wire.MsgTx) error {
+
+	txHash := tx.TxHash()
+	if s.htlcTxHash != nil && *s.htlcTxHash != txHash {
+		return fmt.Errorf("%w: %v", ErrHtlcAlreadyPublished,
+			s.htlcTxHash)
+	}
+
+	label := labels.LoopInHtlcLabel(swap.ShortHash(&s.hash))
+	err := s.lnd.WalletKit.PublishTransaction(ctx, tx, label)
+	if err != nil {
+		return fmt.Errorf("unable to publish htlc tx: %w", err)
+	}
+
+	// A re-published transaction is already persisted.
+	if s.htlcTxHash != nil {
+		return nil
+	}
+
+	s.log.Infof("External htlc tx %v published", txHash)
+
+	s.htlcTxHash = &txHash
+	s.lastUpdateTime = time.Now()
+
+	if err := s.persistAndAnnounceState(ctx); err != nil {
+		return fmt.Errorf("unable to persist htlc tx: %w", err)
+	}
+
+	return nil
+}
+
+// releaseLeases releases the passed utxo leases. Failures are only logged as
+// the leases expire eventually.
+func (s *Client) releaseLeases(ctx context.Context,
+	leases []*walletrpc.UtxoLease) {
+
+	for _, lease := range leases {
+		var lockID wtxmgr.LockID
+		copy(lockID[:], lease.Id)
+
+		if lease.Outpoint == nil {
+			continue
+		}
+
+		hash, err := chainhash.NewHash(lease.Outpoint.TxidBytes)
+		if err != nil {
+			log.Warnf("Invalid leased outpoint: %v", err)
+			continue
+		}
+		outpoint := wire.OutPoint{
+			Hash:  *hash,
+			Index: lease.Outpoint.OutputIndex,
+		}
+
+		err = s.lndServices.WalletKit.ReleaseOutput(
+			ctx, lockID, outpoint,
+		)
+		if err != nil {
+			log.Warnf("Unable to release %v: %v", outpoint, err)
+		}
+	}
+}
+
+// verifyHtlcOutput checks that the passed transaction contains exactly one
+// output paying to the htlc and that this output carries the swap amount. The
+// index of the htlc output is returned.
+func verifyHtlcOutput(tx *wire.MsgTx, htlc *swap.Htlc,
+	amt btcutil.Amount) (uint32, error) {
+
+	var (
+		htlcIndex uint32
+		found     bool
+	)
+	for i, txOut := range tx.TxOut {
+		if !bytes.Equal(txOut.PkScript, htlc.PkScript) {
+			continue
+		}
+
+		if found {
+			return 0, errors.New("transaction contains more " +
+				"than one htlc output")
+		}
+
+		if btcutil.Amount(txOut.Value) != amt {
+			return 0, fmt.Errorf("htlc output value %v does not "+
+				"match swap amount %v",
+				btcutil.Amount(txOut.Value), amt)
+		}
+
+		htlcIndex = uint32(i)
+		found = true
+	}
+
+	if !found {
+		return 0, fmt.Errorf("transaction does not pay to htlc "+
+			"address %v", htlc.Address)
+	}
+
+	return htlcIndex, nil
+}
@@ -0,0 +1,280 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightninglabs/loop/utils"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// newExternalLoopInTest stores a pending external loop in swap in a mock store
+// and returns a client that uses it together with the swap's htlc.
+func newExternalLoopInTest(t *testing.T) (*Client, *test.LndMockServices,
+	*loopdb.StoreMock, *swap.Htlc) {
+
+	lnd := test.NewMockLnd()
+	store := loopdb.NewStoreMock(t)
+
+	_, senderPubKey := test.CreateKey(1)
+	_, receiverPubKey := test.CreateKey(2)
+
+	var senderKey, receiverKey [33]byte
+	copy(receiverKey[:], receiverPubKey.SerializeCompressed())
+	copy(senderKey[:], senderPubKey.SerializeCompressed())
+
+	contract := &loopdb.LoopInContract{
+		HtlcConfTarget: 2,
+		ExternalHtlc:   true,
+		SwapContract: loopdb.SwapContract{
+			Preimage:        testPreimage,
+			AmountRequested: 100000,
+			CltvExpiry:      744,
+			HtlcKeys: loopdb.HtlcKeys{
+				SenderScriptKey:        senderKey,
+				SenderInternalPubKey:   senderKey,
+				ReceiverScriptKey:      receiverKey,
+				ReceiverInternalPubKey: receiverKey,
+			},
+			MaxSwapFee:      60000,
+			MaxMinerFee:     5000,
+			ProtocolVersion: loopdb.ProtocolVersionMuSig2,
+		},
+	}
+
+	hash := testPreimage.Hash()
+	store.LoopInSwaps[hash] = contract
+	store.LoopInUpdates[hash] = []loopdb.SwapStateData{{
+		State: loopdb.StateHtlcPublished,
+	}}
+
+	htlc, err := utils.GetHtlc(
+		hash, &contract.SwapContract, lnd.ChainParams,
+	)
+	require.NoError(t, err)
+
+	client := &Client{
+		clientConfig: clientConfig{
+			LndServices: &lnd.LndServices,
+			Store:       store,
+		},
+		lndServices: &lnd.LndServices,
+		executor:    &executor{},
+		externalHtlcChans: make(
+			map[lntypes.Hash]chan *externalHtlcTx,
+		),
+	}
+
+	return client, lnd, store, htlc
+}
+
+// runExternalLoopIn registers a loop in swap for the pending external loop in
+// swap of the passed client that persists the htlc txs handed over to it until
+// the test finishes.
+func runExternalLoopIn(t *testing.T, client *Client,
+	lnd *test.LndMockServices, store *loopdb.StoreMock, htlc *swap.Htlc) {
+
+	hash := testPreimage.Hash()
+	contract := store.LoopInSwaps[hash]
+
+	inSwap := &loopInSwap{
+		swapKit: *newSwapKit(
+			hash, swap.TypeIn,
+			newSwapConfig(&lnd.LndServices, store, nil),
+			&contract.SwapContract,
+		),
+		executeConfig: executeConfig{
+			statusChan: make(chan SwapInfo, 10),
+		},
+		LoopInContract:   *contract,
+		htlcP2TR:         htlc,
+		externalHtlcChan: make(chan *externalHtlcTx),
+	}
+	inSwap.state = loopdb.StateHtlcPublished
+
+	client.externalHtlcChans[hash] = inSwap.externalHtlcChan
+
+	quit := make(chan struct{})
+	t.Cleanup(func() {
+		close(quit)
+	})
+	go func() {
+		for {
+			select {
+			case htlcTx := <-inSwap.externalHtlcChan:
+				htlcTx.errChan <- inSwap.publishExternalHtlcTx(
+					context.Background(), htlcTx.tx,
+				)
+
+			case <-quit:
+				return
+			}
+		}
+	}()
+}
+
+// signedHtlcPsbt creates a finalized PSBT that spends a single input of the
+// given value and pays the passed amount to the htlc.
+func signedHtlcPsbt(t *testing.T, htlc *swap.Htlc, inputValue,
+	htlcValue btcutil.Amount, prevIndex uint32) *psbt.Packet {
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: prevIndex},
+	})
+	tx.AddTxOut(&wire.TxOut{
+		Value:    int64(htlcValue),
+		PkScript: htlc.PkScript,
+	})
+
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	require.NoError(t, err)
+
+	packet.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    int64(inputValue),
+		PkScript: []byte{0x51},
+	}
+
+	// A single empty witness element is enough to mark the input as
+	// finalized.
+	packet.Inputs[0].FinalScriptWitness = []byte{0x01, 0x00}
+
+	return packet
+}
+
+// failingWalletKit wraps a wallet kit and fails to publish transactions while
+// err is set.
+type failingWalletKit struct {
+	lndclient.WalletKitClient
+
+	err error
+}
+
+// PublishTransaction publishes the transaction unless err is set.
+func (w *failingWalletKit) PublishTransaction(ctx context.Context,
+	tx *wire.MsgTx, label string) error {
+
+	if w.err != nil {
+		return w.err
+	}
+
+	return w.WalletKitClient.PublishTransaction(ctx, tx, label)
+}
+
+// TestPublishLoopInPsbt tests that signed PSBTs for external loop in swaps are
+// verified before they are published and that the htlc tx hash is persisted by
+// the swap.
+func TestPublishLoopInPsbt(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctxb := context.Background()
+	hash := testPreimage.Hash()
+
+	t.Run("wrong amount", func(t *testing.T) {
+		client, _, _, htlc := newExternalLoopInTest(t)
+
+		packet := signedHtlcPsbt(t, htlc, 110000, 99999, 0)
+		_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+		require.ErrorContains(t, err, "does not match swap amount")
+	})
+
+	t.Run("fee too high", func(t *testing.T) {
+		client, _, _, htlc := newExternalLoopInTest(t)
+
+		packet := signedHtlcPsbt(t, htlc, 110000, 100000, 0)
+		_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+		require.ErrorIs(t, err, ErrHtlcFeeTooHigh)
+	})
+
+	t.Run("not external", func(t *testing.T) {
+		client, _, store, htlc := newExternalLoopInTest(t)
+		store.LoopInSwaps[hash].ExternalHtlc = false
+
+		packet := signedHtlcPsbt(t, htlc, 101000, 100000, 0)
+		_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+		require.ErrorIs(t, err, ErrNotExternalLoopIn)
+	})
+
+	t.Run("swap not running", func(t *testing.T) {
+		client, _, _, htlc := newExternalLoopInTest(t)
+
+		packet := signedHtlcPsbt(t, htlc, 101000, 100000, 0)
+		_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+		require.ErrorContains(t, err, "is not running")
+	})
+
+	t.Run("publish failure", func(t *testing.T) {
+		client, lnd, store, htlc := newExternalLoopInTest(t)
+
+		walletKit := &failingWalletKit{
+			WalletKitClient: lnd.WalletKit,
+			err:             errors.New("insufficient fee"),
+		}
+		lnd.WalletKit = walletKit
+		runExternalLoopIn(t, client, lnd, store, htlc)
+
+		// A transaction that fails to publish isn't persisted.
+		packet := signedHtlcPsbt(t, htlc, 101000, 100000, 0)
+		_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+		require.ErrorIs(t, err, walletKit.err)
+		require.Len(t, store.LoopInUpdates[hash], 1)
+
+		// So the user can fund the htlc with a different transaction.
+		walletKit.err = nil
+		packet = signedHtlcPsbt(t, htlc, 101000, 100000, 1)
+
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+			errChan <- err
+		}()
+
+		tx := <-lnd.TxPublishChannel
+
+		state := store.AssertLoopInState(loopdb.StateHtlcPublished)
+		require.Equal(t, tx.TxHash(), *state.HtlcTxHash)
+		require.NoError(t, <-errChan)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		client, lnd, store, htlc := newExternalLoopInTest(t)
+		runExternalLoopIn(t, client, lnd, store, htlc)
+
+		packet := signedHtlcPsbt(t, htlc, 101000, 100000, 0)
+
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+			errChan <- err
+		}()
+
+		tx := <-lnd.TxPublishChannel
+
+		state := store.AssertLoopInState(loopdb.StateHtlcPublished)
+		require.NotNil(t, state.HtlcTxHash)
+		require.Equal(t, *state.HtlcTxHash, tx.TxHash())
+		require.NoError(t, <-errChan)
+
+		// Re-publishing the same transaction is allowed, but a
+		// different transaction for the same htlc is rejected.
+		go func() {
+			_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+			errChan <- err
+		}()
+		<-lnd.TxPublishChannel
+		require.NoError(t, <-errChan)
+
+		packet = signedHtlcPsbt(t, htlc, 101000, 100000, 1)
+		_, err := client.PublishLoopInPsbt(ctxb, hash, packet)
+		require.ErrorIs(t, err, ErrHtlcAlreadyPublished)
+	})
+}
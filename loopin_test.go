@@ -7,7 +7,9 @@ import (
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/sweepbatcher"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightninglabs/loop/utils"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -272,6 +274,22 @@ func testLoopInTimeout(t *testing.T, externalValue int64) {
 	// Expect register for htlc conf.
 	<-ctx.lnd.RegisterConfChannel
 
+	// The external htlc tx is handed to the swap which publishes and
+	// then persists it.
+	if externalValue != 0 {
+		handOver := &externalHtlcTx{
+			tx:      &htlcTx,
+			errChan: make(chan error, 1),
+		}
+		inSwap.externalHtlcChan <- handOver
+		<-ctx.lnd.TxPublishChannel
+
+		state := ctx.store.AssertLoopInState(loopdb.StateHtlcPublished)
+		require.Equal(t, htlcTx.TxHash(), *state.HtlcTxHash)
+		ctx.assertState(loopdb.StateHtlcPublished)
+		require.NoError(t, <-handOver.errChan)
+	}
+
 	// Confirm htlc.
 	ctx.lnd.ConfChannel <- &chainntnfs.TxConfirmation{
 		Tx: &htlcTx,
@@ -349,6 +367,110 @@ func handleHtlcExpiry(t *testing.T, ctx *loopInTestContext, inSwap *loopInSwap,
 	require.NoError(t, <-errChan)
 }
 
+// TestLoopInTimeoutBatched tests that an expired loop in htlc is handed to the
+// sweep batcher and that the swap's share of the batch fee is accounted for.
+func TestLoopInTimeoutBatched(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := newLoopInTestContext(t)
+	cfg := newSwapConfig(&ctx.lnd.LndServices, ctx.store, ctx.server)
+
+	height := int32(600)
+	initResult, err := newLoopInSwap(
+		context.Background(), cfg, height, &testLoopInRequest,
+	)
+	require.NoError(t, err)
+	inSwap := initResult.swap
+
+	ctx.store.AssertLoopInStored()
+
+	// The batch registers for block epochs once it is spun up, by then
+	// the htlc has expired.
+	ctx.lnd.Height = inSwap.LoopInContract.CltvExpiry
+
+	sweepStore, err := sweepbatcher.NewSweepFetcherFromSwapStore(
+		ctx.store, ctx.lnd.ChainParams,
+	)
+	require.NoError(t, err)
+
+	batcher := sweepbatcher.NewBatcher(
+		ctx.lnd.WalletKit, ctx.lnd.ChainNotifier, ctx.lnd.Signer,
+		mockMuSig2SignSweep, mockVerifySchnorrSigSuccess,
+		ctx.lnd.ChainParams, sweepbatcher.NewStoreMock(), sweepStore,
+	)
+	ctx.cfg.batcher = batcher
+
+	tctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	batcherErrChan := make(chan error, 1)
+	go func() {
+		batcherErrChan <- batcher.Run(tctx)
+	}()
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- inSwap.execute(tctx, ctx.cfg, height)
+	}()
+
+	ctx.assertState(loopdb.StateInitiated)
+	ctx.assertState(loopdb.StateHtlcPublished)
+	ctx.store.AssertLoopInState(loopdb.StateHtlcPublished)
+
+	htlcTx := <-ctx.lnd.SendOutputsChannel
+	cost := loopdb.SwapCost{
+		Onchain: getTxFee(&htlcTx, test.DefaultMockFee.FeePerKVByte()),
+	}
+	ctx.store.AssertLoopInState(loopdb.StateHtlcPublished)
+
+	<-ctx.lnd.RegisterConfChannel
+	ctx.lnd.ConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: &htlcTx,
+	}
+
+	<-ctx.lnd.RegisterSpendChannel
+	ctx.assertSubscribeInvoice(ctx.server.swapHash)
+
+	// Let the htlc expire. Instead of publishing a timeout tx itself, the
+	// swap adds the htlc to the batcher, which registers for its spend
+	// and publishes a timeout batch.
+	ctx.blockEpochChan <- inSwap.LoopInContract.CltvExpiry
+
+	<-ctx.lnd.RegisterSpendChannel
+	<-ctx.lnd.SignOutputRawChannel
+	timeoutTx := <-ctx.lnd.TxPublishChannel
+	require.Len(t, timeoutTx.TxIn, 1)
+
+	// Confirm the timeout batch. Both the swap and the batch are notified
+	// of the spend.
+	spendDetail := &chainntnfs.SpendDetail{
+		SpendingTx:        timeoutTx,
+		SpenderInputIndex: 0,
+	}
+	ctx.lnd.SpendChannel <- spendDetail
+	ctx.lnd.SpendChannel <- spendDetail
+
+	// The batch waits for the confirmation of the timeout tx.
+	<-ctx.lnd.RegisterConfChannel
+
+	<-ctx.lnd.FailInvoiceChannel
+	ctx.updateInvoiceState(0, invpkg.ContractCanceled)
+
+	// The swap is charged the fee of the batch, as it is the only sweep of
+	// the batch.
+	cost.Onchain += btcutil.Amount(htlcTx.TxOut[0].Value) -
+		btcutil.Amount(timeoutTx.TxOut[0].Value)
+
+	ctx.assertState(loopdb.StateFailTimeout)
+	state := ctx.store.AssertLoopInState(loopdb.StateFailTimeout)
+	require.Equal(t, cost, state.Cost)
+
+	require.NoError(t, <-errChan)
+
+	cancel()
+	require.ErrorIs(t, <-batcherErrChan, context.Canceled)
+}
+
 // TestLoopInResume tests resuming swaps in various states.
 func TestLoopInResume(t *testing.T) {
 	storedVersion := []loopdb.ProtocolVersion{
@@ -378,6 +500,11 @@ func TestLoopInResume(t *testing.T) {
 			state:   loopdb.StateHtlcPublished,
 			expired: false,
 		},
+		{
+			name:    "invoice settled",
+			state:   loopdb.StateInvoiceSettled,
+			expired: false,
+		},
 	}
 
 	for _, next := range []bool{false, true} {
@@ -456,7 +583,7 @@ func testLoopInResume(t *testing.T, state loopdb.SwapState, expired bool,
 	// If we have already published the htlc, we expect our cost to already
 	// be published.
 	var cost loopdb.SwapCost
-	if state == loopdb.StateHtlcPublished {
+	if state != loopdb.StateInitiated {
 		cost = loopdb.SwapCost{
 			Onchain: 999,
 		}
@@ -475,6 +602,8 @@ func testLoopInResume(t *testing.T, state loopdb.SwapState, expired bool,
 	inSwap, err := resumeLoopInSwap(context.Background(), cfg, pendSwap)
 	require.NoError(t, err)
 
+	machines := ctx.trackStateMachines()
+
 	var height int32
 	if expired {
 		height = 740
@@ -513,6 +642,13 @@ func testLoopInResume(t *testing.T, state loopdb.SwapState, expired bool,
 
 		if expired {
 			ctx.assertState(loopdb.StateFailTimeout)
+			ctx.store.AssertLoopInState(loopdb.StateFailTimeout)
+
+			assertStateHistory(
+				t, <-machines, fsm.EmptyState, loopInInitiated,
+				loopInFailTimeout,
+			)
+
 			return
 		}
 
@@ -532,7 +668,7 @@ func testLoopInResume(t *testing.T, state loopdb.SwapState, expired bool,
 		state := ctx.store.AssertLoopInState(loopdb.StateHtlcPublished)
 		require.NotNil(t, state.HtlcTxHash)
 	} else {
-		ctx.assertState(loopdb.StateHtlcPublished)
+		ctx.assertState(state)
 
 		htlcTx.AddTxOut(&wire.TxOut{
 			PkScript: htlc.PkScript,
@@ -559,9 +695,12 @@ func testLoopInResume(t *testing.T, state loopdb.SwapState, expired bool,
 	amtPaid := btcutil.Amount(49000)
 	ctx.updateInvoiceState(amtPaid, invpkg.ContractSettled)
 
-	// Swap is expected to move to the state InvoiceSettled
-	ctx.assertState(loopdb.StateInvoiceSettled)
-	ctx.store.AssertLoopInState(loopdb.StateInvoiceSettled)
+	// Swap is expected to move to the state InvoiceSettled, unless it was
+	// already settled before the restart.
+	if state != loopdb.StateInvoiceSettled {
+		ctx.assertState(loopdb.StateInvoiceSettled)
+		ctx.store.AssertLoopInState(loopdb.StateInvoiceSettled)
+	}
 
 	// Server spends htlc.
 	successTx := wire.MsgTx{}
@@ -587,6 +726,30 @@ func testLoopInResume(t *testing.T, state loopdb.SwapState, expired bool,
 	// earlier in the test, because we expect this value to be unchanged.
 	cost.Server = btcutil.Amount(htlcTx.TxOut[0].Value) - amtPaid
 	require.Equal(t, cost, finalState.Cost)
+
+	// Swaps that didn't publish their htlc yet start over, all others are
+	// resumed in the state that matches their stored swap state.
+	machine := <-machines
+	switch state {
+	case loopdb.StateInitiated:
+		assertStateHistory(
+			t, machine, fsm.EmptyState, loopInInitiated,
+			loopInPublishHtlc, loopInHtlcPublished,
+			loopInInvoiceSettled, loopInSuccess,
+		)
+
+	case loopdb.StateHtlcPublished:
+		assertStateHistory(
+			t, machine, loopInHtlcPublished, loopInHtlcPublished,
+			loopInInvoiceSettled, loopInSuccess,
+		)
+
+	case loopdb.StateInvoiceSettled:
+		assertStateHistory(
+			t, machine, loopInInvoiceSettled, loopInInvoiceSettled,
+			loopInSuccess,
+		)
+	}
 }
 
 // TestAbandonPublishedHtlcState advances a loop-in swap to StateHtlcPublished,
@@ -6,7 +6,9 @@ import (
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweep"
 	"github.com/lightninglabs/loop/test"
 	invpkg "github.com/lightningnetwork/lnd/invoices"
@@ -94,3 +96,18 @@ func (c *loopInTestContext) updateInvoiceState(amount btcutil.Amount,
 		close(c.swapInvoiceSubscription.Err)
 	}
 }
+
+// trackStateMachines makes the state machines of the swaps that are executed
+// with the test context available on the returned channel.
+func (c *loopInTestContext) trackStateMachines() <-chan fsm.Introspector {
+	machines := make(chan fsm.Introspector, 1)
+	c.cfg.trackStateMachine = func(_ lntypes.Hash, _ swap.Type,
+		machine fsm.Introspector) func() {
+
+		machines <- machine
+
+		return func() {}
+	}
+
+	return machines
+}
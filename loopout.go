@@ -15,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweep"
@@ -75,6 +76,9 @@ type loopOutSwap struct {
 	// htlcTxHash is the confirmed htlc tx id.
 	htlcTxHash *chainhash.Hash
 
+	// htlcConf is the confirmation of the htlc tx.
+	htlcConf *chainntnfs.TxConfirmation
+
 	swapInvoicePaymentAddr [32]byte
 
 	// prepayAmount holds the amount of the prepay invoice. We use this
@@ -84,6 +88,17 @@ type loopOutSwap struct {
 	swapPaymentChan chan paymentResult
 	prePaymentChan  chan paymentResult
 
+	// stateMachine executes the swap.
+	stateMachine *fsm.TypedStateMachine[context.Context]
+
+	// sweepWatch contains the subscriptions used while waiting for the
+	// spend of the htlc.
+	sweepWatch *htlcSweepWatch
+
+	// persistErr is the error of the last attempt to persist the swap
+	// state when the state machine transitioned.
+	persistErr error
+
 	wg sync.WaitGroup
 }
 
@@ -99,6 +114,20 @@ type executeConfig struct {
 	maxPaymentRetries   int
 	cancelSwap          func(context.Context, *outCancelDetails) error
 	verifySchnorrSig    func(pubKey *btcec.PublicKey, hash, sig []byte) error
+	routeSender         routeSender
+	htlcFeeBumper       htlcFeeBumper
+	htlcBatcher         *htlcBatcher
+
+	// trackStateMachine makes the state machine of the swap available
+	// for introspection until the returned function is called. It is
+	// optional.
+	trackStateMachine func(lntypes.Hash, swap.Type,
+		fsm.Introspector) func()
+
+	// presignedTimeoutFeeRates are the sorted fee rates in sat/vbyte at
+	// which the timeout txs of confirmed loop in htlcs are signed in
+	// advance.
+	presignedTimeoutFeeRates []uint64
 }
 
 // loopOutInitResult contains information about a just-initiated loop out swap.
@@ -198,8 +227,10 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 			Label:           request.Label,
 			ProtocolVersion: loopdb.CurrentProtocolVersion(),
 		},
-		OutgoingChanSet: chanSet,
-		PaymentTimeout:  request.PaymentTimeout,
+		OutgoingChanSet:        chanSet,
+		PaymentTimeout:         request.PaymentTimeout,
+		SwapRoutePreferences:   request.SwapRoutePreferences,
+		PrepayRoutePreferences: request.PrepayRoutePreferences,
 	}
 
 	swapKit := newSwapKit(
@@ -387,20 +418,53 @@ func (s *loopOutSwap) executeAndFinalize(globalCtx context.Context) error {
 		return err
 	}
 
-	// Execute swap. When this call returns, the swap outcome is final, but
-	// it may be that there are still off-chain payments pending.
-	err = s.executeSwap(globalCtx)
+	// Run the state machine of the swap. When this call returns, the swap
+	// outcome is final and all off-chain payments have completed.
+	s.stateMachine = fsm.NewTypedStateMachine(
+		s.getStates(), defaultObserverSize,
+	)
+	s.stateMachine.RegisterObserver(s)
+	defer s.stopSweepWatch()
+
+	if s.trackStateMachine != nil {
+		defer s.trackStateMachine(
+			s.hash, s.swapType, s.stateMachine,
+		)()
+	}
+
+	err = s.stateMachine.SendEvent(onStart, globalCtx)
 	if err != nil {
 		return err
 	}
 
+	if s.stateMachine.LastActionError != nil {
+		return s.stateMachine.LastActionError
+	}
+
+	// The final state is persisted by the observer of the state machine.
+	if s.persistErr != nil {
+		return s.persistErr
+	}
+
 	// Sanity check.
 	if s.state.Type() == loopdb.StateTypePending {
 		return fmt.Errorf("swap in non-final state %v", s.state)
 	}
 
-	// Wait until all offchain payments have completed. If payments have
-	// already completed early, their channels have been set to nil.
+	s.log.Infof("Swap completed: %v "+
+		"(final cost: server %v, onchain %v, offchain %v)",
+		s.state,
+		s.cost.Server,
+		s.cost.Onchain,
+		s.cost.Offchain,
+	)
+
+	return nil
+}
+
+// waitForPayments waits until all off-chain payments have completed. If
+// payments have already completed early, their channels have been set to nil.
+func (s *loopOutSwap) waitForPayments(ctx context.Context) error {
 	s.log.Infof("Wait for server pulling off-chain payment(s)")
 	for s.swapPaymentChan != nil || s.prePaymentChan != nil {
 		select {
@@ -436,21 +500,28 @@ func (s *loopOutSwap) executeAndFinalize(globalCtx context.Context) error {
 				continue
 			}
 
-		case <-globalCtx.Done():
-			return globalCtx.Err()
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 
-	// Mark swap completed in store.
-	s.log.Infof("Swap completed: %v "+
-		"(final cost: server %v, onchain %v, offchain %v)",
-		s.state,
-		s.cost.Server,
-		s.cost.Onchain,
-		s.cost.Offchain,
-	)
+	return nil
+}
+
+// completeSwap stops watching the htlc spend and waits for the off-chain
+// payments to complete before the swap transitions into its final state with
+// the given event.
+func (s *loopOutSwap) completeSwap(ctx context.Context,
+	event fsm.EventType) fsm.EventType {
 
-	return s.persistState(globalCtx)
+	s.stopSweepWatch()
+
+	err := s.waitForPayments(ctx)
+	if err != nil {
+		return s.stateMachine.HandleError(err)
+	}
+
+	return event
 }
 
 // handlePaymentResult processes the result of a payment attempt. If the
@@ -494,99 +565,6 @@ func (s *loopOutSwap) handlePaymentResult(result paymentResult,
 	}
 }
 
-// executeSwap executes the swap, but returns as soon as the swap outcome is
-// final. At that point, there may still be pending off-chain payment(s).
-func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
-	// Decode the prepay invoice so we can ensure that we account for the
-	// prepay amount when calculating the final costs of the swap.
-	_, _, _, amt, err := swap.DecodeInvoice(
-		s.lnd.ChainParams, s.PrepayInvoice,
-	)
-	if err != nil {
-		return err
-	}
-	s.prepayAmount = amt
-
-	// We always pay both invoices (again). This is currently the only way
-	// to sort of resume payments.
-	//
-	// TODO: We shouldn't pay the invoices if it is already too late to
-	// start the swap. But because we don't know if we already fired the
-	// payments in a previous run, we cannot just abandon here.
-	s.payInvoices(globalCtx)
-
-	// Wait for confirmation of the on-chain htlc by watching for a tx
-	// producing the swap script output.
-	txConf, err := s.waitForConfirmedHtlc(globalCtx)
-	if err != nil {
-		return err
-	}
-
-	// If no error and no confirmation, the swap is aborted without an
-	// error. The swap state has been updated to a final state.
-	if txConf == nil {
-		return nil
-	}
-
-	// TODO: Off-chain payments can be canceled here. Most probably the HTLC
-	// is accepted by the server, but in case there are not for whatever
-	// reason, we don't need to have mission control start another payment
-	// attempt.
-
-	// Retrieve outpoint for sweep.
-	htlcOutpoint, htlcValue, err := swap.GetScriptOutput(
-		txConf.Tx, s.htlc.PkScript,
-	)
-	if err != nil {
-		return err
-	}
-
-	s.log.Infof("Htlc value: %v", htlcValue)
-
-	// Verify amount if preimage hasn't been revealed yet.
-	if s.state != loopdb.StatePreimageRevealed && htlcValue < s.AmountRequested {
-		log.Warnf("Swap amount too low, expected %v but received %v",
-			s.AmountRequested, htlcValue)
-
-		s.state = loopdb.StateFailInsufficientValue
-		return nil
-	}
-
-	// Try to spend htlc and continue (rbf) until a spend has confirmed.
-	spend, err := s.waitForHtlcSpendConfirmedV2(
-		globalCtx, *htlcOutpoint, htlcValue,
-	)
-	if err != nil {
-		return err
-	}
-
-	// If spend details are nil, we resolved the swap without waiting for
-	// its spend, so we can exit.
-	if spend == nil {
-		return nil
-	}
-
-	// Inspect witness stack to see if it is a success transaction. We
-	// don't just try to match with the hash of our sweep tx, because it
-	// may be swept by a different (fee) sweep tx from a previous run.
-	htlcInput, err := swap.GetTxInputByOutpoint(
-		spend.Tx, htlcOutpoint,
-	)
-	if err != nil {
-		return err
-	}
-
-	sweepSuccessful := s.htlc.IsSuccessWitness(htlcInput.Witness)
-	if sweepSuccessful {
-		s.cost.Onchain = spend.OnChainFeePortion
-		s.state = loopdb.StateSuccess
-	} else {
-		s.state = loopdb.StateFailSweepTimeout
-	}
-
-	return nil
-}
-
 // persistState updates the swap state and sends out an update notification.
 func (s *loopOutSwap) persistState(ctx context.Context) error {
 	updateTime := time.Now()
@@ -630,7 +608,8 @@ func (s *loopOutSwap) payInvoices(ctx context.Context) {
 	s.swapPaymentChan = s.payInvoice(
 		ctx, s.SwapInvoice, s.MaxSwapRoutingFee,
 		s.LoopOutContract.OutgoingChanSet,
-		s.LoopOutContract.PaymentTimeout, pluginType, true,
+		s.LoopOutContract.PaymentTimeout,
+		s.LoopOutContract.SwapRoutePreferences, pluginType, true,
 	)
 
 	// Pay the prepay invoice. Won't use the routing plugin here as the
@@ -640,7 +619,9 @@ func (s *loopOutSwap) payInvoices(ctx context.Context) {
 	s.prePaymentChan = s.payInvoice(
 		ctx, s.PrepayInvoice, s.MaxPrepayRoutingFee,
 		s.LoopOutContract.OutgoingChanSet,
-		s.LoopOutContract.PaymentTimeout, RoutingPluginNone, false,
+		s.LoopOutContract.PaymentTimeout,
+		s.LoopOutContract.PrepayRoutePreferences, RoutingPluginNone,
+		false,
 	)
 }
 
@@ -668,7 +649,8 @@ func (p paymentResult) failure() error {
 // payInvoice pays a single invoice.
 func (s *loopOutSwap) payInvoice(ctx context.Context, invoice string,
 	maxFee btcutil.Amount, outgoingChanIds loopdb.ChannelSet,
-	paymentTimeout time.Duration, pluginType RoutingPluginType,
+	paymentTimeout time.Duration, routePrefs loopdb.RoutePreferences,
+	pluginType RoutingPluginType,
 	reportPluginResult bool) chan paymentResult {
 
 	resultChan := make(chan paymentResult)
@@ -684,7 +666,7 @@ func (s *loopOutSwap) payInvoice(ctx context.Context, invoice string,
 
 		status, err := s.payInvoiceAsync(
 			ctx, invoice, maxFee, outgoingChanIds, paymentTimeout,
-			pluginType, reportPluginResult,
+			routePrefs, pluginType, reportPluginResult,
 		)
 		if err != nil {
 			result.err = err
@@ -713,8 +695,31 @@ func (s *loopOutSwap) payInvoice(ctx context.Context, invoice string,
 func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 	invoice string, maxFee btcutil.Amount,
 	outgoingChanIds loopdb.ChannelSet, paymentTimeout time.Duration,
-	pluginType RoutingPluginType, reportPluginResult bool) (
-	*lndclient.PaymentStatus, error) {
+	routePrefs loopdb.RoutePreferences, pluginType RoutingPluginType,
+	reportPluginResult bool) (*lndclient.PaymentStatus, error) {
+
+	// If the route is restricted, we select a route up front and send the
+	// payment over it, selecting a new route for each retry. The routing
+	// plugin manipulates lnd's pathfinding, so it doesn't apply here.
+	if routePrefs.IsSet() {
+		start := time.Now()
+		paymentStatus, attempts, err := s.sendPaymentToRoute(
+			ctx, invoice, maxFee, outgoingChanIds, paymentTimeout,
+			routePrefs,
+		)
+
+		if reportPluginResult {
+			success := err == nil &&
+				paymentStatus.State == lnrpc.Payment_SUCCEEDED
+
+			s.reportRoutingResult(
+				ctx, RoutingPluginNone, success, attempts,
+				time.Since(start),
+			)
+		}
+
+		return paymentStatus, err
+	}
 
 	// Extract hash from payment request. Unfortunately the request
 	// components aren't available directly.
@@ -761,7 +766,7 @@ func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 				"%v to stay within total payment timeout",
 				maxRetries)
 		}
-		defer ReleaseRoutingPlugin(ctx)
+		defer ReleaseRoutingPlugin(ctx, routingPlugin)
 	} else if paymentTimeout == 0 {
 		// If not set, default the payment timeout to the total payment
 		// timeout.
@@ -797,18 +802,28 @@ func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 			reportType = RoutingPluginNone
 		}
 
-		if err := s.swapKit.server.ReportRoutingResult(
-			ctx, s.swapInfo().SwapHash, s.swapInvoicePaymentAddr,
-			reportType, paymentSuccess, int32(attempts),
-			dt.Milliseconds(),
-		); err != nil {
-			s.log.Warnf("Failed to report routing result: %v", err)
-		}
+		s.reportRoutingResult(
+			ctx, reportType, paymentSuccess, attempts, dt,
+		)
 	}
 
 	return paymentStatus, err
 }
 
+// reportRoutingResult reports the outcome of the swap payment to the server.
+func (s *loopOutSwap) reportRoutingResult(ctx context.Context,
+	pluginType RoutingPluginType, success bool, attempts int,
+	duration time.Duration) {
+
+	if err := s.swapKit.server.ReportRoutingResult(
+		ctx, s.swapInfo().SwapHash, s.swapInvoicePaymentAddr,
+		pluginType, success, int32(attempts),
+		duration.Milliseconds(),
+	); err != nil {
+		s.log.Warnf("Failed to report routing result: %v", err)
+	}
+}
+
 // sendPaymentWithRetry will send the payment, optionally with the passed
 // routing plugin retrying at most maxRetries times.
 func (s *loopOutSwap) sendPaymentWithRetry(ctx context.Context,
@@ -900,11 +915,11 @@ func (s *loopOutSwap) awaitSendPayment(ctx context.Context, hash lntypes.Hash,
 	}
 }
 
-// waitForConfirmedHtlc waits for a confirmed htlc to appear on the chain. In
-// case we haven't revealed the preimage yet, it also monitors block height and
-// off-chain payment failure.
-func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
-	*chainntnfs.TxConfirmation, error) {
+// waitForConfirmedHtlcAction waits for a confirmed htlc to appear on the
+// chain. In case we haven't revealed the preimage yet, it also monitors block
+// height and off-chain payment failure.
+func (s *loopOutSwap) waitForConfirmedHtlcAction(
+	globalCtx context.Context) fsm.EventType {
 
 	// Wait for confirmation of the on-chain htlc by watching for a tx
 	// producing the swap script output.
@@ -931,7 +946,7 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 			int32(s.HtlcConfirmations), s.InitiationHeight,
 		)
 	if err != nil {
-		return nil, err
+		return s.stateMachine.HandleError(err)
 	}
 
 	var txConf *chainntnfs.TxConfirmation
@@ -955,8 +970,6 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 				"exceeded (height %v)",
 				maxPreimageRevealHeight, s.height)
 
-			s.state = loopdb.StateFailTimeout
-
 			return true
 		}
 
@@ -964,7 +977,7 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 		// preimage after the max height (depending on order in which
 		// events are received in the select loop below).
 		if checkMaxRevealHeightExceeded() {
-			return nil, nil
+			return s.completeSwap(globalCtx, onTimeout)
 		}
 		s.log.Infof("Waiting for either htlc on-chain confirmation or " +
 			"off-chain payment failure")
@@ -978,7 +991,7 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 
 				err := s.handlePaymentResult(result, true)
 				if err != nil {
-					return nil, err
+					return s.stateMachine.HandleError(err)
 				}
 
 				if result.failure() != nil {
@@ -989,7 +1002,11 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 						ctx, paymentTypeInvoice,
 						result.status,
 					)
-					return nil, nil
+
+					return s.completeSwap(
+						globalCtx,
+						onOffchainPaymentFailed,
+					)
 				}
 
 			// If the prepay fails, abandon the swap. Because we
@@ -1000,7 +1017,7 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 
 				err := s.handlePaymentResult(result, false)
 				if err != nil {
-					return nil, err
+					return s.stateMachine.HandleError(err)
 				}
 
 				if result.failure() != nil {
@@ -1012,13 +1029,16 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 						result.status,
 					)
 
-					return nil, nil
+					return s.completeSwap(
+						globalCtx,
+						onOffchainPaymentFailed,
+					)
 				}
 
 			// Unexpected error on the confirm channel happened,
 			// abandon the swap.
 			case err := <-htlcErrChan:
-				return nil, err
+				return s.stateMachine.HandleError(err)
 
 			// Htlc got confirmed, continue to sweeping.
 			case htlcConfNtfn := <-htlcConfChan:
@@ -1032,12 +1052,16 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 				log.Infof("Received block %v", s.height)
 
 				if checkMaxRevealHeightExceeded() {
-					return nil, nil
+					return s.completeSwap(
+						globalCtx, onTimeout,
+					)
 				}
 
 			// Client quit.
 			case <-globalCtx.Done():
-				return nil, globalCtx.Err()
+				return s.stateMachine.HandleError(
+					globalCtx.Err(),
+				)
 			}
 		}
 
@@ -1046,11 +1070,11 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 		s.log.Infof("Retrieving htlc onchain")
 		select {
 		case err := <-htlcErrChan:
-			return nil, err
+			return s.stateMachine.HandleError(err)
 		case htlcConfNtfn := <-htlcConfChan:
 			txConf = htlcConfNtfn
 		case <-globalCtx.Done():
-			return nil, globalCtx.Err()
+			return s.stateMachine.HandleError(globalCtx.Err())
 		}
 	}
 
@@ -1058,117 +1082,171 @@ func (s *loopOutSwap) waitForConfirmedHtlc(globalCtx context.Context) (
 	s.log.Infof("Htlc tx %v at height %v", htlcTxHash, txConf.BlockHeight)
 
 	s.htlcTxHash = &htlcTxHash
+	s.htlcConf = txConf
+
+	return onHtlcConfirmed
+}
+
+// htlcSweepWatch contains the subscriptions used to wait for the spend of the
+// confirmed htlc of a loop out swap. They are kept open while the swap moves
+// from the htlc confirmed state to the preimage revealed state.
+type htlcSweepWatch struct {
+	// sweepReq is the request that is sent to the sweep batcher.
+	sweepReq sweepbatcher.SweepRequest
+
+	// quitChan notifies the sweep batcher that we stopped waiting for the
+	// spend.
+	quitChan chan bool
 
-	return txConf, nil
+	// spendChan receives the spend of the htlc.
+	spendChan chan *sweepbatcher.SpendDetail
+
+	// spendErrChan receives errors of the spend notification.
+	spendErrChan chan error
+
+	// trackChan receives status updates of the swap payment.
+	trackChan <-chan lndclient.PaymentStatus
+
+	// trackErrChan receives errors of the payment tracking.
+	trackErrChan <-chan error
+
+	// timerChan fires when it is time to try to sweep the htlc again.
+	timerChan <-chan time.Time
+
+	// paymentComplete tracks whether our payment is complete, and is used
+	// to decide whether we need to push our preimage to the server.
+	paymentComplete bool
+
+	// pushPreimage is set if the preimage was just revealed and has yet to
+	// be pushed to the server.
+	pushPreimage bool
+
+	// cancel cancels the tracking of the payment.
+	cancel func()
 }
 
-// waitForHtlcSpendConfirmedV2 waits for the htlc to be spent either by our own
-// sweep or a server revocation tx.
-func (s *loopOutSwap) waitForHtlcSpendConfirmedV2(globalCtx context.Context,
-	htlcOutpoint wire.OutPoint, htlcValue btcutil.Amount) (
-	*sweepbatcher.SpendDetail, error) {
+// startSweepWatch registers the htlc spend notification and starts tracking
+// the swap payment.
+func (s *loopOutSwap) startSweepWatch(globalCtx context.Context,
+	htlcOutpoint wire.OutPoint, htlcValue btcutil.Amount) error {
 
 	spendChan := make(chan *sweepbatcher.SpendDetail)
 	spendErrChan := make(chan error, 1)
 	quitChan := make(chan bool, 1)
 
-	defer func() {
-		quitChan <- true
-	}()
-
 	notifier := sweepbatcher.SpendNotifier{
 		SpendChan:    spendChan,
 		SpendErrChan: spendErrChan,
 		QuitChan:     quitChan,
 	}
 
-	sweepReq := sweepbatcher.SweepRequest{
-		SwapHash: s.hash,
-		Outpoint: htlcOutpoint,
-		Value:    htlcValue,
-		Notifier: &notifier,
-	}
-
-	// Register the htlc spend notification.
-	ctx, cancel := context.WithCancel(globalCtx)
-	defer cancel()
-
 	// Track our payment status so that we can detect whether our off chain
 	// htlc is settled. We track this information to determine whether it is
 	// necessary to continue trying to push our preimage to the server.
+	ctx, cancel := context.WithCancel(globalCtx)
 	trackChan, trackErrChan, err := s.lnd.Router.TrackPayment(
 		ctx, s.hash,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("track payment: %v", err)
+		cancel()
+
+		return fmt.Errorf("track payment: %v", err)
 	}
 
-	var (
-		// paymentComplete tracks whether our payment is complete, and
-		// is used to decide whether we need to push our preimage to
-		// the server.
-		paymentComplete bool
-	)
+	s.sweepWatch = &htlcSweepWatch{
+		sweepReq: sweepbatcher.SweepRequest{
+			SwapHash: s.hash,
+			Outpoint: htlcOutpoint,
+			Value:    htlcValue,
+			Notifier: &notifier,
+		},
+		quitChan:     quitChan,
+		spendChan:    spendChan,
+		spendErrChan: spendErrChan,
+		trackChan:    trackChan,
+		trackErrChan: trackErrChan,
+		timerChan:    s.timerFactory(repushDelay),
+		cancel:       cancel,
+	}
 
-	timerChan := s.timerFactory(repushDelay)
+	return nil
+}
+
+// stopSweepWatch stops waiting for the spend of the htlc, if we are.
+func (s *loopOutSwap) stopSweepWatch() {
+	if s.sweepWatch == nil {
+		return
+	}
+
+	s.sweepWatch.quitChan <- true
+	s.sweepWatch.cancel()
+	s.sweepWatch = nil
+}
+
+// waitForHtlcSpend waits for the htlc to be spent either by our own sweep or
+// a server revocation tx. It returns early with onPreimageRevealed once our
+// first sweep reveals the preimage.
+func (s *loopOutSwap) waitForHtlcSpend(
+	globalCtx context.Context) fsm.EventType {
+	w := s.sweepWatch
 
 	for {
 		select {
 		// Htlc spend, break loop.
-		case spend := <-spendChan:
+		case spend := <-w.spendChan:
 			s.log.Infof("Htlc spend by tx: %v", spend.Tx.TxHash())
 
-			return spend, nil
+			return s.htlcSpent(globalCtx, spend)
 
 		// Spend notification error.
-		case err := <-spendErrChan:
-			return nil, err
+		case err := <-w.spendErrChan:
+			return s.stateMachine.HandleError(err)
 
 		// Receive status updates for our payment so that we can detect
 		// whether we've successfully pushed our preimage.
-		case status, ok := <-trackChan:
+		case status, ok := <-w.trackChan:
 			// If our channel has been closed, indicating that the
 			// server is finished providing updates because the
 			// payment has reached a terminal state, we replace
 			// the closed channel with nil so that we will no longer
 			// listen on it.
 			if !ok {
-				trackChan = nil
+				w.trackChan = nil
 				continue
 			}
 
 			if status.State == lnrpc.Payment_SUCCEEDED {
 				s.log.Infof("Off chain payment succeeded")
 
-				paymentComplete = true
+				w.paymentComplete = true
 			}
 
 		// If we receive a track payment error that indicates that the
 		// server stream is complete, we ignore it because we want to
 		// continue this loop beyond the completion of the payment.
-		case err, ok := <-trackErrChan:
+		case err, ok := <-w.trackErrChan:
 			// If our channel has been closed, indicating that the
 			// server is finished providing updates because the
 			// payment has reached a terminal state, we replace
 			// the closed channel with nil so that we will no longer
 			// listen on it.
 			if !ok {
-				trackErrChan = nil
+				w.trackErrChan = nil
 				continue
 			}
 
 			// Otherwise, if we receive a non-nil error, we return
 			// it.
 			if err != nil {
-				return nil, err
+				return s.stateMachine.HandleError(err)
 			}
 
 		// New block arrived, update height and try pushing preimage.
 		case notification := <-s.blockEpochChan:
 			s.height = notification.(int32)
-			timerChan = s.timerFactory(repushDelay)
+			w.timerChan = s.timerFactory(repushDelay)
 
-		case <-timerChan:
+		case <-w.timerChan:
 			// sweepConfTarget will return false if the preimage is
 			// not revealed yet but the conf target is closer than
 			// 20 blocks. In this case to be sure we won't attempt
@@ -1179,44 +1257,63 @@ func (s *loopOutSwap) waitForHtlcSpendConfirmedV2(globalCtx context.Context,
 				s.log.Infof("Aborting swap, timed " +
 					"out on-chain")
 
-				s.state = loopdb.StateFailTimeout
-				err := s.persistState(ctx)
-				if err != nil {
-					log.Warnf("unable to persist " +
-						"state")
-				}
-
-				return nil, nil
+				return s.completeSwap(globalCtx, onTimeout)
 			}
 
 			// Send the sweep to the sweeper.
-			err := s.batcher.AddSweep(&sweepReq)
+			err := s.batcher.AddSweep(&w.sweepReq)
 			if err != nil {
-				return nil, err
+				return s.stateMachine.HandleError(err)
 			}
 
 			// Now that the sweep is taken care of, we can update
-			// our state.
-			err = s.setStatePreimageRevealed(ctx)
-			if err != nil {
-				return nil, err
+			// our state. The preimage is pushed once the new state
+			// is persisted.
+			if s.state != loopdb.StatePreimageRevealed {
+				w.pushPreimage = true
+
+				return onPreimageRevealed
 			}
 
-			if !paymentComplete {
+			if !w.paymentComplete {
 				// Push the preimage for as long as the
 				// server is able to settle the swap
 				// invoice. So that we can continue
 				// with the MuSig2 sweep afterwards.
-				s.pushPreimage(ctx)
+				s.pushPreimage(globalCtx)
 			}
 
 		// Context canceled.
 		case <-globalCtx.Done():
-			return nil, globalCtx.Err()
+			return s.stateMachine.HandleError(globalCtx.Err())
 		}
 	}
 }
 
+// htlcSpent inspects the spend of the htlc to determine the outcome of the
+// swap.
+func (s *loopOutSwap) htlcSpent(globalCtx context.Context,
+	spend *sweepbatcher.SpendDetail) fsm.EventType {
+
+	// Inspect witness stack to see if it is a success transaction. We
+	// don't just try to match with the hash of our sweep tx, because it
+	// may be swept by a different (fee) sweep tx from a previous run.
+	htlcInput, err := swap.GetTxInputByOutpoint(
+		spend.Tx, &s.sweepWatch.sweepReq.Outpoint,
+	)
+	if err != nil {
+		return s.stateMachine.HandleError(err)
+	}
+
+	if !s.htlc.IsSuccessWitness(htlcInput.Witness) {
+		return s.completeSwap(globalCtx, onHtlcTimeoutSwept)
+	}
+
+	s.cost.Onchain = spend.OnChainFeePortion
+
+	return s.completeSwap(globalCtx, onHtlcSwept)
+}
+
 // pushPreimage pushes our preimage to the server if we have already revealed
 // our preimage on chain with a sweep attempt.
 func (s *loopOutSwap) pushPreimage(ctx context.Context) {
@@ -1237,14 +1334,11 @@ func (s *loopOutSwap) pushPreimage(ctx context.Context) {
 	}
 }
 
-// failOffChain updates a swap's state when it has failed due to a routing
-// failure and notifies the server of the failure.
+// failOffChain notifies the server of a swap that has failed due to a routing
+// failure.
 func (s *loopOutSwap) failOffChain(ctx context.Context, paymentType paymentType,
 	status lndclient.PaymentStatus) {
 
-	// Set our state to failed off chain timeout.
-	s.state = loopdb.StateFailOffchainPayments
-
 	details := &outCancelDetails{
 		hash:        s.hash,
 		paymentAddr: s.swapInvoicePaymentAddr,
@@ -1316,19 +1410,6 @@ func (s *loopOutSwap) failOffChain(ctx context.Context, paymentType paymentType,
 	}
 }
 
-func (s *loopOutSwap) setStatePreimageRevealed(ctx context.Context) error {
-	if s.state != loopdb.StatePreimageRevealed {
-		s.state = loopdb.StatePreimageRevealed
-
-		err := s.persistState(ctx)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // validateLoopOutContract validates the contract parameters against our
 // request.
 func validateLoopOutContract(lnd *lndclient.LndServices, request *OutRequest,
@@ -1337,7 +1418,7 @@ func validateLoopOutContract(lnd *lndclient.LndServices, request *OutRequest,
 	// Check invoice amounts.
 	chainParams := lnd.ChainParams
 
-	_, _, swapInvoiceHash, swapInvoiceAmt, err := swap.DecodeInvoice(
+	swapDest, _, swapInvoiceHash, swapInvoiceAmt, err := swap.DecodeInvoice(
 		chainParams, response.swapInvoice,
 	)
 	if err != nil {
@@ -1350,7 +1431,7 @@ func validateLoopOutContract(lnd *lndclient.LndServices, request *OutRequest,
 				"generated swap hash %v", swapInvoiceHash, swapHash)
 	}
 
-	_, _, _, prepayInvoiceAmt, err := swap.DecodeInvoice(
+	prepayDest, _, _, prepayInvoiceAmt, err := swap.DecodeInvoice(
 		chainParams, response.prepayInvoice,
 	)
 	if err != nil {
@@ -1372,6 +1453,22 @@ func validateLoopOutContract(lnd *lndclient.LndServices, request *OutRequest,
 		return ErrPrepayAmountTooHigh
 	}
 
+	err = validateRoutePreferences(
+		request.SwapRoutePreferences, swapDest,
+		request.OutgoingChanSet,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid swap route preferences: %w", err)
+	}
+
+	err = validateRoutePreferences(
+		request.PrepayRoutePreferences, prepayDest,
+		request.OutgoingChanSet,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid prepay route preferences: %w", err)
+	}
+
 	return nil
 }
 
@@ -1392,7 +1489,6 @@ func (s *loopOutSwap) sweepConfTarget() (int32, bool) {
 			"expires at: %v, current height: %v", s.CltvExpiry,
 			s.height)
 
-		s.state = loopdb.StateFailTimeout
 		return 0, false
 	}
 
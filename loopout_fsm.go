@@ -0,0 +1,261 @@
+package loop
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/fsm"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+)
+
+// States of the loop out state machine. The states that are stored in the
+// database are named after the matching loopdb.SwapState.
+const (
+	// loopOutPayInvoices is the state where the swap and prepay invoices
+	// are paid.
+	loopOutPayInvoices = fsm.StateType("PayInvoices")
+
+	// loopOutWaitForHtlcConf is the state where we wait for the server to
+	// confirm the htlc on chain.
+	loopOutWaitForHtlcConf = fsm.StateType("WaitForHtlcConf")
+
+	// loopOutHtlcConfirmed is the state where the htlc is confirmed and
+	// we wait for it to become economical to sweep it.
+	loopOutHtlcConfirmed = fsm.StateType("HtlcConfirmed")
+
+	// loopOutPreimageRevealed is the state where the preimage is revealed
+	// by our sweep and we wait for the spend of the htlc.
+	loopOutPreimageRevealed = fsm.StateType("PreimageRevealed")
+
+	// loopOutSuccess is the state where the htlc is swept by us.
+	loopOutSuccess = fsm.StateType("Success")
+
+	// loopOutFailOffchainPayments is the state where one of the
+	// off-chain payments failed.
+	loopOutFailOffchainPayments = fsm.StateType("FailOffchainPayments")
+
+	// loopOutFailTimeout is the state where it became too late to reveal
+	// the preimage.
+	loopOutFailTimeout = fsm.StateType("FailTimeout")
+
+	// loopOutFailInsufficientValue is the state where the value of the
+	// htlc is lower than the requested amount.
+	loopOutFailInsufficientValue = fsm.StateType("FailInsufficientValue")
+
+	// loopOutFailSweepTimeout is the state where the server swept the
+	// htlc with the timeout path.
+	loopOutFailSweepTimeout = fsm.StateType("FailSweepTimeout")
+
+	// loopOutFailTemporary is the state where the execution of the swap
+	// was aborted by an unexpected error. The swap is resumed on restart.
+	loopOutFailTemporary = fsm.StateType("FailTemporary")
+)
+
+// Events of the loop out state machine that aren't shared with the loop in
+// state machine.
+const (
+	// onPaymentsSent is sent once the off-chain payments are dispatched.
+	onPaymentsSent = fsm.EventType("OnPaymentsSent")
+
+	// onHtlcConfirmed is sent once the htlc is confirmed.
+	onHtlcConfirmed = fsm.EventType("OnHtlcConfirmed")
+
+	// onOffchainPaymentFailed is sent if one of the off-chain payments
+	// failed before the preimage was revealed.
+	onOffchainPaymentFailed = fsm.EventType("OnOffchainPaymentFailed")
+
+	// onInsufficientValue is sent if the htlc value is too low.
+	onInsufficientValue = fsm.EventType("OnInsufficientValue")
+
+	// onPreimageRevealed is sent once our first sweep revealed the
+	// preimage.
+	onPreimageRevealed = fsm.EventType("OnPreimageRevealed")
+)
+
+// loopOutSwapStates maps the states of the loop out state machine to the
+// swap states that are stored in the database.
+var loopOutSwapStates = map[fsm.StateType]loopdb.SwapState{
+	loopOutPreimageRevealed:      loopdb.StatePreimageRevealed,
+	loopOutSuccess:               loopdb.StateSuccess,
+	loopOutFailOffchainPayments:  loopdb.StateFailOffchainPayments,
+	loopOutFailTimeout:           loopdb.StateFailTimeout,
+	loopOutFailInsufficientValue: loopdb.StateFailInsufficientValue,
+	loopOutFailSweepTimeout:      loopdb.StateFailSweepTimeout,
+}
+
+// LoopOutStates returns the states of the loop out state machine.
+func LoopOutStates() fsm.TypedStates[context.Context] {
+	return (&loopOutSwap{}).getStates()
+}
+
+// getStates returns the states of the loop out state machine. Swaps that are
+// resumed from the database start over from the empty state, the actions
+// pick up from the stored swap state.
+func (s *loopOutSwap) getStates() fsm.TypedStates[context.Context] {
+	noOp := fsm.TypedNoOpAction[context.Context]
+
+	return fsm.TypedStates[context.Context]{
+		fsm.EmptyState: {
+			Transitions: fsm.Transitions{
+				onStart: loopOutPayInvoices,
+			},
+		},
+		loopOutPayInvoices: {
+			Transitions: fsm.Transitions{
+				onPaymentsSent: loopOutWaitForHtlcConf,
+				fsm.OnError:    loopOutFailTemporary,
+			},
+			Action: s.payInvoicesAction,
+		},
+		loopOutWaitForHtlcConf: {
+			Transitions: fsm.Transitions{
+				onHtlcConfirmed:         loopOutHtlcConfirmed,
+				onOffchainPaymentFailed: loopOutFailOffchainPayments,
+				onTimeout:               loopOutFailTimeout,
+				fsm.OnError:             loopOutFailTemporary,
+			},
+			Action: s.waitForConfirmedHtlcAction,
+		},
+		loopOutHtlcConfirmed: {
+			Transitions: fsm.Transitions{
+				onInsufficientValue: loopOutFailInsufficientValue,
+				onPreimageRevealed:  loopOutPreimageRevealed,
+				onHtlcSwept:         loopOutSuccess,
+				onHtlcTimeoutSwept:  loopOutFailSweepTimeout,
+				onTimeout:           loopOutFailTimeout,
+				fsm.OnError:         loopOutFailTemporary,
+			},
+			Action: s.htlcConfirmedAction,
+		},
+		loopOutPreimageRevealed: {
+			Transitions: fsm.Transitions{
+				onHtlcSwept:        loopOutSuccess,
+				onHtlcTimeoutSwept: loopOutFailSweepTimeout,
+				fsm.OnError:        loopOutFailTemporary,
+			},
+			Action: s.preimageRevealedAction,
+		},
+		loopOutSuccess: {
+			Action: noOp,
+		},
+		loopOutFailOffchainPayments: {
+			Action: noOp,
+		},
+		loopOutFailTimeout: {
+			Action: noOp,
+		},
+		loopOutFailInsufficientValue: {
+			Action: noOp,
+		},
+		loopOutFailSweepTimeout: {
+			Action: noOp,
+		},
+		loopOutFailTemporary: {
+			Action: noOp,
+		},
+	}
+}
+
+// Notify implements the fsm.TypedObserver interface. It persists the swap
+// state if the state machine transitioned into a state that is stored in the
+// database and sends out a status update.
+func (s *loopOutSwap) Notify(n fsm.TypedNotification[context.Context]) {
+	state, ok := loopOutSwapStates[n.NextState]
+	if !ok || state == s.state {
+		return
+	}
+
+	s.state = state
+	s.persistErr = s.persistState(n.EventContext)
+	if s.persistErr != nil {
+		s.log.Errorf("Unable to persist state %v: %v", state,
+			s.persistErr)
+	}
+}
+
+// payInvoicesAction pays the swap and prepay invoices.
+func (s *loopOutSwap) payInvoicesAction(ctx context.Context) fsm.EventType {
+	// Decode the prepay invoice so we can ensure that we account for the
+	// prepay amount when calculating the final costs of the swap.
+	_, _, _, amt, err := swap.DecodeInvoice(
+		s.lnd.ChainParams, s.PrepayInvoice,
+	)
+	if err != nil {
+		return s.stateMachine.HandleError(err)
+	}
+	s.prepayAmount = amt
+
+	// We always pay both invoices (again). This is currently the only way
+	// to sort of resume payments.
+	//
+	// TODO: We shouldn't pay the invoices if it is already too late to
+	// start the swap. But because we don't know if we already fired the
+	// payments in a previous run, we cannot just abandon here.
+	s.payInvoices(ctx)
+
+	return onPaymentsSent
+}
+
+// htlcConfirmedAction verifies the value of the confirmed htlc and starts to
+// wait for its spend.
+func (s *loopOutSwap) htlcConfirmedAction(ctx context.Context) fsm.EventType {
+	// TODO: Off-chain payments can be canceled here. Most probably the HTLC
+	// is accepted by the server, but in case there are not for whatever
+	// reason, we don't need to have mission control start another payment
+	// attempt.
+
+	// Retrieve outpoint for sweep.
+	htlcOutpoint, htlcValue, err := swap.GetScriptOutput(
+		s.htlcConf.Tx, s.htlc.PkScript,
+	)
+	if err != nil {
+		return s.stateMachine.HandleError(err)
+	}
+
+	s.log.Infof("Htlc value: %v", htlcValue)
+
+	// Verify amount if preimage hasn't been revealed yet.
+	if s.state != loopdb.StatePreimageRevealed &&
+		htlcValue < s.AmountRequested {
+
+		log.Warnf("Swap amount too low, expected %v but received %v",
+			s.AmountRequested, htlcValue)
+
+		return s.completeSwap(ctx, onInsufficientValue)
+	}
+
+	// Try to spend htlc and continue (rbf) until a spend has confirmed.
+	err = s.startSweepWatch(ctx, *htlcOutpoint, htlcValue)
+	if err != nil {
+		return s.stateMachine.HandleError(err)
+	}
+
+	// If we revealed the preimage in a previous run, we continue waiting
+	// for the spend in that state.
+	if s.state == loopdb.StatePreimageRevealed {
+		return onPreimageRevealed
+	}
+
+	return s.waitForHtlcSpend(ctx)
+}
+
+// preimageRevealedAction pushes the just revealed preimage to the server and
+// continues to wait for the spend of the htlc.
+func (s *loopOutSwap) preimageRevealedAction(
+	ctx context.Context) fsm.EventType {
+
+	if s.persistErr != nil {
+		return s.stateMachine.HandleError(s.persistErr)
+	}
+
+	w := s.sweepWatch
+	if w.pushPreimage && !w.paymentComplete {
+		// Push the preimage for as long as the server is able to settle
+		// the swap invoice. So that we can continue with the MuSig2
+		// sweep afterwards.
+		s.pushPreimage(ctx)
+	}
+	w.pushPreimage = false
+
+	return s.waitForHtlcSpend(ctx)
+}
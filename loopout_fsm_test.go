@@ -0,0 +1,188 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/fsm"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckLoopOutStates statically checks the loop out states.
+func TestCheckLoopOutStates(t *testing.T) {
+	var finalStates []fsm.StateType
+	for state, swapState := range loopOutSwapStates {
+		if swapState.IsFinal() {
+			finalStates = append(finalStates, state)
+		}
+	}
+	finalStates = append(finalStates, loopOutFailTemporary)
+
+	issues := fsm.CheckStates(LoopOutStates(), &fsm.CheckConfig{
+		FinalStates: finalStates,
+		Events: []fsm.EventType{
+			onStart, onPaymentsSent, onHtlcConfirmed,
+			onOffchainPaymentFailed, onTimeout,
+			onInsufficientValue, onPreimageRevealed, onHtlcSwept,
+			onHtlcTimeoutSwept,
+		},
+	})
+	require.Empty(t, issues)
+}
+
+// TestLoopOutSwapStates tests that the stored states of the loop out state
+// machine are named after their swap state.
+func TestLoopOutSwapStates(t *testing.T) {
+	for state, swapState := range loopOutSwapStates {
+		require.Equal(t, swapState.String(), string(state))
+	}
+
+	require.Equal(
+		t, loopdb.StateFailTemporary.String(),
+		string(loopOutFailTemporary),
+	)
+}
+
+// TestLoopOutStateMachine drives the loop out state machine with lnd and
+// server events and asserts the states it goes through and the swap states
+// that are stored in the database.
+func TestLoopOutStateMachine(t *testing.T) {
+	t.Run("success", testLoopOutStateMachineSuccess)
+	t.Run("offchain payment failed", testLoopOutStateMachineFailOffchain)
+}
+
+func testLoopOutStateMachineSuccess(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	info, err := ctx.swapClient.LoopOut(context.Background(), testRequest)
+	require.NoError(t, err)
+
+	ctx.assertStored()
+	ctx.assertStatus(loopdb.StateInitiated)
+
+	signalSwapPaymentResult := ctx.AssertPaid(swapInvoiceDesc)
+	signalPrepaymentResult := ctx.AssertPaid(prepayInvoiceDesc)
+
+	// Once the payments are dispatched, the swap waits for the server to
+	// publish the htlc. Nothing is stored until the preimage is revealed.
+	confIntent := ctx.Context.AssertRegisterConf(false, defaultConfirmations)
+
+	machine := getStateMachine(t, ctx.swapClient, info.SwapHash)
+	require.NoError(t, machine.DefaultObserver.WaitForState(
+		context.Background(), test.Timeout, loopOutWaitForHtlcConf,
+	))
+	assertLoopOutUpdates(t, ctx.store, info.SwapHash)
+
+	testLoopOutSuccess(ctx, testRequest.Amount, info.SwapHash,
+		signalPrepaymentResult, signalSwapPaymentResult, false,
+		confIntent, swap.HtlcV3,
+	)
+
+	assertStateHistory(
+		t, machine, fsm.EmptyState, loopOutPayInvoices,
+		loopOutWaitForHtlcConf, loopOutHtlcConfirmed,
+		loopOutPreimageRevealed, loopOutSuccess,
+	)
+	assertLoopOutUpdates(
+		t, ctx.store, info.SwapHash, loopdb.StatePreimageRevealed,
+		loopdb.StateSuccess,
+	)
+}
+
+func testLoopOutStateMachineFailOffchain(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	info, err := ctx.swapClient.LoopOut(context.Background(), testRequest)
+	require.NoError(t, err)
+
+	ctx.assertStored()
+	ctx.assertStatus(loopdb.StateInitiated)
+
+	signalSwapPaymentResult := ctx.AssertPaid(swapInvoiceDesc)
+	signalPrepaymentResult := ctx.AssertPaid(prepayInvoiceDesc)
+
+	ctx.Context.AssertRegisterConf(false, defaultConfirmations)
+
+	machine := getStateMachine(t, ctx.swapClient, info.SwapHash)
+	require.NoError(t, machine.DefaultObserver.WaitForState(
+		context.Background(), test.Timeout, loopOutWaitForHtlcConf,
+	))
+
+	// The server fails the payments before publishing the htlc.
+	signalSwapPaymentResult(
+		errors.New(lndclient.PaymentResultUnknownPaymentHash),
+	)
+	signalPrepaymentResult(
+		errors.New(lndclient.PaymentResultUnknownPaymentHash),
+	)
+	<-ctx.serverMock.cancelSwap
+
+	ctx.assertStatus(loopdb.StateFailOffchainPayments)
+	ctx.assertStoreFinished(loopdb.StateFailOffchainPayments)
+	ctx.finish()
+
+	assertStateHistory(
+		t, machine, fsm.EmptyState, loopOutPayInvoices,
+		loopOutWaitForHtlcConf, loopOutFailOffchainPayments,
+	)
+	assertLoopOutUpdates(
+		t, ctx.store, info.SwapHash, loopdb.StateFailOffchainPayments,
+	)
+}
+
+// getStateMachine returns the state machine of the executing swap with the
+// given hash.
+func getStateMachine(t *testing.T, client *Client,
+	hash lntypes.Hash) *fsm.TypedStateMachine[context.Context] {
+
+	t.Helper()
+
+	_, introspector, err := client.GetSwapStateMachine(hash)
+	require.NoError(t, err)
+
+	return requireTypedStateMachine(t, introspector)
+}
+
+// assertStateHistory asserts that the state machine went through the given
+// states, starting with the state it was created in.
+func assertStateHistory(t *testing.T, machine fsm.Introspector,
+	expected ...fsm.StateType) {
+
+	t.Helper()
+
+	history := machine.Snapshot().History
+	require.NotEmpty(t, history)
+
+	states := []fsm.StateType{history[0].PreviousState}
+	for _, entry := range history {
+		states = append(states, entry.NextState)
+	}
+	require.Equal(t, expected, states)
+}
+
+// assertLoopOutUpdates asserts the swap states that are stored for the loop
+// out swap with the given hash.
+func assertLoopOutUpdates(t *testing.T, store *loopdb.StoreMock,
+	hash lntypes.Hash, expected ...loopdb.SwapState) {
+
+	t.Helper()
+
+	store.RLock()
+	defer store.RUnlock()
+
+	var states []loopdb.SwapState
+	for _, update := range store.LoopOutUpdates[hash] {
+		states = append(states, update.State)
+	}
+	require.Equal(t, expected, states)
+}
@@ -0,0 +1,428 @@
+package loop
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// maxRouteQueryAttempts is the maximum number of routes we query from
+	// lnd when looking for a route that only goes through included nodes.
+	// Every rejected route adds its offending nodes to the ignored set, so
+	// this bounds the number of round trips rather than the search space.
+	maxRouteQueryAttempts = 10
+)
+
+var (
+	// errMultipleRouteChannels is returned if route preferences are
+	// combined with an outgoing channel set of more than one channel. A
+	// single route can only use one outgoing channel.
+	errMultipleRouteChannels = errors.New("route preferences can only " +
+		"be combined with a single outgoing channel")
+)
+
+// validateRoutePreferences checks that the passed route preferences can be
+// used to pay the given destination over the passed outgoing channel set.
+func validateRoutePreferences(prefs loopdb.RoutePreferences,
+	dest route.Vertex, outgoingChanSet loopdb.ChannelSet) error {
+
+	if !prefs.IsSet() {
+		return nil
+	}
+
+	if len(outgoingChanSet) > 1 {
+		return errMultipleRouteChannels
+	}
+
+	return prefs.Validate(dest)
+}
+
+// sendPaymentToRoute pays the invoice over routes that satisfy the passed
+// route preferences and waits for the payment to reach a final state. Like
+// regular payments, a failed attempt is retried over a newly selected route
+// up to the configured maximum number of payment retries, as long as no new
+// attempt would start after the payment timeout.
+func (s *loopOutSwap) sendPaymentToRoute(ctx context.Context, invoice string,
+	maxFee btcutil.Amount, outgoingChanIds loopdb.ChannelSet,
+	paymentTimeout time.Duration, prefs loopdb.RoutePreferences) (
+	*lndclient.PaymentStatus, int, error) {
+
+	if s.executeConfig.routeSender == nil {
+		return nil, 0, errRouteSenderUnavailable
+	}
+
+	payReq, err := zpay32.Decode(invoice, s.lnd.ChainParams)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if payReq.MilliSat == nil {
+		return nil, 0, errors.New("no amount in invoice")
+	}
+
+	var hash lntypes.Hash
+	copy(hash[:], payReq.PaymentHash[:])
+
+	maxAttempts := s.executeConfig.maxPaymentRetries
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	if paymentTimeout == 0 {
+		paymentTimeout = s.executeConfig.totalPaymentTimeout
+	}
+
+	var deadline time.Time
+	if paymentTimeout > 0 {
+		deadline = time.Now().Add(paymentTimeout)
+	}
+
+	var paymentStatus *lndclient.PaymentStatus
+	for attempt := 1; ; attempt++ {
+		rt, err := selectRoute(
+			ctx, s.executeConfig.routeSender, payReq, maxFee,
+			outgoingChanIds, prefs,
+		)
+
+		// If no other route is found after a failed attempt, the
+		// payment failed.
+		if err != nil && paymentStatus != nil {
+			s.log.Infof("No route for payment %v retry: %v", hash,
+				err)
+
+			return paymentStatus, attempt - 1, nil
+		}
+		if err != nil {
+			return nil, attempt, fmt.Errorf("unable to select "+
+				"route: %w", err)
+		}
+
+		s.log.Infof("Sending payment %v (attempt %v/%v) over route %v "+
+			"(fee=%v msat)", hash, attempt, maxAttempts,
+			routeString(rt), rt.TotalFeesMsat)
+
+		htlc, err := s.executeConfig.routeSender.SendToRoute(
+			ctx, hash, rt,
+		)
+		switch {
+		// If the payment was already dispatched before, for example
+		// because the swap was resumed after a restart, we only need to
+		// track it to its final state.
+		case isPaymentInitiatedErr(err):
+			s.log.Infof("Payment %v already initiated: %v", hash,
+				err)
+
+		case err != nil:
+			return nil, attempt, err
+
+		default:
+			s.log.Infof("Payment %v htlc attempt %v", hash,
+				htlc.Status)
+		}
+
+		// We obtain the final payment status from lnd rather than
+		// converting the htlc attempt, so that the fee and failure
+		// reason are reported exactly as they would be for a regular
+		// payment.
+		paymentStatus, err = s.awaitTrackPayment(ctx, hash)
+		if err != nil {
+			return nil, attempt, err
+		}
+
+		if paymentStatus.State == lnrpc.Payment_SUCCEEDED ||
+			!isRetryableRouteFailure(paymentStatus.FailureReason) ||
+			attempt >= maxAttempts ||
+			(!deadline.IsZero() && time.Now().After(deadline)) {
+
+			return paymentStatus, attempt, nil
+		}
+
+		s.log.Infof("Payment %v failed over route: %v, retrying", hash,
+			paymentStatus.FailureReason)
+	}
+}
+
+// isRetryableRouteFailure returns true if a payment that failed with the
+// passed reason may succeed over a different route.
+func isRetryableRouteFailure(reason lnrpc.PaymentFailureReason) bool {
+	switch reason {
+	case lnrpc.PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS,
+		lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE:
+
+		return false
+
+	default:
+		return true
+	}
+}
+
+// awaitTrackPayment tracks the payment with the passed hash until it reaches
+// a final state.
+func (s *loopOutSwap) awaitTrackPayment(ctx context.Context,
+	hash lntypes.Hash) (*lndclient.PaymentStatus, error) {
+
+	payStatusChan, payErrChan, err := s.lnd.Router.TrackPayment(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case payState := <-payStatusChan:
+			s.log.Infof("Payment %v: %v", hash, payState)
+
+			switch payState.State {
+			case lnrpc.Payment_SUCCEEDED, lnrpc.Payment_FAILED:
+				return &payState, nil
+
+			case lnrpc.Payment_IN_FLIGHT:
+				// Continue waiting for final state.
+
+			default:
+				return nil, errors.New("unknown payment state")
+			}
+
+		case err := <-payErrChan:
+			return nil, err
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// isPaymentInitiatedErr returns true if the passed error indicates that lnd
+// already knows about a payment for the hash we tried to send to.
+func isPaymentInitiatedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	errStr := err.Error()
+
+	return strings.Contains(errStr, channeldb.ErrAlreadyPaid.Error()) ||
+		strings.Contains(errStr, channeldb.ErrPaymentInFlight.Error())
+}
+
+// selectRoute builds or queries a route to the invoice destination that
+// satisfies the passed route preferences and stays within the maximum routing
+// fee.
+func selectRoute(ctx context.Context, sender routeSender,
+	payReq *zpay32.Invoice, maxFee btcutil.Amount,
+	outgoingChanIds loopdb.ChannelSet,
+	prefs loopdb.RoutePreferences) (*lnrpc.Route, error) {
+
+	if len(outgoingChanIds) > 1 {
+		return nil, errMultipleRouteChannels
+	}
+
+	var outgoingChanID uint64
+	if len(outgoingChanIds) == 1 {
+		outgoingChanID = outgoingChanIds[0]
+	}
+
+	var paymentAddr []byte
+	if payReq.PaymentAddr != nil {
+		paymentAddr = payReq.PaymentAddr[:]
+	}
+
+	amtMsat := int64(*payReq.MilliSat)
+	finalCltvDelta := int32(payReq.MinFinalCLTVExpiry())
+
+	var (
+		rt  *lnrpc.Route
+		err error
+	)
+	if len(prefs.Hops) > 0 {
+		hops := make([][]byte, len(prefs.Hops))
+		for i, hop := range prefs.Hops {
+			hops[i] = hop[:]
+		}
+
+		rt, err = sender.BuildRoute(ctx, &routerrpc.BuildRouteRequest{
+			AmtMsat:        amtMsat,
+			FinalCltvDelta: finalCltvDelta,
+			OutgoingChanId: outgoingChanID,
+			HopPubkeys:     hops,
+			PaymentAddr:    paymentAddr,
+		})
+	} else {
+		rt, err = queryRestrictedRoute(
+			ctx, sender, payReq, maxFee, outgoingChanID, prefs,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rt.Hops) == 0 {
+		return nil, errors.New("empty route")
+	}
+
+	maxFeeMsat := lnwire.NewMSatFromSatoshis(maxFee)
+	if lnwire.MilliSatoshi(rt.TotalFeesMsat) > maxFeeMsat {
+		return nil, fmt.Errorf("route fee %v msat exceeds maximum "+
+			"routing fee %v", rt.TotalFeesMsat, maxFee)
+	}
+
+	// QueryRoutes doesn't know about the payment address of the invoice,
+	// so we add the mpp record to the final hop ourselves. This is a no-op
+	// for routes returned by BuildRoute.
+	finalHop := rt.Hops[len(rt.Hops)-1]
+	if paymentAddr != nil && finalHop.MppRecord == nil {
+		finalHop.MppRecord = &lnrpc.MPPRecord{
+			PaymentAddr:  paymentAddr,
+			TotalAmtMsat: amtMsat,
+		}
+	}
+
+	return rt, nil
+}
+
+// queryRestrictedRoute queries lnd for a route that avoids the excluded nodes
+// and only uses included nodes as intermediate hops. As lnd doesn't support
+// include sets natively, intermediate nodes outside of the include set are
+// added to the ignored nodes and the route is queried again.
+func queryRestrictedRoute(ctx context.Context, sender routeSender,
+	payReq *zpay32.Invoice, maxFee btcutil.Amount, outgoingChanID uint64,
+	prefs loopdb.RoutePreferences) (*lnrpc.Route, error) {
+
+	included := make(map[route.Vertex]struct{}, len(prefs.IncludeNodes))
+	for _, node := range prefs.IncludeNodes {
+		included[node] = struct{}{}
+	}
+
+	// The nodes of the invoice's route hints lead to the private
+	// destination, so routes always go through them, whether they are
+	// included or not.
+	hintNodes := make(map[route.Vertex]struct{})
+	for _, routeHint := range payReq.RouteHints {
+		for _, hopHint := range routeHint {
+			hintNodes[route.NewVertex(hopHint.NodeID)] = struct{}{}
+		}
+	}
+
+	ignored := make([][]byte, 0, len(prefs.ExcludeNodes))
+	for _, node := range prefs.ExcludeNodes {
+		ignored = append(ignored, node[:])
+	}
+
+	dest := payReq.Destination.SerializeCompressed()
+	req := &lnrpc.QueryRoutesRequest{
+		PubKey:         hex.EncodeToString(dest),
+		AmtMsat:        int64(*payReq.MilliSat),
+		FinalCltvDelta: int32(payReq.MinFinalCLTVExpiry()),
+		FeeLimit: &lnrpc.FeeLimit{
+			Limit: &lnrpc.FeeLimit_Fixed{
+				Fixed: int64(maxFee),
+			},
+		},
+		OutgoingChanId:    outgoingChanID,
+		UseMissionControl: true,
+		RouteHints:        marshallLndRouteHints(payReq.RouteHints),
+	}
+
+	if payReq.Features != nil {
+		for bit := range payReq.Features.Features() {
+			req.DestFeatures = append(
+				req.DestFeatures, lnrpc.FeatureBit(bit),
+			)
+		}
+	}
+
+	for i := 0; i < maxRouteQueryAttempts; i++ {
+		req.IgnoredNodes = ignored
+
+		rt, err := sender.QueryRoute(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(included) == 0 {
+			return rt, nil
+		}
+
+		// Check the intermediate hops against the include set. The
+		// final hop is the destination and the hops of route hints are
+		// given by the invoice, so they are always allowed.
+		var rejected bool
+		for _, hop := range rt.Hops[:max(len(rt.Hops)-1, 0)] {
+			node, err := route.NewVertexFromStr(hop.PubKey)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := included[node]; ok {
+				continue
+			}
+
+			if _, ok := hintNodes[node]; ok {
+				continue
+			}
+
+			rejected = true
+			ignored = append(ignored, node[:])
+		}
+
+		if !rejected {
+			return rt, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no route through included nodes found "+
+		"after %v attempts", maxRouteQueryAttempts)
+}
+
+// marshallLndRouteHints converts the route hints of an invoice into their lnd
+// rpc representation.
+func marshallLndRouteHints(routeHints [][]zpay32.HopHint) []*lnrpc.RouteHint {
+	rpcRouteHints := make([]*lnrpc.RouteHint, 0, len(routeHints))
+	for _, routeHint := range routeHints {
+		rpcRouteHint := make([]*lnrpc.HopHint, 0, len(routeHint))
+		for _, hint := range routeHint {
+			pubKey := hint.NodeID.SerializeCompressed()
+			nodeID := hex.EncodeToString(pubKey)
+			feeRate := hint.FeeProportionalMillionths
+			cltvDelta := uint32(hint.CLTVExpiryDelta)
+
+			rpcRouteHint = append(rpcRouteHint, &lnrpc.HopHint{
+				NodeId:                    nodeID,
+				ChanId:                    hint.ChannelID,
+				FeeBaseMsat:               hint.FeeBaseMSat,
+				FeeProportionalMillionths: feeRate,
+				CltvExpiryDelta:           cltvDelta,
+			})
+		}
+
+		rpcRouteHints = append(rpcRouteHints, &lnrpc.RouteHint{
+			HopHints: rpcRouteHint,
+		})
+	}
+
+	return rpcRouteHints
+}
+
+// routeString returns a human-readable representation of the passed route.
+func routeString(rt *lnrpc.Route) string {
+	hops := make([]string, len(rt.Hops))
+	for i, hop := range rt.Hops {
+		hops[i] = fmt.Sprintf("%v(%v)", hop.PubKey, hop.ChanId)
+	}
+
+	return strings.Join(hops, " -> ")
+}
@@ -0,0 +1,416 @@
+package loop
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRouteSender is a routeSender that returns pre-set routes and records
+// the requests it receives.
+type mockRouteSender struct {
+	queryRoutes  []*lnrpc.Route
+	queryDests   []string
+	ignoredNodes [][][]byte
+
+	buildRoute    *lnrpc.Route
+	buildRequests []*routerrpc.BuildRouteRequest
+
+	sentRoutes []*lnrpc.Route
+}
+
+func (m *mockRouteSender) QueryRoute(_ context.Context,
+	req *lnrpc.QueryRoutesRequest) (*lnrpc.Route, error) {
+
+	// Copy the ignored nodes as the caller reuses the request.
+	m.queryDests = append(m.queryDests, req.PubKey)
+	m.ignoredNodes = append(
+		m.ignoredNodes, append([][]byte{}, req.IgnoredNodes...),
+	)
+
+	rt := m.queryRoutes[0]
+	m.queryRoutes = m.queryRoutes[1:]
+
+	return rt, nil
+}
+
+func (m *mockRouteSender) BuildRoute(_ context.Context,
+	req *routerrpc.BuildRouteRequest) (*lnrpc.Route, error) {
+
+	m.buildRequests = append(m.buildRequests, req)
+
+	return m.buildRoute, nil
+}
+
+func (m *mockRouteSender) SendToRoute(_ context.Context, _ lntypes.Hash,
+	rt *lnrpc.Route) (*lnrpc.HTLCAttempt, error) {
+
+	m.sentRoutes = append(m.sentRoutes, rt)
+
+	return &lnrpc.HTLCAttempt{Status: lnrpc.HTLCAttempt_SUCCEEDED}, nil
+}
+
+// makeRouteTestNode returns a new random node key.
+func makeRouteTestNode(t *testing.T) (*btcec.PublicKey, route.Vertex) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pubKey := privKey.PubKey()
+
+	return pubKey, route.NewVertex(pubKey)
+}
+
+// makeTestRoute creates a route along the passed nodes with the given fee.
+func makeTestRoute(feeMsat int64, nodes ...route.Vertex) *lnrpc.Route {
+	rt := &lnrpc.Route{
+		TotalFeesMsat: feeMsat,
+	}
+	for i, node := range nodes {
+		rt.Hops = append(rt.Hops, &lnrpc.Hop{
+			ChanId: uint64(i + 1),
+			PubKey: hex.EncodeToString(node[:]),
+		})
+	}
+
+	return rt
+}
+
+// TestSelectRoute tests that routes are built or queried according to the
+// route preferences and validated against the maximum routing fee.
+func TestSelectRoute(t *testing.T) {
+	destKey, dest := makeRouteTestNode(t)
+	_, nodeA := makeRouteTestNode(t)
+	_, nodeB := makeRouteTestNode(t)
+	_, nodeC := makeRouteTestNode(t)
+
+	amt := lnwire.MilliSatoshi(100_000_000)
+	paymentAddr := [32]byte{1, 2, 3}
+	payReq := &zpay32.Invoice{
+		MilliSat:    &amt,
+		PaymentHash: &[32]byte{4},
+		PaymentAddr: &paymentAddr,
+		Destination: destKey,
+	}
+
+	t.Run("explicit hops", func(t *testing.T) {
+		sender := &mockRouteSender{
+			buildRoute: makeTestRoute(1000, nodeA, dest),
+		}
+
+		rt, err := selectRoute(
+			context.Background(), sender, payReq, 10,
+			loopdb.ChannelSet{5},
+			loopdb.RoutePreferences{
+				Hops: []route.Vertex{nodeA, dest},
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, sender.buildRequests, 1)
+		require.Empty(t, sender.queryDests)
+
+		req := sender.buildRequests[0]
+		require.Equal(t, [][]byte{nodeA[:], dest[:]}, req.HopPubkeys)
+		require.Equal(t, uint64(5), req.OutgoingChanId)
+		require.Equal(t, paymentAddr[:], req.PaymentAddr)
+		require.Equal(t, int64(amt), req.AmtMsat)
+
+		finalHop := rt.Hops[len(rt.Hops)-1]
+		require.Equal(t, paymentAddr[:], finalHop.MppRecord.PaymentAddr)
+		require.Equal(t, int64(amt), finalHop.MppRecord.TotalAmtMsat)
+	})
+
+	t.Run("include and exclude sets", func(t *testing.T) {
+		// The first route goes through node B which is not part of the
+		// include set, so it should be ignored in the second query.
+		sender := &mockRouteSender{
+			queryRoutes: []*lnrpc.Route{
+				makeTestRoute(1000, nodeB, dest),
+				makeTestRoute(2000, nodeA, dest),
+			},
+		}
+
+		rt, err := selectRoute(
+			context.Background(), sender, payReq, 10, nil,
+			loopdb.RoutePreferences{
+				IncludeNodes: []route.Vertex{nodeA},
+				ExcludeNodes: []route.Vertex{nodeC},
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, int64(2000), rt.TotalFeesMsat)
+
+		destStr := hex.EncodeToString(dest[:])
+		require.Equal(t, []string{destStr, destStr}, sender.queryDests)
+		require.Equal(t, [][][]byte{
+			{nodeC[:]},
+			{nodeC[:], nodeB[:]},
+		}, sender.ignoredNodes)
+
+		finalHop := rt.Hops[len(rt.Hops)-1]
+		require.Equal(t, paymentAddr[:], finalHop.MppRecord.PaymentAddr)
+	})
+
+	t.Run("route hint nodes", func(t *testing.T) {
+		// Node B is the entry of the invoice's route hint, so it's
+		// allowed even though it isn't part of the include set.
+		nodeBKey, err := btcec.ParsePubKey(nodeB[:])
+		require.NoError(t, err)
+
+		hintReq := *payReq
+		hintReq.RouteHints = [][]zpay32.HopHint{{{
+			NodeID:    nodeBKey,
+			ChannelID: 7,
+		}}}
+
+		sender := &mockRouteSender{
+			queryRoutes: []*lnrpc.Route{
+				makeTestRoute(1000, nodeA, nodeB, dest),
+			},
+		}
+
+		rt, err := selectRoute(
+			context.Background(), sender, &hintReq, 10, nil,
+			loopdb.RoutePreferences{
+				IncludeNodes: []route.Vertex{nodeA},
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, sender.queryDests, 1)
+		require.Len(t, rt.Hops, 3)
+	})
+
+	t.Run("fee too high", func(t *testing.T) {
+		sender := &mockRouteSender{
+			buildRoute: makeTestRoute(10_001, nodeA, dest),
+		}
+
+		_, err := selectRoute(
+			context.Background(), sender, payReq, 10, nil,
+			loopdb.RoutePreferences{
+				Hops: []route.Vertex{nodeA, dest},
+			},
+		)
+		require.ErrorContains(t, err, "exceeds maximum routing fee")
+	})
+
+	t.Run("multiple outgoing channels", func(t *testing.T) {
+		_, err := selectRoute(
+			context.Background(), &mockRouteSender{}, payReq, 10,
+			loopdb.ChannelSet{1, 2},
+			loopdb.RoutePreferences{
+				ExcludeNodes: []route.Vertex{nodeC},
+			},
+		)
+		require.ErrorIs(t, err, errMultipleRouteChannels)
+	})
+}
+
+// TestSendPaymentToRoute tests that payments over restricted routes are
+// retried over newly selected routes until they succeed, fail permanently or
+// run out of retries.
+func TestSendPaymentToRoute(t *testing.T) {
+	defer test.Guard(t)()
+
+	_, nodeA := makeRouteTestNode(t)
+	_, nodeB := makeRouteTestNode(t)
+	_, nodeC := makeRouteTestNode(t)
+
+	invoice, err := getInvoice(testPreimage.Hash(), 10000, swapInvoiceDesc)
+	require.NoError(t, err)
+
+	prefs := loopdb.RoutePreferences{
+		ExcludeNodes: []route.Vertex{nodeC},
+	}
+
+	// sendPayment pays the invoice with the passed number of retries and
+	// returns the final payment states to lnd's payment tracking, one per
+	// attempt.
+	sendPayment := func(t *testing.T, maxRetries int,
+		states ...lndclient.PaymentStatus) (*mockRouteSender,
+		*lndclient.PaymentStatus, int) {
+
+		lnd := test.NewMockLnd()
+		sender := &mockRouteSender{
+			queryRoutes: []*lnrpc.Route{
+				makeTestRoute(1000, nodeA),
+				makeTestRoute(1000, nodeB),
+			},
+		}
+
+		s := &loopOutSwap{
+			swapKit: *newSwapKit(
+				testPreimage.Hash(), swap.TypeOut,
+				newSwapConfig(&lnd.LndServices, nil, nil),
+				&loopdb.SwapContract{},
+			),
+			executeConfig: executeConfig{
+				routeSender:         sender,
+				maxPaymentRetries:   maxRetries,
+				totalPaymentTimeout: time.Minute,
+			},
+		}
+
+		go func() {
+			for _, state := range states {
+				msg := <-lnd.TrackPaymentChannel
+				msg.Updates <- state
+			}
+		}()
+
+		status, attempts, err := s.sendPaymentToRoute(
+			context.Background(), invoice, 10, nil, 0, prefs,
+		)
+		require.NoError(t, err)
+
+		return sender, status, attempts
+	}
+
+	failed := lndclient.PaymentStatus{
+		State:         lnrpc.Payment_FAILED,
+		FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_ERROR,
+	}
+	succeeded := lndclient.PaymentStatus{
+		State: lnrpc.Payment_SUCCEEDED,
+	}
+
+	t.Run("retry succeeds", func(t *testing.T) {
+		sender, status, attempts := sendPayment(
+			t, 2, failed, succeeded,
+		)
+		require.Equal(t, lnrpc.Payment_SUCCEEDED, status.State)
+		require.Equal(t, 2, attempts)
+
+		// The retry is sent over a newly queried route.
+		require.Len(t, sender.sentRoutes, 2)
+		require.Equal(
+			t, hex.EncodeToString(nodeA[:]),
+			sender.sentRoutes[0].Hops[0].PubKey,
+		)
+		require.Equal(
+			t, hex.EncodeToString(nodeB[:]),
+			sender.sentRoutes[1].Hops[0].PubKey,
+		)
+	})
+
+	t.Run("out of retries", func(t *testing.T) {
+		sender, status, attempts := sendPayment(t, 1, failed)
+		require.Equal(t, lnrpc.Payment_FAILED, status.State)
+		require.Equal(t, 1, attempts)
+		require.Len(t, sender.sentRoutes, 1)
+	})
+
+	t.Run("permanent failure", func(t *testing.T) {
+		incorrectDetails := lndclient.PaymentStatus{
+			State: lnrpc.Payment_FAILED,
+			FailureReason: lnrpc.
+				PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS,
+		}
+
+		sender, status, attempts := sendPayment(
+			t, 2, incorrectDetails,
+		)
+		require.Equal(t, lnrpc.Payment_FAILED, status.State)
+		require.Equal(t, 1, attempts)
+		require.Len(t, sender.sentRoutes, 1)
+	})
+}
+
+// TestValidateRoutePreferences tests validation of route preferences against
+// the payment destination and outgoing channel set.
+func TestValidateRoutePreferences(t *testing.T) {
+	_, dest := makeRouteTestNode(t)
+	_, nodeA := makeRouteTestNode(t)
+	_, nodeB := makeRouteTestNode(t)
+
+	tests := []struct {
+		name    string
+		prefs   loopdb.RoutePreferences
+		chanSet loopdb.ChannelSet
+		valid   bool
+	}{
+		{
+			name:    "no preferences",
+			chanSet: loopdb.ChannelSet{1, 2},
+			valid:   true,
+		},
+		{
+			name: "valid hops",
+			prefs: loopdb.RoutePreferences{
+				Hops:         []route.Vertex{nodeA, dest},
+				IncludeNodes: []route.Vertex{nodeA},
+				ExcludeNodes: []route.Vertex{nodeB},
+			},
+			chanSet: loopdb.ChannelSet{1},
+			valid:   true,
+		},
+		{
+			name: "multiple channels",
+			prefs: loopdb.RoutePreferences{
+				Hops: []route.Vertex{nodeA, dest},
+			},
+			chanSet: loopdb.ChannelSet{1, 2},
+		},
+		{
+			name: "last hop not destination",
+			prefs: loopdb.RoutePreferences{
+				Hops: []route.Vertex{dest, nodeA},
+			},
+		},
+		{
+			name: "excluded hop",
+			prefs: loopdb.RoutePreferences{
+				Hops:         []route.Vertex{nodeA, dest},
+				ExcludeNodes: []route.Vertex{nodeA},
+			},
+		},
+		{
+			name: "hop not included",
+			prefs: loopdb.RoutePreferences{
+				Hops:         []route.Vertex{nodeA, dest},
+				IncludeNodes: []route.Vertex{nodeB},
+			},
+		},
+		{
+			name: "destination excluded",
+			prefs: loopdb.RoutePreferences{
+				ExcludeNodes: []route.Vertex{dest},
+			},
+		},
+		{
+			name: "node included and excluded",
+			prefs: loopdb.RoutePreferences{
+				IncludeNodes: []route.Vertex{nodeA},
+				ExcludeNodes: []route.Vertex{nodeA},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateRoutePreferences(
+				testCase.prefs, dest, testCase.chanSet,
+			)
+			if testCase.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
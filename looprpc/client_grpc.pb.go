@@ -35,7 +35,7 @@ type SwapClientClient interface {
 	Monitor(ctx context.Context, in *MonitorRequest, opts ...grpc.CallOption) (SwapClient_MonitorClient, error)
 	// loop: `listswaps`
 	// ListSwaps returns a list of all currently known swaps and their current
-	// status.
+	// status. The swaps can be filtered, sorted and paginated.
 	ListSwaps(ctx context.Context, in *ListSwapsRequest, opts ...grpc.CallOption) (*ListSwapsResponse, error)
 	// loop: `swapinfo`
 	// SwapInfo returns all known details about a single swap.
@@ -91,6 +91,16 @@ type SwapClientClient interface {
 	// loop: `listreservations`
 	// ListReservations returns a list of all reservations the server opened to us.
 	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
+	// loop: `reservations request`
+	// ReservationQuote returns the fee the server charges for opening a
+	// reservation with the given amount and expiry on our request.
+	ReservationQuote(ctx context.Context, in *ReservationQuoteRequest, opts ...grpc.CallOption) (*ReservationQuoteResponse, error)
+	// loop: `reservations request`
+	// RequestReservation requests a reservation with the given amount and expiry
+	// from the server. The parameters offered by the server are validated and
+	// its fee is paid if it doesn't exceed the given maximum, before the
+	// reservation is opened.
+	RequestReservation(ctx context.Context, in *RequestReservationRequest, opts ...grpc.CallOption) (*RequestReservationResponse, error)
 	// loop: `instantout`
 	// InstantOut initiates an instant out swap with the given parameters.
 	InstantOut(ctx context.Context, in *InstantOutRequest, opts ...grpc.CallOption) (*InstantOutResponse, error)
@@ -102,6 +112,73 @@ type SwapClientClient interface {
 	// ListInstantOuts returns a list of all currently known instant out swaps and
 	// their current status.
 	ListInstantOuts(ctx context.Context, in *ListInstantOutsRequest, opts ...grpc.CallOption) (*ListInstantOutsResponse, error)
+	// loop: `cancelinstantout`
+	// CancelInstantOut cancels an instant out swap that hasn't sent its htlc
+	// signatures to the server yet. The swap is cancelled with the server and
+	// its reservations are unlocked.
+	CancelInstantOut(ctx context.Context, in *CancelInstantOutRequest, opts ...grpc.CallOption) (*CancelInstantOutResponse, error)
+	// loop: `monitor --reservations`
+	// MonitorReservations returns a stream of reservation updates. All known
+	// reservations are sent first, followed by an update every time a
+	// reservation changes its state.
+	MonitorReservations(ctx context.Context, in *MonitorReservationsRequest, opts ...grpc.CallOption) (SwapClient_MonitorReservationsClient, error)
+	// loop: `monitor --instantouts`
+	// MonitorInstantOuts returns a stream of instant out updates. All known
+	// instant outs are sent first, followed by an update every time an instant
+	// out changes its state.
+	MonitorInstantOuts(ctx context.Context, in *MonitorInstantOutsRequest, opts ...grpc.CallOption) (SwapClient_MonitorInstantOutsClient, error)
+	// loop: `psbt fund`
+	// FundLoopInPsbt creates a funded but unsigned PSBT that pays the htlc of a
+	// pending external loop in swap. The PSBT can be signed by an external
+	// signer and then be published with PublishLoopInPsbt.
+	FundLoopInPsbt(ctx context.Context, in *FundLoopInPsbtRequest, opts ...grpc.CallOption) (*FundLoopInPsbtResponse, error)
+	// loop: `psbt publish`
+	// PublishLoopInPsbt verifies that a signed PSBT pays the htlc of a pending
+	// external loop in swap with the exact swap amount and publishes it.
+	PublishLoopInPsbt(ctx context.Context, in *PublishLoopInPsbtRequest, opts ...grpc.CallOption) (*PublishLoopInPsbtResponse, error)
+	// loop: `debug fsm`
+	// GetStateMachine returns the live state of the state machine of an active
+	// swap, reservation or instant out. The response contains the current
+	// state, the recent transitions, the last action error and the state graph,
+	// which can optionally be rendered as a Mermaid or DOT diagram.
+	GetStateMachine(ctx context.Context, in *GetStateMachineRequest, opts ...grpc.CallOption) (*GetStateMachineResponse, error)
+	// loop: `db export`
+	// ExportDatabase exports the swap database into a versioned JSON archive.
+	// The archive contains the loop outs, loop ins, their updates, the liquidity
+	// parameters, the reservations, the instant outs and the sweep batches. It
+	// includes the swap preimages and must be kept secret.
+	ExportDatabase(ctx context.Context, in *ExportDatabaseRequest, opts ...grpc.CallOption) (*ExportDatabaseResponse, error)
+	// loop: `db import`
+	// ImportDatabase imports an archive that was created by ExportDatabase. The
+	// database of loopd must not contain any swaps, reservations or sweep batches
+	// yet. Pending swaps of the archive are resumed once loopd is restarted.
+	ImportDatabase(ctx context.Context, in *ImportDatabaseRequest, opts ...grpc.CallOption) (*ImportDatabaseResponse, error)
+	// loop: `db compact`
+	// CompactSwaps compacts the loop outs and loop ins that reached a final state
+	// more than the given number of days ago. Only the final update of such a
+	// swap is kept, which holds the final state and the costs of the swap. The
+	// intermediate updates are deleted. Optionally, an archive of the compacted
+	// swaps with all their updates is returned.
+	CompactSwaps(ctx context.Context, in *CompactSwapsRequest, opts ...grpc.CallOption) (*CompactSwapsResponse, error)
+	// loop: `recover`
+	// RecoverSwaps restores the pending swaps of an encrypted swap backup file
+	// that aren't stored in the database yet and resumes them. The backup must
+	// have been created by a loopd that is connected to an lnd with the same
+	// seed.
+	RecoverSwaps(ctx context.Context, in *RecoverSwapsRequest, opts ...grpc.CallOption) (*RecoverSwapsResponse, error)
+	// loop: `recover htlc`
+	// RecoverHtlc creates and signs a transaction that spends the confirmed htlc
+	// of a swap to the wallet of lnd or a given address. The htlc of a loop in
+	// swap is spent with the timeout path, the htlc of a loop out swap with the
+	// success path which reveals the preimage of the swap. This is meant as a
+	// last resort if a swap can't be completed by loopd anymore.
+	RecoverHtlc(ctx context.Context, in *RecoverHtlcRequest, opts ...grpc.CallOption) (*RecoverHtlcResponse, error)
+	// loop: `presignedtimeouts`
+	// GetPresignedTimeouts returns the timeout transactions that were signed in
+	// advance for the confirmed htlcs of pending loop in swaps. They can be
+	// handed to a third party that publishes one of them once the htlc expired,
+	// in case loopd is offline at that time.
+	GetPresignedTimeouts(ctx context.Context, in *GetPresignedTimeoutsRequest, opts ...grpc.CallOption) (*GetPresignedTimeoutsResponse, error)
 }
 
 type swapClientClient struct {
@@ -297,6 +374,24 @@ func (c *swapClientClient) ListReservations(ctx context.Context, in *ListReserva
 	return out, nil
 }
 
+func (c *swapClientClient) ReservationQuote(ctx context.Context, in *ReservationQuoteRequest, opts ...grpc.CallOption) (*ReservationQuoteResponse, error) {
+	out := new(ReservationQuoteResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ReservationQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) RequestReservation(ctx context.Context, in *RequestReservationRequest, opts ...grpc.CallOption) (*RequestReservationResponse, error) {
+	out := new(RequestReservationResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RequestReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) InstantOut(ctx context.Context, in *InstantOutRequest, opts ...grpc.CallOption) (*InstantOutResponse, error) {
 	out := new(InstantOutResponse)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/InstantOut", in, out, opts...)
@@ -324,6 +419,160 @@ func (c *swapClientClient) ListInstantOuts(ctx context.Context, in *ListInstantO
 	return out, nil
 }
 
+func (c *swapClientClient) CancelInstantOut(ctx context.Context, in *CancelInstantOutRequest, opts ...grpc.CallOption) (*CancelInstantOutResponse, error) {
+	out := new(CancelInstantOutResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/CancelInstantOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) MonitorReservations(ctx context.Context, in *MonitorReservationsRequest, opts ...grpc.CallOption) (SwapClient_MonitorReservationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SwapClient_ServiceDesc.Streams[1], "/looprpc.SwapClient/MonitorReservations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &swapClientMonitorReservationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SwapClient_MonitorReservationsClient interface {
+	Recv() (*ClientReservation, error)
+	grpc.ClientStream
+}
+
+type swapClientMonitorReservationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *swapClientMonitorReservationsClient) Recv() (*ClientReservation, error) {
+	m := new(ClientReservation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *swapClientClient) MonitorInstantOuts(ctx context.Context, in *MonitorInstantOutsRequest, opts ...grpc.CallOption) (SwapClient_MonitorInstantOutsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SwapClient_ServiceDesc.Streams[2], "/looprpc.SwapClient/MonitorInstantOuts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &swapClientMonitorInstantOutsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SwapClient_MonitorInstantOutsClient interface {
+	Recv() (*InstantOut, error)
+	grpc.ClientStream
+}
+
+type swapClientMonitorInstantOutsClient struct {
+	grpc.ClientStream
+}
+
+func (x *swapClientMonitorInstantOutsClient) Recv() (*InstantOut, error) {
+	m := new(InstantOut)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *swapClientClient) FundLoopInPsbt(ctx context.Context, in *FundLoopInPsbtRequest, opts ...grpc.CallOption) (*FundLoopInPsbtResponse, error) {
+	out := new(FundLoopInPsbtResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/FundLoopInPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) PublishLoopInPsbt(ctx context.Context, in *PublishLoopInPsbtRequest, opts ...grpc.CallOption) (*PublishLoopInPsbtResponse, error) {
+	out := new(PublishLoopInPsbtResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/PublishLoopInPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetStateMachine(ctx context.Context, in *GetStateMachineRequest, opts ...grpc.CallOption) (*GetStateMachineResponse, error) {
+	out := new(GetStateMachineResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetStateMachine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ExportDatabase(ctx context.Context, in *ExportDatabaseRequest, opts ...grpc.CallOption) (*ExportDatabaseResponse, error) {
+	out := new(ExportDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ExportDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ImportDatabase(ctx context.Context, in *ImportDatabaseRequest, opts ...grpc.CallOption) (*ImportDatabaseResponse, error) {
+	out := new(ImportDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ImportDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) CompactSwaps(ctx context.Context, in *CompactSwapsRequest, opts ...grpc.CallOption) (*CompactSwapsResponse, error) {
+	out := new(CompactSwapsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/CompactSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) RecoverSwaps(ctx context.Context, in *RecoverSwapsRequest, opts ...grpc.CallOption) (*RecoverSwapsResponse, error) {
+	out := new(RecoverSwapsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RecoverSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) RecoverHtlc(ctx context.Context, in *RecoverHtlcRequest, opts ...grpc.CallOption) (*RecoverHtlcResponse, error) {
+	out := new(RecoverHtlcResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RecoverHtlc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetPresignedTimeouts(ctx context.Context, in *GetPresignedTimeoutsRequest, opts ...grpc.CallOption) (*GetPresignedTimeoutsResponse, error) {
+	out := new(GetPresignedTimeoutsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetPresignedTimeouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SwapClientServer is the server API for SwapClient service.
 // All implementations must embed UnimplementedSwapClientServer
 // for forward compatibility
@@ -345,7 +594,7 @@ type SwapClientServer interface {
 	Monitor(*MonitorRequest, SwapClient_MonitorServer) error
 	// loop: `listswaps`
 	// ListSwaps returns a list of all currently known swaps and their current
-	// status.
+	// status. The swaps can be filtered, sorted and paginated.
 	ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
 	// loop: `swapinfo`
 	// SwapInfo returns all known details about a single swap.
@@ -401,6 +650,16 @@ type SwapClientServer interface {
 	// loop: `listreservations`
 	// ListReservations returns a list of all reservations the server opened to us.
 	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
+	// loop: `reservations request`
+	// ReservationQuote returns the fee the server charges for opening a
+	// reservation with the given amount and expiry on our request.
+	ReservationQuote(context.Context, *ReservationQuoteRequest) (*ReservationQuoteResponse, error)
+	// loop: `reservations request`
+	// RequestReservation requests a reservation with the given amount and expiry
+	// from the server. The parameters offered by the server are validated and
+	// its fee is paid if it doesn't exceed the given maximum, before the
+	// reservation is opened.
+	RequestReservation(context.Context, *RequestReservationRequest) (*RequestReservationResponse, error)
 	// loop: `instantout`
 	// InstantOut initiates an instant out swap with the given parameters.
 	InstantOut(context.Context, *InstantOutRequest) (*InstantOutResponse, error)
@@ -412,6 +671,73 @@ type SwapClientServer interface {
 	// ListInstantOuts returns a list of all currently known instant out swaps and
 	// their current status.
 	ListInstantOuts(context.Context, *ListInstantOutsRequest) (*ListInstantOutsResponse, error)
+	// loop: `cancelinstantout`
+	// CancelInstantOut cancels an instant out swap that hasn't sent its htlc
+	// signatures to the server yet. The swap is cancelled with the server and
+	// its reservations are unlocked.
+	CancelInstantOut(context.Context, *CancelInstantOutRequest) (*CancelInstantOutResponse, error)
+	// loop: `monitor --reservations`
+	// MonitorReservations returns a stream of reservation updates. All known
+	// reservations are sent first, followed by an update every time a
+	// reservation changes its state.
+	MonitorReservations(*MonitorReservationsRequest, SwapClient_MonitorReservationsServer) error
+	// loop: `monitor --instantouts`
+	// MonitorInstantOuts returns a stream of instant out updates. All known
+	// instant outs are sent first, followed by an update every time an instant
+	// out changes its state.
+	MonitorInstantOuts(*MonitorInstantOutsRequest, SwapClient_MonitorInstantOutsServer) error
+	// loop: `psbt fund`
+	// FundLoopInPsbt creates a funded but unsigned PSBT that pays the htlc of a
+	// pending external loop in swap. The PSBT can be signed by an external
+	// signer and then be published with PublishLoopInPsbt.
+	FundLoopInPsbt(context.Context, *FundLoopInPsbtRequest) (*FundLoopInPsbtResponse, error)
+	// loop: `psbt publish`
+	// PublishLoopInPsbt verifies that a signed PSBT pays the htlc of a pending
+	// external loop in swap with the exact swap amount and publishes it.
+	PublishLoopInPsbt(context.Context, *PublishLoopInPsbtRequest) (*PublishLoopInPsbtResponse, error)
+	// loop: `debug fsm`
+	// GetStateMachine returns the live state of the state machine of an active
+	// swap, reservation or instant out. The response contains the current
+	// state, the recent transitions, the last action error and the state graph,
+	// which can optionally be rendered as a Mermaid or DOT diagram.
+	GetStateMachine(context.Context, *GetStateMachineRequest) (*GetStateMachineResponse, error)
+	// loop: `db export`
+	// ExportDatabase exports the swap database into a versioned JSON archive.
+	// The archive contains the loop outs, loop ins, their updates, the liquidity
+	// parameters, the reservations, the instant outs and the sweep batches. It
+	// includes the swap preimages and must be kept secret.
+	ExportDatabase(context.Context, *ExportDatabaseRequest) (*ExportDatabaseResponse, error)
+	// loop: `db import`
+	// ImportDatabase imports an archive that was created by ExportDatabase. The
+	// database of loopd must not contain any swaps, reservations or sweep batches
+	// yet. Pending swaps of the archive are resumed once loopd is restarted.
+	ImportDatabase(context.Context, *ImportDatabaseRequest) (*ImportDatabaseResponse, error)
+	// loop: `db compact`
+	// CompactSwaps compacts the loop outs and loop ins that reached a final state
+	// more than the given number of days ago. Only the final update of such a
+	// swap is kept, which holds the final state and the costs of the swap. The
+	// intermediate updates are deleted. Optionally, an archive of the compacted
+	// swaps with all their updates is returned.
+	CompactSwaps(context.Context, *CompactSwapsRequest) (*CompactSwapsResponse, error)
+	// loop: `recover`
+	// RecoverSwaps restores the pending swaps of an encrypted swap backup file
+	// that aren't stored in the database yet and resumes them. The backup must
+	// have been created by a loopd that is connected to an lnd with the same
+	// seed.
+	RecoverSwaps(context.Context, *RecoverSwapsRequest) (*RecoverSwapsResponse, error)
+	// loop: `recover htlc`
+	// RecoverHtlc creates and signs a transaction that spends the confirmed htlc
+	// of a swap to the wallet of lnd or a given address. The htlc of a loop in
+	// swap is spent with the timeout path, the htlc of a loop out swap with the
+	// success path which reveals the preimage of the swap. This is meant as a
+	// last resort if a swap can't be completed by loopd anymore.
+	RecoverHtlc(context.Context, *RecoverHtlcRequest) (*RecoverHtlcResponse, error)
+	// loop: `presignedtimeouts`
+	// GetPresignedTimeouts returns the timeout transactions that were signed in
+	// advance for the confirmed htlcs of pending loop in swaps. They can be
+	// handed to a third party that publishes one of them once the htlc expired,
+	// in case loopd is offline at that time.
+	GetPresignedTimeouts(context.Context, *GetPresignedTimeoutsRequest) (*GetPresignedTimeoutsResponse, error)
 	mustEmbedUnimplementedSwapClientServer()
 }
 
@@ -473,6 +799,12 @@ func (UnimplementedSwapClientServer) SuggestSwaps(context.Context, *SuggestSwaps
 func (UnimplementedSwapClientServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
 }
+func (UnimplementedSwapClientServer) ReservationQuote(context.Context, *ReservationQuoteRequest) (*ReservationQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservationQuote not implemented")
+}
+func (UnimplementedSwapClientServer) RequestReservation(context.Context, *RequestReservationRequest) (*RequestReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReservation not implemented")
+}
 func (UnimplementedSwapClientServer) InstantOut(context.Context, *InstantOutRequest) (*InstantOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstantOut not implemented")
 }
@@ -482,6 +814,42 @@ func (UnimplementedSwapClientServer) InstantOutQuote(context.Context, *InstantOu
 func (UnimplementedSwapClientServer) ListInstantOuts(context.Context, *ListInstantOutsRequest) (*ListInstantOutsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListInstantOuts not implemented")
 }
+func (UnimplementedSwapClientServer) CancelInstantOut(context.Context, *CancelInstantOutRequest) (*CancelInstantOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInstantOut not implemented")
+}
+func (UnimplementedSwapClientServer) MonitorReservations(*MonitorReservationsRequest, SwapClient_MonitorReservationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method MonitorReservations not implemented")
+}
+func (UnimplementedSwapClientServer) MonitorInstantOuts(*MonitorInstantOutsRequest, SwapClient_MonitorInstantOutsServer) error {
+	return status.Errorf(codes.Unimplemented, "method MonitorInstantOuts not implemented")
+}
+func (UnimplementedSwapClientServer) FundLoopInPsbt(context.Context, *FundLoopInPsbtRequest) (*FundLoopInPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundLoopInPsbt not implemented")
+}
+func (UnimplementedSwapClientServer) PublishLoopInPsbt(context.Context, *PublishLoopInPsbtRequest) (*PublishLoopInPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishLoopInPsbt not implemented")
+}
+func (UnimplementedSwapClientServer) GetStateMachine(context.Context, *GetStateMachineRequest) (*GetStateMachineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateMachine not implemented")
+}
+func (UnimplementedSwapClientServer) ExportDatabase(context.Context, *ExportDatabaseRequest) (*ExportDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDatabase not implemented")
+}
+func (UnimplementedSwapClientServer) ImportDatabase(context.Context, *ImportDatabaseRequest) (*ImportDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDatabase not implemented")
+}
+func (UnimplementedSwapClientServer) CompactSwaps(context.Context, *CompactSwapsRequest) (*CompactSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) RecoverSwaps(context.Context, *RecoverSwapsRequest) (*RecoverSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) RecoverHtlc(context.Context, *RecoverHtlcRequest) (*RecoverHtlcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverHtlc not implemented")
+}
+func (UnimplementedSwapClientServer) GetPresignedTimeouts(context.Context, *GetPresignedTimeoutsRequest) (*GetPresignedTimeoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresignedTimeouts not implemented")
+}
 func (UnimplementedSwapClientServer) mustEmbedUnimplementedSwapClientServer() {}
 
 // UnsafeSwapClientServer may be embedded to opt out of forward compatibility for this service.
@@ -822,6 +1190,42 @@ func _SwapClient_ListReservations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwapClient_ReservationQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ReservationQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ReservationQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ReservationQuote(ctx, req.(*ReservationQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_RequestReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).RequestReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/RequestReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).RequestReservation(ctx, req.(*RequestReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SwapClient_InstantOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InstantOutRequest)
 	if err := dec(in); err != nil {
@@ -876,6 +1280,228 @@ func _SwapClient_ListInstantOuts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwapClient_CancelInstantOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInstantOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).CancelInstantOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/CancelInstantOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).CancelInstantOut(ctx, req.(*CancelInstantOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_MonitorReservations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonitorReservationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwapClientServer).MonitorReservations(m, &swapClientMonitorReservationsServer{stream})
+}
+
+type SwapClient_MonitorReservationsServer interface {
+	Send(*ClientReservation) error
+	grpc.ServerStream
+}
+
+type swapClientMonitorReservationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *swapClientMonitorReservationsServer) Send(m *ClientReservation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SwapClient_MonitorInstantOuts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonitorInstantOutsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwapClientServer).MonitorInstantOuts(m, &swapClientMonitorInstantOutsServer{stream})
+}
+
+type SwapClient_MonitorInstantOutsServer interface {
+	Send(*InstantOut) error
+	grpc.ServerStream
+}
+
+type swapClientMonitorInstantOutsServer struct {
+	grpc.ServerStream
+}
+
+func (x *swapClientMonitorInstantOutsServer) Send(m *InstantOut) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SwapClient_FundLoopInPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundLoopInPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).FundLoopInPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/FundLoopInPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).FundLoopInPsbt(ctx, req.(*FundLoopInPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_PublishLoopInPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishLoopInPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).PublishLoopInPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/PublishLoopInPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).PublishLoopInPsbt(ctx, req.(*PublishLoopInPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetStateMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetStateMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetStateMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetStateMachine(ctx, req.(*GetStateMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ExportDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ExportDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ExportDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ExportDatabase(ctx, req.(*ExportDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ImportDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ImportDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ImportDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ImportDatabase(ctx, req.(*ImportDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_CompactSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).CompactSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/CompactSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).CompactSwaps(ctx, req.(*CompactSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_RecoverSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).RecoverSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/RecoverSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).RecoverSwaps(ctx, req.(*RecoverSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_RecoverHtlc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverHtlcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).RecoverHtlc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/RecoverHtlc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).RecoverHtlc(ctx, req.(*RecoverHtlcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetPresignedTimeouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresignedTimeoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetPresignedTimeouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetPresignedTimeouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetPresignedTimeouts(ctx, req.(*GetPresignedTimeoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SwapClient_ServiceDesc is the grpc.ServiceDesc for SwapClient service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -951,6 +1577,14 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReservations",
 			Handler:    _SwapClient_ListReservations_Handler,
 		},
+		{
+			MethodName: "ReservationQuote",
+			Handler:    _SwapClient_ReservationQuote_Handler,
+		},
+		{
+			MethodName: "RequestReservation",
+			Handler:    _SwapClient_RequestReservation_Handler,
+		},
 		{
 			MethodName: "InstantOut",
 			Handler:    _SwapClient_InstantOut_Handler,
@@ -963,6 +1597,46 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListInstantOuts",
 			Handler:    _SwapClient_ListInstantOuts_Handler,
 		},
+		{
+			MethodName: "CancelInstantOut",
+			Handler:    _SwapClient_CancelInstantOut_Handler,
+		},
+		{
+			MethodName: "FundLoopInPsbt",
+			Handler:    _SwapClient_FundLoopInPsbt_Handler,
+		},
+		{
+			MethodName: "PublishLoopInPsbt",
+			Handler:    _SwapClient_PublishLoopInPsbt_Handler,
+		},
+		{
+			MethodName: "GetStateMachine",
+			Handler:    _SwapClient_GetStateMachine_Handler,
+		},
+		{
+			MethodName: "ExportDatabase",
+			Handler:    _SwapClient_ExportDatabase_Handler,
+		},
+		{
+			MethodName: "ImportDatabase",
+			Handler:    _SwapClient_ImportDatabase_Handler,
+		},
+		{
+			MethodName: "CompactSwaps",
+			Handler:    _SwapClient_CompactSwaps_Handler,
+		},
+		{
+			MethodName: "RecoverSwaps",
+			Handler:    _SwapClient_RecoverSwaps_Handler,
+		},
+		{
+			MethodName: "RecoverHtlc",
+			Handler:    _SwapClient_RecoverHtlc_Handler,
+		},
+		{
+			MethodName: "GetPresignedTimeouts",
+			Handler:    _SwapClient_GetPresignedTimeouts_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -970,6 +1644,16 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SwapClient_Monitor_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "MonitorReservations",
+			Handler:       _SwapClient_MonitorReservations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MonitorInstantOuts",
+			Handler:       _SwapClient_MonitorInstantOuts_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "client.proto",
 }
@@ -0,0 +1,139 @@
+package loop
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// errRouteSenderUnavailable is returned if a payment should be sent
+	// over an explicit route, but no connection to lnd is available that
+	// allows us to do so.
+	errRouteSenderUnavailable = errors.New("explicit route selection " +
+		"is not available")
+)
+
+// routeSender is the interface that is used to pay invoices over explicitly
+// selected routes instead of letting lnd pathfind freely.
+type routeSender interface {
+	// QueryRoute returns the best route that satisfies the passed request.
+	QueryRoute(ctx context.Context,
+		req *lnrpc.QueryRoutesRequest) (*lnrpc.Route, error)
+
+	// BuildRoute builds a fully specified route along the hops in the
+	// passed request.
+	BuildRoute(ctx context.Context,
+		req *routerrpc.BuildRouteRequest) (*lnrpc.Route, error)
+
+	// SendToRoute attempts to pay the passed hash along the given route
+	// and blocks until the htlc attempt is resolved.
+	SendToRoute(ctx context.Context, hash lntypes.Hash,
+		route *lnrpc.Route) (*lnrpc.HTLCAttempt, error)
+}
+
+// lndRouteSender implements the routeSender interface using lnd's lightning
+// and router sub-server.
+type lndRouteSender struct {
+	lnd       *lndclient.LndServices
+	lightning lnrpc.LightningClient
+	router    routerrpc.RouterClient
+}
+
+// newLndRouteSender creates a new route sender that uses the gRPC connection
+// of the passed lnd services.
+func newLndRouteSender(lnd *lndclient.LndServices) *lndRouteSender {
+	sender := &lndRouteSender{
+		lnd: lnd,
+	}
+
+	if lnd.ClientConn != nil {
+		sender.lightning = lnrpc.NewLightningClient(lnd.ClientConn)
+		sender.router = routerrpc.NewRouterClient(lnd.ClientConn)
+	}
+
+	return sender
+}
+
+// QueryRoute returns the best route that satisfies the passed request.
+//
+// NOTE: This is part of the routeSender interface.
+func (l *lndRouteSender) QueryRoute(ctx context.Context,
+	req *lnrpc.QueryRoutesRequest) (*lnrpc.Route, error) {
+
+	if l.lightning == nil {
+		return nil, errRouteSenderUnavailable
+	}
+
+	rpcCtx, err := l.lnd.WithMacaroonAuthForService(
+		ctx, lndclient.AdminServiceMac,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.lightning.QueryRoutes(rpcCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Routes) == 0 {
+		return nil, lndclient.ErrNoRouteFound
+	}
+
+	return resp.Routes[0], nil
+}
+
+// BuildRoute builds a fully specified route along the hops in the passed
+// request.
+//
+// NOTE: This is part of the routeSender interface.
+func (l *lndRouteSender) BuildRoute(ctx context.Context,
+	req *routerrpc.BuildRouteRequest) (*lnrpc.Route, error) {
+
+	if l.router == nil {
+		return nil, errRouteSenderUnavailable
+	}
+
+	rpcCtx, err := l.lnd.WithMacaroonAuthForService(
+		ctx, lndclient.RouterServiceMac,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.router.BuildRoute(rpcCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Route, nil
+}
+
+// SendToRoute attempts to pay the passed hash along the given route and blocks
+// until the htlc attempt is resolved.
+//
+// NOTE: This is part of the routeSender interface.
+func (l *lndRouteSender) SendToRoute(ctx context.Context, hash lntypes.Hash,
+	route *lnrpc.Route) (*lnrpc.HTLCAttempt, error) {
+
+	if l.router == nil {
+		return nil, errRouteSenderUnavailable
+	}
+
+	rpcCtx, err := l.lnd.WithMacaroonAuthForService(
+		ctx, lndclient.RouterServiceMac,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.router.SendToRouteV2(rpcCtx, &routerrpc.SendToRouteRequest{
+		PaymentHash: hash[:],
+		Route:       route,
+	})
+}
@@ -31,10 +31,9 @@ var (
 		"retry more")
 )
 
-var (
-	routingPluginMx       sync.Mutex
-	routingPluginInstance RoutingPlugin
-)
+// routingPluginSessions is the shared coordinator for all routing plugin
+// sessions created through AcquireRoutingPlugin.
+var routingPluginSessions = newMissionControlCoordinator()
 
 // RoutingPlugin is a generic interface for off-chain payment helpers.
 type RoutingPlugin interface {
@@ -51,51 +50,234 @@ type RoutingPlugin interface {
 	BeforePayment(ctx context.Context, attempt int, maxAttempts int) error
 }
 
+// missionControlTarget holds the mission control state shared by all routing
+// plugin sessions that manipulate node pairs towards the same target node.
+// The session count and the busy channel are guarded by the coordinator, all
+// other fields by the target's own mutex.
+type missionControlTarget struct {
+	sync.Mutex
+
+	// sessions is the number of sessions currently holding this target.
+	sessions int
+
+	// busy is set while the MC state of the target is being saved or
+	// restored and is closed once that is done. Joining sessions wait for
+	// it so that they don't observe a partially saved or restored state.
+	busy chan struct{}
+
+	// importer is the session that imports MC changes for the target.
+	// Only one session imports at a time so that concurrent sessions don't
+	// overwrite each other's changes. As lnd's MC is global, the payments
+	// of all other sessions for the target are routed with the MC state
+	// imported by this session, while their own BeforePayment calls don't
+	// change MC. Another session takes over once the importer is released.
+	importer *lowToHighRoutingPlugin
+
+	// mcState holds the original MC state for the tracked node pairs as it
+	// was before any of the sessions changed it.
+	mcState map[route.Vertex]lndclient.MissionControlEntry
+
+	// capacities holds the total capacity towards the target for each
+	// node that any of the sessions may manipulate.
+	capacities map[route.Vertex]btcutil.Amount
+
+	// mcChanged flags that the MC settings for the tracked nodes were
+	// changed and should be reset to their original state once the last
+	// session is done.
+	mcChanged bool
+}
+
+// missionControlCoordinator coordinates mission control modifications of
+// concurrent routing plugin sessions. Sessions are reference counted per
+// target node whose inbound pairs they manipulate, so that the original MC
+// state is saved only once per target and restored only after the last
+// session for that target is done. The MC RPCs are never made while holding
+// the coordinator's mutex.
+type missionControlCoordinator struct {
+	sync.Mutex
+
+	targets map[route.Vertex]*missionControlTarget
+}
+
+// newMissionControlCoordinator creates a new mission control coordinator.
+func newMissionControlCoordinator() *missionControlCoordinator {
+	return &missionControlCoordinator{
+		targets: make(map[route.Vertex]*missionControlTarget),
+	}
+}
+
+// join registers a new session for the passed target node. The save function
+// is called with the subset of the passed nodes whose pairs towards the target
+// are not yet tracked by any other session, as only those still hold their
+// original MC state. It returns the original MC state of those pairs.
+func (m *missionControlCoordinator) join(target route.Vertex,
+	nodes map[route.Vertex]btcutil.Amount,
+	save func(map[route.Vertex]struct{}) (
+		map[route.Vertex]lndclient.MissionControlEntry, error)) (
+	*missionControlTarget, error) {
+
+	m.Lock()
+
+	// Wait until no other session is saving or restoring the MC state of
+	// the target. A restored target is removed, so we need to look it up
+	// again after waiting.
+	mcTarget := m.targets[target]
+	for mcTarget != nil && mcTarget.busy != nil {
+		busy := mcTarget.busy
+
+		m.Unlock()
+		<-busy
+		m.Lock()
+
+		mcTarget = m.targets[target]
+	}
+
+	if mcTarget == nil {
+		mcTarget = &missionControlTarget{
+			mcState: make(
+				map[route.Vertex]lndclient.MissionControlEntry,
+			),
+			capacities: make(map[route.Vertex]btcutil.Amount),
+		}
+		m.targets[target] = mcTarget
+	}
+	mcTarget.sessions++
+
+	mcTarget.Lock()
+	untracked := make(map[route.Vertex]struct{})
+	for node := range nodes {
+		if _, ok := mcTarget.capacities[node]; !ok {
+			untracked[node] = struct{}{}
+		}
+	}
+	mcTarget.Unlock()
+
+	var (
+		mcState = make(map[route.Vertex]lndclient.MissionControlEntry)
+		err     error
+	)
+	if len(untracked) > 0 {
+		busy := make(chan struct{})
+		mcTarget.busy = busy
+		m.Unlock()
+
+		mcState, err = save(untracked)
+
+		m.Lock()
+		mcTarget.busy = nil
+		close(busy)
+	}
+	defer m.Unlock()
+
+	if err != nil {
+		mcTarget.sessions--
+		if mcTarget.sessions == 0 {
+			delete(m.targets, target)
+		}
+
+		return nil, err
+	}
+
+	mcTarget.Lock()
+	defer mcTarget.Unlock()
+
+	for node, entry := range mcState {
+		mcTarget.mcState[node] = entry
+	}
+
+	for node, capacity := range nodes {
+		if capacity >= mcTarget.capacities[node] {
+			mcTarget.capacities[node] = capacity
+		}
+	}
+
+	return mcTarget, nil
+}
+
+// leave unregisters a session from the passed target node. If this was the
+// last session for the target, the restore function is called with the
+// target's shared state so that the original MC state can be restored.
+func (m *missionControlCoordinator) leave(target route.Vertex,
+	mcTarget *missionControlTarget, session *lowToHighRoutingPlugin,
+	restore func(*missionControlTarget) error) error {
+
+	m.Lock()
+
+	mcTarget.Lock()
+	if mcTarget.importer == session {
+		mcTarget.importer = nil
+	}
+	mcTarget.Unlock()
+
+	mcTarget.sessions--
+	if mcTarget.sessions > 0 {
+		log.Debugf("Routing plugin session for %v released, %v "+
+			"session(s) remaining", target, mcTarget.sessions)
+
+		m.Unlock()
+		return nil
+	}
+
+	// Keep the target registered until the MC state is restored so that
+	// new sessions don't save the state we're about to restore.
+	busy := make(chan struct{})
+	mcTarget.busy = busy
+	m.Unlock()
+
+	err := restore(mcTarget)
+
+	m.Lock()
+	delete(m.targets, target)
+	mcTarget.busy = nil
+	close(busy)
+	m.Unlock()
+
+	return err
+}
+
 // makeRoutingPlugin is a helper to instantiate routing plugins.
 func makeRoutingPlugin(pluginType RoutingPluginType,
-	lnd lndclient.LndServices, clock clock.Clock) RoutingPlugin {
+	lnd lndclient.LndServices, clock clock.Clock,
+	coordinator *missionControlCoordinator) RoutingPlugin {
 
 	if pluginType == RoutingPluginLowHigh {
 		return &lowToHighRoutingPlugin{
-			lnd:   lnd,
-			clock: clock,
+			lnd:         lnd,
+			clock:       clock,
+			coordinator: coordinator,
 		}
 	}
 
 	return nil
 }
 
-// AcquireRoutingPlugin will return a RoutingPlugin instance (or nil). As the
-// LND instance used is a shared resource, currently only one requestor will be
-// able to acquire a RoutingPlugin instance. If someone is already holding the
-// instance a nil is returned.
+// AcquireRoutingPlugin will return a new RoutingPlugin session (or nil).
+// Multiple sessions may be held at the same time as mission control changes
+// are coordinated per target node: the original state is saved by the first
+// session manipulating a target and restored once the last session for that
+// target is released. Only one session at a time imports MC changes for a
+// target. Concurrent sessions for the same target share the MC state of that
+// session and don't import changes of their own until it is released.
 func AcquireRoutingPlugin(ctx context.Context, pluginType RoutingPluginType,
 	lnd lndclient.LndServices, target route.Vertex,
 	routeHints [][]zpay32.HopHint, amt btcutil.Amount) (
 	RoutingPlugin, error) {
 
-	routingPluginMx.Lock()
-	defer routingPluginMx.Unlock()
-
-	// Another swap is already using the routing plugin.
-	if routingPluginInstance != nil {
-		return nil, nil
-	}
-
-	routingPluginInstance = makeRoutingPlugin(
+	plugin := makeRoutingPlugin(
 		pluginType, lnd, clock.NewDefaultClock(),
+		routingPluginSessions,
 	)
-	if routingPluginInstance == nil {
+	if plugin == nil {
 		return nil, nil
 	}
 
 	// Initialize the plugin with the passed parameters.
-	err := routingPluginInstance.Init(ctx, target, routeHints, amt)
+	err := plugin.Init(ctx, target, routeHints, amt)
 	if err != nil {
 		if err == ErrRoutingPluginNotApplicable {
 			// Since the routing plugin is not applicable for this
 			// payment, we can immediately destruct it.
-			if err := routingPluginInstance.Done(ctx); err != nil {
+			if err := plugin.Done(ctx); err != nil {
 				log.Errorf("Error while releasing routing "+
 					"plugin: %v", err)
 			}
@@ -106,29 +288,23 @@ func AcquireRoutingPlugin(ctx context.Context, pluginType RoutingPluginType,
 			err = nil
 		}
 
-		routingPluginInstance = nil
 		return nil, err
 	}
 
-	return routingPluginInstance, nil
+	return plugin, nil
 }
 
-// ReleaseRoutingPlugin will release the RoutingPlugin, allowing other
-// requestors to acquire the instance.
-func ReleaseRoutingPlugin(ctx context.Context) {
-	routingPluginMx.Lock()
-	defer routingPluginMx.Unlock()
-
-	if routingPluginInstance == nil {
+// ReleaseRoutingPlugin will release the passed RoutingPlugin session. It is
+// safe to call it with a nil plugin or with an already released one.
+func ReleaseRoutingPlugin(ctx context.Context, plugin RoutingPlugin) {
+	if plugin == nil {
 		return
 	}
 
-	if err := routingPluginInstance.Done(ctx); err != nil {
+	if err := plugin.Done(ctx); err != nil {
 		log.Errorf("Error while releasing routing plugin: %v",
 			err)
 	}
-
-	routingPluginInstance = nil
 }
 
 // lowToHighRoutingPlugin is a RoutingPlugin that implements "low to high"
@@ -137,20 +313,22 @@ func ReleaseRoutingPlugin(ctx context.Context) {
 // given routing timeouts. The lowToHighRoutingPlugin itself is responsible for
 // manipulating LND's Mission Control to make such routing attempts possible.
 type lowToHighRoutingPlugin struct {
-	lnd     lndclient.LndServices
-	clock   clock.Clock
-	target  route.Vertex
-	amount  btcutil.Amount
-	mcState map[route.Vertex]lndclient.MissionControlEntry
+	lnd    lndclient.LndServices
+	clock  clock.Clock
+	target route.Vertex
+	amount btcutil.Amount
+
+	// coordinator coordinates MC changes with other concurrent sessions.
+	coordinator *missionControlCoordinator
+
+	// mcTarget is the MC state shared with all other sessions that
+	// manipulate pairs towards the same target. It is nil if the plugin
+	// isn't initialized or was already released.
+	mcTarget *missionControlTarget
 
 	// nodesByMaxFee holds nodes sorted by maximum fees that would be paid
 	// to the target node for the target amount.
 	nodesByMaxFee []nodeFeeInfo
-
-	// mcChanged flags that the MC settings for the tracked nodes were
-	// changed and should be reset to their original state once the plugin
-	// is done.
-	mcChanged bool
 }
 
 type nodeFeeInfo struct {
@@ -273,17 +451,18 @@ func getNodeInfo(ctx context.Context, lnd lndclient.LightningClient,
 	return nodeInfo, nil
 }
 
-// saveMissionControlState will save the MC state for the node pairs formed by
-// the passed nodes and target.
+// saveMissionControlState will return the current MC state for the node pairs
+// formed by the passed nodes and target.
 func (r *lowToHighRoutingPlugin) saveMissionControlState(ctx context.Context,
-	nodes map[route.Vertex]*lndclient.NodeInfo, target route.Vertex) error {
+	nodes map[route.Vertex]struct{}, target route.Vertex) (
+	map[route.Vertex]lndclient.MissionControlEntry, error) {
 
 	entries, err := r.lnd.Router.QueryMissionControl(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	r.mcState = make(map[route.Vertex]lndclient.MissionControlEntry)
+	mcState := make(map[route.Vertex]lndclient.MissionControlEntry)
 	for _, entry := range entries {
 		// Skip pairs which we do not intend to change.
 		if _, ok := nodes[entry.NodeFrom]; !ok {
@@ -294,11 +473,11 @@ func (r *lowToHighRoutingPlugin) saveMissionControlState(ctx context.Context,
 			continue
 		}
 
-		r.mcState[entry.NodeFrom] = entry
+		mcState[entry.NodeFrom] = entry
 	}
 
-	log.Debugf("Saved MC state: %v", spew.Sdump(r.mcState))
-	return nil
+	log.Debugf("Saved MC state: %v", spew.Sdump(mcState))
+	return mcState, nil
 }
 
 // nodesByMaxFee is a helper function to order the passed nodes by overall max
@@ -475,13 +654,22 @@ func (r *lowToHighRoutingPlugin) Init(ctx context.Context, target route.Vertex,
 	r.target = target
 	r.amount = amt
 
-	// Save MC state.
-	err = r.saveMissionControlState(ctx, nodes, target)
-	if err != nil {
-		return err
+	capacities := make(map[route.Vertex]btcutil.Amount)
+	for _, nodeFee := range r.nodesByMaxFee {
+		capacities[nodeFee.node] = nodeFee.capacity
 	}
 
-	return nil
+	// Join the sessions for this target, saving the MC state of the pairs
+	// that no other session has touched yet.
+	r.mcTarget, err = r.coordinator.join(
+		target, capacities, func(untracked map[route.Vertex]struct{}) (
+			map[route.Vertex]lndclient.MissionControlEntry, error) {
+
+			return r.saveMissionControlState(ctx, untracked, target)
+		},
+	)
+
+	return err
 }
 
 // BeforePayment will reconfigure the mission control on each payment attempt.
@@ -562,14 +750,33 @@ func (r *lowToHighRoutingPlugin) BeforePayment(ctx context.Context,
 		return ErrRoutingPluginNoMoreRetries
 	}
 
+	// Only a single session imports MC changes for the target at a time,
+	// as concurrent sessions would otherwise overwrite each other's
+	// changes. Other sessions use the MC state set up by the importer.
+	r.mcTarget.Lock()
+	if r.mcTarget.importer == nil {
+		r.mcTarget.importer = r
+	}
+	importer := r.mcTarget.importer == r
+	if importer {
+		// Flag that we are changing the MC state.
+		r.mcTarget.mcChanged = true
+	}
+	r.mcTarget.Unlock()
+
+	if !importer {
+		log.Debugf("MC state towards %v is managed by another "+
+			"session, using its MC state for attempt %v",
+			r.target, currAttempt)
+
+		return nil
+	}
+
 	err := r.lnd.Router.ImportMissionControl(ctx, entries, true)
 	if err != nil {
 		return err
 	}
 
-	// Flag that we have changed the MC state.
-	r.mcChanged = true
-
 	log.Tracef("Imported MC state: %v", spew.Sdump(entries))
 
 	// If logging in trace level, query routes and log to see how our
@@ -583,21 +790,33 @@ func (r *lowToHighRoutingPlugin) BeforePayment(ctx context.Context,
 	return nil
 }
 
-// Done will attempt to reconstruct the MC state for the affected node pairs to
-// the same state as it was before using the routing plugin. For those node
-// pairs where the beginning state was empty, we set success for the maximum
-// capacity for the sake of simplicity.
+// Done releases the session. If this was the last session manipulating pairs
+// towards the target, it will attempt to reconstruct the MC state for the
+// affected node pairs to the same state as it was before using the routing
+// plugin. For those node pairs where the beginning state was empty, we set
+// success for the maximum capacity for the sake of simplicity.
 func (r *lowToHighRoutingPlugin) Done(ctx context.Context) error {
-	if r.mcState == nil {
+	if r.mcTarget == nil {
 		return nil
 	}
 
-	defer func() {
-		r.mcState = nil
-	}()
+	mcTarget := r.mcTarget
+	r.mcTarget = nil
+
+	restore := func(mcTarget *missionControlTarget) error {
+		return r.restoreMissionControlState(ctx, mcTarget)
+	}
+
+	return r.coordinator.leave(r.target, mcTarget, r, restore)
+}
+
+// restoreMissionControlState restores the saved MC state for all node pairs
+// towards the target that were tracked by any of the sessions.
+func (r *lowToHighRoutingPlugin) restoreMissionControlState(
+	ctx context.Context, mcTarget *missionControlTarget) error {
 
 	// If none of the selected pairs were manipulated we can skip ahead.
-	if !r.mcChanged {
+	if !mcTarget.mcChanged {
 		log.Debugf("MC state not changed, skipping restore")
 		return nil
 	}
@@ -607,19 +826,17 @@ func (r *lowToHighRoutingPlugin) Done(ctx context.Context) error {
 	// override current MC state.
 	now := r.clock.Now()
 	entries := make(
-		[]lndclient.MissionControlEntry, 0, len(r.nodesByMaxFee),
+		[]lndclient.MissionControlEntry, 0, len(mcTarget.capacities),
 	)
-	for _, nodeInfo := range r.nodesByMaxFee {
+	for node, nodeCapacity := range mcTarget.capacities {
 		// We didn't have MC state for this node pair before, so just
 		// set it to succeed the max amount and fail anything more than
 		// that. This way we don't restrict forwarding for normal cases.
-		if _, ok := r.mcState[nodeInfo.node]; !ok {
-			capacity := lnwire.MilliSatoshi(
-				nodeInfo.capacity * 1000,
-			)
+		if _, ok := mcTarget.mcState[node]; !ok {
+			capacity := lnwire.MilliSatoshi(nodeCapacity * 1000)
 			entries = append(
 				entries, lndclient.MissionControlEntry{
-					NodeFrom:    nodeInfo.node,
+					NodeFrom:    node,
 					NodeTo:      r.target,
 					FailTime:    now,
 					FailAmt:     capacity + 1,
@@ -629,7 +846,7 @@ func (r *lowToHighRoutingPlugin) Done(ctx context.Context) error {
 		} else {
 			// We did have a MC entry for this pair, so we just bump
 			// the time to now + 1 sec.
-			entry := r.mcState[nodeInfo.node]
+			entry := mcTarget.mcState[node]
 
 			if !entry.FailTime.IsZero() {
 				entry.FailTime = now
@@ -554,6 +554,7 @@ func TestLowHighRoutingPlugin(t *testing.T) {
 			testClock := clock.NewTestClock(testTime)
 			plugin := makeRoutingPlugin(
 				RoutingPluginLowHigh, lnd, testClock,
+				newMissionControlCoordinator(),
 			)
 			require.NotNil(t, plugin)
 
@@ -651,24 +652,8 @@ func TestRoutingPluginAcquireRelease(t *testing.T) {
 	require.Nil(t, plugin)
 	require.NoError(t, err)
 
-	// Attempting to acquire RoutingPluginNone again still returns nil.
-	plugin, err = AcquireRoutingPlugin(
-		ctx, RoutingPluginNone, lnd, target, nil, amt,
-	)
-	require.Nil(t, plugin)
-	require.NoError(t, err)
-
-	// Call ReleaseRoutingPlugin twice to ensure we can call it even when no
-	// plugin is acquired.
-	ReleaseRoutingPlugin(ctx)
-	ReleaseRoutingPlugin(ctx)
-
-	// RoutingPluginNone returns nil.
-	plugin2, err := AcquireRoutingPlugin(
-		ctx, RoutingPluginNone, lnd, target, nil, amt,
-	)
-	require.Nil(t, plugin2)
-	require.NoError(t, err)
+	// Releasing a nil plugin is a no-op.
+	ReleaseRoutingPlugin(ctx, plugin)
 
 	// Acquire is successful.
 	plugin, err = AcquireRoutingPlugin(
@@ -677,20 +662,158 @@ func TestRoutingPluginAcquireRelease(t *testing.T) {
 	require.NotNil(t, plugin)
 	require.NoError(t, err)
 
-	// Plugin already acquired, above.
-	plugin2, err = AcquireRoutingPlugin(
+	// A concurrent session can be acquired while the first one is held.
+	plugin2, err := AcquireRoutingPlugin(
 		ctx, RoutingPluginLowHigh, lnd, target, nil, amt,
 	)
-	require.Nil(t, plugin2)
+	require.NotNil(t, plugin2)
 	require.NoError(t, err)
 
-	// Release acruired plugin.
-	ReleaseRoutingPlugin(ctx)
+	// Release both sessions. Releasing twice is safe.
+	ReleaseRoutingPlugin(ctx, plugin)
+	ReleaseRoutingPlugin(ctx, plugin)
+	ReleaseRoutingPlugin(ctx, plugin2)
 
-	// Acquire is successful.
-	plugin2, err = AcquireRoutingPlugin(
-		ctx, RoutingPluginLowHigh, lnd, target, nil, amt,
+	// All sessions are released, so no target should be tracked.
+	require.Empty(t, routingPluginSessions.targets)
+}
+
+// TestRoutingPluginOverlappingSessions tests that overlapping routing plugin
+// sessions towards the same target save the original MC state only once, share
+// the MC state imported by a single session instead of overwriting each other's
+// MC changes and restore the original MC state after the last session is done.
+func TestRoutingPluginOverlappingSessions(t *testing.T) {
+	mockLnd := test.NewMockLnd()
+	testTime := time.Now().UTC()
+
+	//       _____Bob_____
+	//      /             \
+	// Alice               Dave---Loop
+	//      \___       ___/
+	//          Charlie
+	//
+	channels := []testChan{
+		{alice, bob, 1, 1000, 1000, 1, 1000, 1},
+		{alice, charlie, 2, 1000, 1000, 1, 1000, 1},
+		{bob, dave, 3, 1000, 1000, 1, 1000, 1},
+		{charlie, dave, 4, 1000, 1000, 100, 1000, 1},
+		{dave, loopNode, 5, 1000, 1000, 1, 1000, 1},
+	}
+
+	mockLnd.Channels, mockLnd.ChannelEdges = makeTestNetwork(channels)
+	lnd := lndclient.LndServices{
+		Client: mockLnd.Client,
+		Router: mockLnd.Router,
+	}
+
+	originalState := []lndclient.MissionControlEntry{
+		{
+			NodeFrom:    bob,
+			NodeTo:      dave,
+			SuccessTime: testTime,
+			SuccessAmt:  10000,
+		},
+	}
+	mockLnd.MissionControlState = append(
+		[]lndclient.MissionControlEntry{}, originalState...,
 	)
-	require.NotNil(t, plugin2)
-	require.NoError(t, err)
+
+	ctx := context.TODO()
+	amt := btcutil.Amount(50)
+	testClock := clock.NewTestClock(testTime)
+	coordinator := newMissionControlCoordinator()
+
+	plugin1 := makeRoutingPlugin(
+		RoutingPluginLowHigh, lnd, testClock, coordinator,
+	)
+	require.NoError(t, plugin1.Init(ctx, loopNode, nil, amt))
+
+	// The first session discourages Bob which changes the MC state.
+	require.NoError(t, plugin1.BeforePayment(ctx, 2, 2))
+	modifiedState := []lndclient.MissionControlEntry{
+		{
+			NodeFrom: bob,
+			NodeTo:   dave,
+			FailTime: testTime,
+			FailAmt:  1,
+		},
+		{
+			NodeFrom:    charlie,
+			NodeTo:      dave,
+			SuccessTime: testTime,
+			SuccessAmt:  1000000,
+		},
+	}
+	require.ElementsMatch(t, modifiedState, mockLnd.MissionControlState)
+
+	// The second session joins the same target while the MC state is
+	// modified. It must not save the modified state as the original one.
+	plugin2 := makeRoutingPlugin(
+		RoutingPluginLowHigh, lnd, testClock, coordinator,
+	)
+	require.NoError(t, plugin2.Init(ctx, loopNode, nil, amt))
+	require.Len(t, coordinator.targets, 1)
+	require.Equal(t, 2, coordinator.targets[dave].sessions)
+
+	// The second session doesn't overwrite the MC state imported by the
+	// first session.
+	laterTime := testTime.Add(time.Minute)
+	testClock.SetTime(laterTime)
+	require.NoError(t, plugin2.BeforePayment(ctx, 2, 2))
+	require.ElementsMatch(t, modifiedState, mockLnd.MissionControlState)
+
+	// The second session still stops retrying once it would discourage all
+	// inbound peers of the target, even though it doesn't import MC
+	// changes while the first session does.
+	require.ErrorIs(
+		t, plugin2.BeforePayment(ctx, 3, 2),
+		ErrRoutingPluginNoMoreRetries,
+	)
+	require.ElementsMatch(t, modifiedState, mockLnd.MissionControlState)
+
+	// Releasing the first session keeps the MC state as the second session
+	// still depends on it.
+	require.NoError(t, plugin1.Done(ctx))
+	require.ElementsMatch(t, modifiedState, mockLnd.MissionControlState)
+
+	// The second session takes over the MC imports for the target.
+	require.NoError(t, plugin2.BeforePayment(ctx, 2, 2))
+	require.ElementsMatch(
+		t, []lndclient.MissionControlEntry{
+			{
+				NodeFrom: bob,
+				NodeTo:   dave,
+				FailTime: laterTime,
+				FailAmt:  1,
+			},
+			{
+				NodeFrom:    charlie,
+				NodeTo:      dave,
+				SuccessTime: laterTime,
+				SuccessAmt:  1000000,
+			},
+		}, mockLnd.MissionControlState,
+	)
+
+	// Releasing the last session restores the original MC state.
+	require.NoError(t, plugin2.Done(ctx))
+	require.ElementsMatch(
+		t, []lndclient.MissionControlEntry{
+			{
+				NodeFrom:    bob,
+				NodeTo:      dave,
+				SuccessTime: laterTime,
+				SuccessAmt:  10000,
+			},
+			{
+				NodeFrom:    charlie,
+				NodeTo:      dave,
+				FailTime:    laterTime,
+				FailAmt:     1000001,
+				SuccessTime: laterTime,
+				SuccessAmt:  1000000,
+			},
+		}, mockLnd.MissionControlState,
+	)
+	require.Empty(t, coordinator.targets)
 }
@@ -1,4 +1,6 @@
 #!/usr/bin/env bash
 go run ./fsm/stateparser/stateparser.go --out ./fsm/example_fsm.md --fsm example
 go run ./fsm/stateparser/stateparser.go --out ./reservation/reservation_fsm.md --fsm reservation
-go run ./fsm/stateparser/stateparser.go --out ./instantout/fsm.md --fsm instantout
\ No newline at end of file
+go run ./fsm/stateparser/stateparser.go --out ./instantout/fsm.md --fsm instantout
+go run ./fsm/stateparser/stateparser.go --out ./loopout_fsm.md --fsm loopout
+go run ./fsm/stateparser/stateparser.go --out ./loopin_fsm.md --fsm loopin
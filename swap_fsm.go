@@ -0,0 +1,29 @@
+package loop
+
+import "github.com/lightninglabs/loop/fsm"
+
+const (
+	// defaultObserverSize is the number of notifications cached by the
+	// default observer of a swap state machine.
+	defaultObserverSize = 15
+)
+
+// Events that are shared by the loop out and loop in state machines.
+const (
+	// onStart is sent to start the execution of a swap.
+	onStart = fsm.EventType("OnStart")
+
+	// onRecover is sent to resume a swap in the state that matches its
+	// stored swap state.
+	onRecover = fsm.EventType("OnRecover")
+
+	// onTimeout is sent if it became too late to continue the swap.
+	onTimeout = fsm.EventType("OnTimeout")
+
+	// onHtlcSwept is sent once the htlc is swept with the preimage.
+	onHtlcSwept = fsm.EventType("OnHtlcSwept")
+
+	// onHtlcTimeoutSwept is sent once the htlc is swept with the timeout
+	// path.
+	onHtlcTimeoutSwept = fsm.EventType("OnHtlcTimeoutSwept")
+)
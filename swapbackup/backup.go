@@ -0,0 +1,157 @@
+package swapbackup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnencrypt"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// DefaultBackupFilename is the default file name of the swap backup.
+const DefaultBackupFilename = "swaps.backup"
+
+// Store is the database that the swaps are backed up from and restored to.
+type Store interface {
+	// ExportPendingSwaps creates an archive that only contains the loop
+	// outs and loop ins that are still pending.
+	ExportPendingSwaps(ctx context.Context) (*loopdb.Archive, error)
+
+	// ImportSwaps adds the loop outs and loop ins of the archive that are
+	// not stored in the database yet and returns their hashes.
+	ImportSwaps(ctx context.Context, archive *loopdb.Archive) (
+		[]lntypes.Hash, []lntypes.Hash, error)
+}
+
+// Backup keeps an encrypted backup file of the pending swaps. The backup
+// contains the preimages and key locators that are required to complete the
+// swaps. It is encrypted with a key that is derived from the lnd seed, the
+// same way lnd encrypts its static channel backup.
+type Backup struct {
+	filePath  string
+	store     Store
+	encrypter lnencrypt.EncrypterDecrypter
+
+	mu sync.Mutex
+}
+
+// NewBackup creates a backup that is written to the given file. The
+// encryption key is derived from the wallet of lnd.
+func NewBackup(ctx context.Context, filePath string, store Store,
+	walletKit lndclient.WalletKitClient) (*Backup, error) {
+
+	encrypter, err := lnencrypt.KeyRingEncrypter(&keyRing{
+		ctx:       ctx,
+		walletKit: walletKit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive backup key: %w", err)
+	}
+
+	return &Backup{
+		filePath:  filePath,
+		store:     store,
+		encrypter: encrypter,
+	}, nil
+}
+
+// Update writes the pending swaps of the store to the backup file. The file
+// is replaced atomically, so that a crash never leaves a partial backup.
+func (b *Backup) Update(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	archive, err := b.store.ExportPendingSwaps(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := loopdb.EncodeArchive(archive)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	err = b.encrypter.EncryptPayloadToWriter(data, &buf)
+	if err != nil {
+		return err
+	}
+
+	err = writeFileAtomic(b.filePath, buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Backed up %d loop outs and %d loop ins to %v",
+		len(archive.LoopOuts), len(archive.LoopIns), b.filePath)
+
+	return nil
+}
+
+// Restore decrypts the given backup and adds the swaps that aren't stored
+// yet. It returns the hashes of the restored loop outs and loop ins.
+func (b *Backup) Restore(ctx context.Context, backup []byte) ([]lntypes.Hash,
+	[]lntypes.Hash, error) {
+
+	data, err := b.encrypter.DecryptPayloadFromReader(
+		bytes.NewReader(backup),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decrypt backup: %w", err)
+	}
+
+	archive, err := loopdb.DecodeArchive(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loopOuts, loopIns, err := b.store.ImportSwaps(ctx, archive)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Infof("Restored %d loop outs and %d loop ins from backup",
+		len(loopOuts), len(loopIns))
+
+	// The restored swaps are pending, so they are part of our own backup
+	// from now on.
+	if err := b.Update(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return loopOuts, loopIns, nil
+}
+
+// writeFileAtomic writes the data to a temporary file and renames it to the
+// given path afterwards.
+func writeFileAtomic(filePath string, data []byte) error {
+	tempPath := filePath + ".tmp"
+
+	file, err := os.OpenFile(
+		tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, filePath)
+}
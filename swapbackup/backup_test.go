@@ -0,0 +1,154 @@
+package swapbackup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBackupRestore tests that a swap is backed up when it is created and that
+// it can be restored into another database from the backup file.
+func TestBackupRestore(t *testing.T) {
+	ctxb := context.Background()
+	lnd := test.NewMockLnd()
+	filePath := filepath.Join(t.TempDir(), DefaultBackupFilename)
+
+	db := loopdb.NewTestDB(t)
+	backup, err := NewBackup(ctxb, filePath, db, lnd.WalletKit)
+	require.NoError(t, err)
+
+	store := NewSwapStore(db, backup)
+
+	_, senderKey := test.CreateKey(1)
+	_, receiverKey := test.CreateKey(2)
+
+	contract := &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			AmountRequested: 100,
+			Preimage:        lntypes.Preimage{1},
+			CltvExpiry:      144,
+			InitiationTime:  time.Unix(1, 0).UTC(),
+		},
+		HtlcConfTarget: 2,
+	}
+	copy(contract.HtlcKeys.SenderScriptKey[:],
+		senderKey.SerializeCompressed())
+	copy(contract.HtlcKeys.ReceiverScriptKey[:],
+		receiverKey.SerializeCompressed())
+
+	hash := lntypes.Hash{1}
+	require.NoError(t, store.CreateLoopIn(ctxb, hash, contract))
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	// The backup is encrypted.
+	require.NotContains(t, string(data), "loop_ins")
+
+	// Restore the swap into an empty database.
+	target := loopdb.NewTestDB(t)
+	targetBackup, err := NewBackup(
+		ctxb, filepath.Join(t.TempDir(), DefaultBackupFilename),
+		target, lnd.WalletKit,
+	)
+	require.NoError(t, err)
+
+	loopOuts, loopIns, err := targetBackup.Restore(ctxb, data)
+	require.NoError(t, err)
+	require.Empty(t, loopOuts)
+	require.Equal(t, []lntypes.Hash{hash}, loopIns)
+
+	loopIn, err := target.FetchLoopInSwap(ctxb, hash)
+	require.NoError(t, err)
+	require.Equal(t, contract, loopIn.Contract)
+
+	// A finished swap is removed from the backup.
+	require.NoError(t, store.UpdateLoopIn(
+		ctxb, hash, time.Unix(2, 0).UTC(), loopdb.SwapStateData{
+			State: loopdb.StateSuccess,
+		},
+	))
+
+	data, err = os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	loopOuts, loopIns, err = backup.Restore(ctxb, data)
+	require.NoError(t, err)
+	require.Empty(t, loopOuts)
+	require.Empty(t, loopIns)
+
+	// A corrupted backup is rejected.
+	data[len(data)-1] ^= 1
+	_, _, err = backup.Restore(ctxb, data)
+	require.Error(t, err)
+}
+
+// TestSwapStoreStateChanges tests that the backup is only rewritten if the
+// state of a swap changes.
+func TestSwapStoreStateChanges(t *testing.T) {
+	ctxb := context.Background()
+	lnd := test.NewMockLnd()
+	filePath := filepath.Join(t.TempDir(), DefaultBackupFilename)
+
+	db := loopdb.NewTestDB(t)
+	backup, err := NewBackup(ctxb, filePath, db, lnd.WalletKit)
+	require.NoError(t, err)
+
+	store := NewSwapStore(db, backup)
+
+	_, senderKey := test.CreateKey(1)
+	_, receiverKey := test.CreateKey(2)
+
+	contract := &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			AmountRequested: 100,
+			Preimage:        lntypes.Preimage{1},
+			CltvExpiry:      144,
+			InitiationTime:  time.Unix(1, 0).UTC(),
+		},
+		HtlcConfTarget: 2,
+	}
+	copy(contract.HtlcKeys.SenderScriptKey[:],
+		senderKey.SerializeCompressed())
+	copy(contract.HtlcKeys.ReceiverScriptKey[:],
+		receiverKey.SerializeCompressed())
+
+	hash := lntypes.Hash{1}
+	require.NoError(t, store.CreateLoopIn(ctxb, hash, contract))
+	require.FileExists(t, filePath)
+
+	// updateState stores the given state and returns whether the backup
+	// was written.
+	updateState := func(state loopdb.SwapStateData) bool {
+		require.NoError(t, os.RemoveAll(filePath))
+		require.NoError(t, store.UpdateLoopIn(
+			ctxb, hash, time.Unix(2, 0).UTC(), state,
+		))
+
+		_, err := os.Stat(filePath)
+		return err == nil
+	}
+
+	require.True(t, updateState(loopdb.SwapStateData{
+		State: loopdb.StateHtlcPublished,
+	}))
+
+	// Storing the same state again with the htlc tx hash doesn't rewrite
+	// the backup.
+	require.False(t, updateState(loopdb.SwapStateData{
+		State:      loopdb.StateHtlcPublished,
+		HtlcTxHash: &chainhash.Hash{1},
+	}))
+
+	require.True(t, updateState(loopdb.SwapStateData{
+		State: loopdb.StateSuccess,
+	}))
+}
@@ -0,0 +1,43 @@
+package swapbackup
+
+import (
+	"context"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// keyRing is a keychain.KeyRing that derives the keys with the wallet kit of
+// lnd. Only the public keys are available.
+type keyRing struct {
+	ctx       context.Context
+	walletKit lndclient.WalletKitClient
+}
+
+// A compile time assertion to ensure that keyRing meets the keychain.KeyRing
+// interface.
+var _ keychain.KeyRing = (*keyRing)(nil)
+
+// DeriveNextKey derives the next key of the given key family.
+func (k *keyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	desc, err := k.walletKit.DeriveNextKey(k.ctx, int32(keyFam))
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return *desc, nil
+}
+
+// DeriveKey derives the key of the given key locator.
+func (k *keyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	desc, err := k.walletKit.DeriveKey(k.ctx, &keyLoc)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return *desc, nil
+}
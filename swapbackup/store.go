@@ -0,0 +1,175 @@
+package swapbackup
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// SwapStore is a loopdb.SwapStore that updates the backup whenever a swap is
+// created or its state changes. Updates that store the same state again, for
+// example to add the htlc tx hash or the swap cost, don't rewrite the backup.
+//
+// NOTE: A failed backup update is only logged. The swap is stored in the
+// database at that point already and will be backed up with the next update.
+type SwapStore struct {
+	loopdb.SwapStore
+
+	backup *Backup
+
+	// states holds the last stored state of the pending swaps that were
+	// updated since startup.
+	states map[lntypes.Hash]loopdb.SwapState
+
+	mu sync.Mutex
+}
+
+// A compile time assertion to ensure that SwapStore meets the
+// loopdb.SwapStore interface.
+var _ loopdb.SwapStore = (*SwapStore)(nil)
+
+// NewSwapStore returns a swap store that keeps the given backup up to date.
+func NewSwapStore(store loopdb.SwapStore, backup *Backup) *SwapStore {
+	return &SwapStore{
+		SwapStore: store,
+		backup:    backup,
+		states:    make(map[lntypes.Hash]loopdb.SwapState),
+	}
+}
+
+// CreateLoopOut adds an initiated swap to the store and backs it up.
+func (s *SwapStore) CreateLoopOut(ctx context.Context, hash lntypes.Hash,
+	swap *loopdb.LoopOutContract) error {
+
+	err := s.SwapStore.CreateLoopOut(ctx, hash, swap)
+	if err != nil {
+		return err
+	}
+
+	s.updateBackup(ctx)
+
+	return nil
+}
+
+// BatchCreateLoopOut creates a batch of loop out swaps and backs them up.
+func (s *SwapStore) BatchCreateLoopOut(ctx context.Context,
+	swaps map[lntypes.Hash]*loopdb.LoopOutContract) error {
+
+	err := s.SwapStore.BatchCreateLoopOut(ctx, swaps)
+	if err != nil {
+		return err
+	}
+
+	s.updateBackup(ctx)
+
+	return nil
+}
+
+// UpdateLoopOut stores a new event for a loop out swap and updates the
+// backup if the state of the swap changed.
+func (s *SwapStore) UpdateLoopOut(ctx context.Context, hash lntypes.Hash,
+	time time.Time, state loopdb.SwapStateData) error {
+
+	err := s.SwapStore.UpdateLoopOut(ctx, hash, time, state)
+	if err != nil {
+		return err
+	}
+
+	if s.stateChanged(hash, state.State) {
+		s.updateBackup(ctx)
+	}
+
+	return nil
+}
+
+// CreateLoopIn adds an initiated swap to the store and backs it up.
+func (s *SwapStore) CreateLoopIn(ctx context.Context, hash lntypes.Hash,
+	swap *loopdb.LoopInContract) error {
+
+	err := s.SwapStore.CreateLoopIn(ctx, hash, swap)
+	if err != nil {
+		return err
+	}
+
+	s.updateBackup(ctx)
+
+	return nil
+}
+
+// BatchCreateLoopIn creates a batch of loop in swaps and backs them up.
+func (s *SwapStore) BatchCreateLoopIn(ctx context.Context,
+	swaps map[lntypes.Hash]*loopdb.LoopInContract) error {
+
+	err := s.SwapStore.BatchCreateLoopIn(ctx, swaps)
+	if err != nil {
+		return err
+	}
+
+	s.updateBackup(ctx)
+
+	return nil
+}
+
+// UpdateLoopIn stores a new event for a loop in swap and updates the backup if
+// the state of the swap changed.
+func (s *SwapStore) UpdateLoopIn(ctx context.Context, hash lntypes.Hash,
+	time time.Time, state loopdb.SwapStateData) error {
+
+	err := s.SwapStore.UpdateLoopIn(ctx, hash, time, state)
+	if err != nil {
+		return err
+	}
+
+	if s.stateChanged(hash, state.State) {
+		s.updateBackup(ctx)
+	}
+
+	return nil
+}
+
+// BatchInsertUpdate inserts a batch of swap updates and updates the backup.
+func (s *SwapStore) BatchInsertUpdate(ctx context.Context,
+	updateData map[lntypes.Hash][]loopdb.BatchInsertUpdateData) error {
+
+	err := s.SwapStore.BatchInsertUpdate(ctx, updateData)
+	if err != nil {
+		return err
+	}
+
+	s.updateBackup(ctx)
+
+	return nil
+}
+
+// updateBackup writes the backup and logs a failure.
+func (s *SwapStore) updateBackup(ctx context.Context) {
+	if err := s.backup.Update(ctx); err != nil {
+		log.Errorf("Unable to update swap backup: %v", err)
+	}
+}
+
+// stateChanged records the stored state of a swap and returns whether it
+// differs from the state that was stored before. Swaps that reached a final
+// state are no longer tracked, they are removed from the backup.
+func (s *SwapStore) stateChanged(hash lntypes.Hash,
+	state loopdb.SwapState) bool {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prevState, ok := s.states[hash]
+	if ok && prevState == state {
+		return false
+	}
+
+	if state.IsFinal() {
+		delete(s.states, hash)
+	} else {
+		s.states[hash] = state
+	}
+
+	return true
+}
@@ -23,6 +23,14 @@ type ReservationServiceClient interface {
 	ReservationNotificationStream(ctx context.Context, in *ReservationNotificationRequest, opts ...grpc.CallOption) (ReservationService_ReservationNotificationStreamClient, error)
 	// OpenReservation requests a new reservation UTXO from the server.
 	OpenReservation(ctx context.Context, in *ServerOpenReservationRequest, opts ...grpc.CallOption) (*ServerOpenReservationResponse, error)
+	// QuoteReservation returns the fee the server charges for opening a
+	// reservation with the given value and expiry on request of the client.
+	QuoteReservation(ctx context.Context, in *ServerQuoteReservationRequest, opts ...grpc.CallOption) (*ServerQuoteReservationResponse, error)
+	// RequestReservation asks the server to open a reservation with the
+	// given value and expiry. The server responds with the parameters of the
+	// reservation and an invoice for its fee. Once the invoice is paid, the
+	// client confirms the reservation with OpenReservation.
+	RequestReservation(ctx context.Context, in *ServerRequestReservationRequest, opts ...grpc.CallOption) (*ServerRequestReservationResponse, error)
 }
 
 type reservationServiceClient struct {
@@ -74,6 +82,24 @@ func (c *reservationServiceClient) OpenReservation(ctx context.Context, in *Serv
 	return out, nil
 }
 
+func (c *reservationServiceClient) QuoteReservation(ctx context.Context, in *ServerQuoteReservationRequest, opts ...grpc.CallOption) (*ServerQuoteReservationResponse, error) {
+	out := new(ServerQuoteReservationResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.ReservationService/QuoteReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationServiceClient) RequestReservation(ctx context.Context, in *ServerRequestReservationRequest, opts ...grpc.CallOption) (*ServerRequestReservationResponse, error) {
+	out := new(ServerRequestReservationResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.ReservationService/RequestReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReservationServiceServer is the server API for ReservationService service.
 // All implementations must embed UnimplementedReservationServiceServer
 // for forward compatibility
@@ -83,6 +109,14 @@ type ReservationServiceServer interface {
 	ReservationNotificationStream(*ReservationNotificationRequest, ReservationService_ReservationNotificationStreamServer) error
 	// OpenReservation requests a new reservation UTXO from the server.
 	OpenReservation(context.Context, *ServerOpenReservationRequest) (*ServerOpenReservationResponse, error)
+	// QuoteReservation returns the fee the server charges for opening a
+	// reservation with the given value and expiry on request of the client.
+	QuoteReservation(context.Context, *ServerQuoteReservationRequest) (*ServerQuoteReservationResponse, error)
+	// RequestReservation asks the server to open a reservation with the
+	// given value and expiry. The server responds with the parameters of the
+	// reservation and an invoice for its fee. Once the invoice is paid, the
+	// client confirms the reservation with OpenReservation.
+	RequestReservation(context.Context, *ServerRequestReservationRequest) (*ServerRequestReservationResponse, error)
 	mustEmbedUnimplementedReservationServiceServer()
 }
 
@@ -96,6 +130,12 @@ func (UnimplementedReservationServiceServer) ReservationNotificationStream(*Rese
 func (UnimplementedReservationServiceServer) OpenReservation(context.Context, *ServerOpenReservationRequest) (*ServerOpenReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenReservation not implemented")
 }
+func (UnimplementedReservationServiceServer) QuoteReservation(context.Context, *ServerQuoteReservationRequest) (*ServerQuoteReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteReservation not implemented")
+}
+func (UnimplementedReservationServiceServer) RequestReservation(context.Context, *ServerRequestReservationRequest) (*ServerRequestReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReservation not implemented")
+}
 func (UnimplementedReservationServiceServer) mustEmbedUnimplementedReservationServiceServer() {}
 
 // UnsafeReservationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -148,6 +188,42 @@ func _ReservationService_OpenReservation_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReservationService_QuoteReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerQuoteReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).QuoteReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.ReservationService/QuoteReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).QuoteReservation(ctx, req.(*ServerQuoteReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReservationService_RequestReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerRequestReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServiceServer).RequestReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.ReservationService/RequestReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServiceServer).RequestReservation(ctx, req.(*ServerRequestReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReservationService_ServiceDesc is the grpc.ServiceDesc for ReservationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -159,6 +235,14 @@ var ReservationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OpenReservation",
 			Handler:    _ReservationService_OpenReservation_Handler,
 		},
+		{
+			MethodName: "QuoteReservation",
+			Handler:    _ReservationService_QuoteReservation_Handler,
+		},
+		{
+			MethodName: "RequestReservation",
+			Handler:    _ReservationService_RequestReservation_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -21,6 +21,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btclog"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
 	sweeppkg "github.com/lightninglabs/loop/sweep"
@@ -89,7 +90,8 @@ type sweep struct {
 	htlcKeys loopdb.HtlcKeys
 
 	// htlcSuccessEstimator is a function that estimates the weight of the
-	// HTLC success script.
+	// HTLC success script. For timeout sweeps it estimates the weight of
+	// the HTLC timeout script.
 	htlcSuccessEstimator func(*input.TxWeightEstimator) error
 
 	// protocolVersion is the protocol version of the swap that the sweep
@@ -99,6 +101,14 @@ type sweep struct {
 	// isExternalAddr is true if the sweep spends to a non-wallet address.
 	isExternalAddr bool
 
+	// isTimeoutSweep is true if the sweep spends the HTLC using the
+	// timeout path instead of the preimage.
+	isTimeoutSweep bool
+
+	// maxFee is the maximum share of the batch fee that the sweep pays.
+	// Zero means that the share isn't limited.
+	maxFee btcutil.Amount
+
 	// destAddr is the destination address of the sweep.
 	destAddr btcutil.Address
 
@@ -515,6 +525,20 @@ func (b *batch) addSweep(ctx context.Context, sweep *sweep) (bool, error) {
 		}
 	}
 
+	// Timeout sweeps and success sweeps use different spending paths and
+	// lock times, so we never mix them in a single batch.
+	for _, s := range b.sweeps {
+		if s.isTimeoutSweep != sweep.isTimeoutSweep {
+			b.log.Infof("the batch and sweep %x use different "+
+				"spending paths (timeout: %v)",
+				sweep.swapHash[:6], sweep.isTimeoutSweep)
+
+			return false, nil
+		}
+
+		break
+	}
+
 	// Check the timeout of the incoming sweep against the timeout of all
 	// already contained sweeps. If that difference exceeds the configured
 	// maximum we cannot add this sweep.
@@ -808,12 +832,18 @@ func (b *batch) publish(ctx context.Context) error {
 		b.publishErrorHandler(err, errMsg, b.log)
 	}
 
-	if b.cfg.mixedBatch {
+	// Timeout sweeps can only be spent by us alone, so there is no point
+	// in asking the server to sign them cooperatively.
+	switch {
+	case b.isTimeoutBatch():
+
+	case b.cfg.mixedBatch:
 		fee, err, signSuccess = b.publishMixedBatch(ctx)
 		if err != nil {
 			logPublishError("mixed batch publish error", err)
 		}
-	} else {
+
+	default:
 		fee, err, signSuccess = b.publishBatchCoop(ctx)
 		if err != nil {
 			logPublishError("co-op publish error", err)
@@ -840,6 +870,16 @@ func (b *batch) publish(ctx context.Context) error {
 	return b.persist(ctx)
 }
 
+// isTimeoutBatch returns true if the sweeps of the batch are spent using the
+// timeout path of their HTLCs.
+func (b *batch) isTimeoutBatch() bool {
+	for _, sweep := range b.sweeps {
+		return sweep.isTimeoutSweep
+	}
+
+	return false
+}
+
 // publishBatch creates and publishes the batch transaction. It will consult the
 // RBFCache to determine the fee rate to use.
 func (b *batch) publishBatch(ctx context.Context) (btcutil.Amount, error) {
@@ -847,6 +887,20 @@ func (b *batch) publishBatch(ctx context.Context) (btcutil.Amount, error) {
 	batchTx := wire.NewMsgTx(2)
 	batchTx.LockTime = uint32(b.currentHeight)
 
+	// The lock time of a timeout sweep must not be lower than the expiry
+	// of any of its HTLCs.
+	isTimeoutBatch := b.isTimeoutBatch()
+	if isTimeoutBatch {
+		for _, sweep := range b.sweeps {
+			if sweep.timeout > b.currentHeight {
+				return 0, fmt.Errorf("htlc of sweep %x "+
+					"expires at height %d, current "+
+					"height is %d", sweep.swapHash[:6],
+					sweep.timeout, b.currentHeight)
+			}
+		}
+	}
+
 	var (
 		batchAmt  btcutil.Amount
 		prevOuts  = make([]*wire.TxOut, 0, len(b.sweeps))
@@ -867,10 +921,15 @@ func (b *batch) publishBatch(ctx context.Context) (btcutil.Amount, error) {
 			addrOverride = true
 		}
 
+		sequence := sweep.htlc.SuccessSequence()
+		if sweep.isTimeoutSweep {
+			sequence = 0
+		}
+
 		batchAmt += sweep.value
 		batchTx.AddTxIn(&wire.TxIn{
 			PreviousOutPoint: sweep.outpoint,
-			Sequence:         sweep.htlc.SuccessSequence(),
+			Sequence:         sequence,
 		})
 
 		err := sweep.htlcSuccessEstimator(&weightEstimate)
@@ -889,16 +948,21 @@ func (b *batch) publishBatch(ctx context.Context) (btcutil.Amount, error) {
 			PkScript: sweep.htlc.PkScript,
 		})
 
-		key, err := btcec.ParsePubKey(
-			sweep.htlcKeys.ReceiverScriptKey[:],
-		)
+		scriptKey := sweep.htlcKeys.ReceiverScriptKey
+		witnessScript := sweep.htlc.SuccessScript()
+		if sweep.isTimeoutSweep {
+			scriptKey = sweep.htlcKeys.SenderScriptKey
+			witnessScript = sweep.htlc.TimeoutScript()
+		}
+
+		key, err := btcec.ParsePubKey(scriptKey[:])
 		if err != nil {
 			return fee, err
 		}
 
 		// Create and store the sign descriptor for this sweep.
 		signDesc := lndclient.SignDescriptor{
-			WitnessScript: sweep.htlc.SuccessScript(),
+			WitnessScript: witnessScript,
 			Output:        prevOuts[len(prevOuts)-1],
 			HashType:      sweep.htlc.SigHash(),
 			InputIndex:    inputCounter,
@@ -947,8 +1011,10 @@ func (b *batch) publishBatch(ctx context.Context) (btcutil.Amount, error) {
 	weight := weightEstimate.Weight()
 	feeForWeight := b.rbfCache.FeeRate.FeeForWeight(weight)
 
-	// Clamp the calculated fee to the max allowed fee amount for the batch.
+	// Clamp the calculated fee to the max allowed fee amount for the batch
+	// and to the max fee shares of its sweeps.
 	fee = clampBatchFee(feeForWeight, batchAmt)
+	fee = clampSweepsFee(fee, sweeps)
 
 	// Add the batch transaction output, which excludes the fees paid to
 	// miners.
@@ -966,15 +1032,20 @@ func (b *batch) publishBatch(ctx context.Context) (btcutil.Amount, error) {
 	}
 
 	for i, sweep := range sweeps {
-		// Generate the success witness for the sweep.
-		witness, err := sweep.htlc.GenSuccessWitness(
-			rawSigs[i], sweep.preimage,
-		)
+		// Generate the success or timeout witness for the sweep.
+		var witness wire.TxWitness
+		if sweep.isTimeoutSweep {
+			witness, err = sweep.htlc.GenTimeoutWitness(rawSigs[i])
+		} else {
+			witness, err = sweep.htlc.GenSuccessWitness(
+				rawSigs[i], sweep.preimage,
+			)
+		}
 		if err != nil {
 			return fee, err
 		}
 
-		// Add the success witness to our batch transaction's inputs.
+		// Add the witness to our batch transaction's inputs.
 		batchTx.TxIn[i].Witness = witness
 	}
 
@@ -985,9 +1056,12 @@ func (b *batch) publishBatch(ctx context.Context) (btcutil.Amount, error) {
 
 	b.debugLogTx("serialized non-coop sweep", batchTx)
 
-	err = b.wallet.PublishTransaction(
-		ctx, batchTx, b.cfg.txLabeler(b.id),
-	)
+	label := b.cfg.txLabeler(b.id)
+	if isTimeoutBatch {
+		label = labels.LoopInBatchSweepTimeout(b.id)
+	}
+
+	err = b.wallet.PublishTransaction(ctx, batchTx, label)
 	if err != nil {
 		return fee, err
 	}
@@ -2132,6 +2206,35 @@ func clampBatchFee(fee btcutil.Amount,
 	return fee
 }
 
+// clampSweepsFee makes sure that the share of the batch fee that each sweep
+// pays doesn't exceed its max fee. The fee is split evenly between the sweeps
+// and one of them pays the rounding difference on top of its share.
+func clampSweepsFee(fee btcutil.Amount, sweeps []sweep) btcutil.Amount {
+	var maxFee btcutil.Amount
+	for _, sweep := range sweeps {
+		if sweep.maxFee != 0 && (maxFee == 0 || sweep.maxFee < maxFee) {
+			maxFee = sweep.maxFee
+		}
+	}
+
+	if maxFee == 0 {
+		return fee
+	}
+
+	numSweeps := btcutil.Amount(len(sweeps))
+	if fee > maxFee*numSweeps {
+		fee = maxFee * numSweeps
+	}
+
+	// Drop the rounding difference if the sweep that pays it would exceed
+	// the max fee.
+	if fee/numSweeps+fee%numSweeps > maxFee {
+		fee -= fee % numSweeps
+	}
+
+	return fee
+}
+
 func stateEnumToString(state batchState) string {
 	switch state {
 	case Open:
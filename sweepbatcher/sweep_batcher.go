@@ -50,6 +50,11 @@ const (
 	// defaultTestnetPublishDelay is the default publish delay that is used
 	// for testnet.
 	defaultTestnetPublishDelay = 500 * time.Millisecond
+
+	// loopInTimeoutConfTarget is the confirmation target of the timeout
+	// sweeps of loop in swaps. It matches the confirmation target used
+	// for standalone loop in timeout transactions.
+	loopInTimeoutConfTarget = 2
 )
 
 type BatcherStore interface {
@@ -114,7 +119,8 @@ type SweepInfo struct {
 	HTLCKeys loopdb.HtlcKeys
 
 	// HTLCSuccessEstimator is a function that estimates the weight of the
-	// HTLC success script.
+	// HTLC success script. For timeout sweeps it must estimate the weight
+	// of the HTLC timeout script instead.
 	HTLCSuccessEstimator func(*input.TxWeightEstimator) error
 
 	// ProtocolVersion is the protocol version of the swap that the sweep
@@ -124,6 +130,16 @@ type SweepInfo struct {
 	// IsExternalAddr is true if the sweep spends to a non-wallet address.
 	IsExternalAddr bool
 
+	// IsTimeoutSweep is true if the HTLC is swept back to our wallet using
+	// the timeout path, as done for loop in swaps that timed out. Timeout
+	// sweeps are always signed by us alone and are never batched together
+	// with success sweeps.
+	IsTimeoutSweep bool
+
+	// MaxFee is the maximum share of the batch fee that the sweep pays.
+	// Zero means that the share isn't limited.
+	MaxFee btcutil.Amount
+
 	// DestAddr is the destination address of the sweep.
 	DestAddr btcutil.Address
 
@@ -997,7 +1013,17 @@ type LoopOutFetcher interface {
 		hash lntypes.Hash) (*loopdb.LoopOut, error)
 }
 
+// LoopInFetcher is used to load LoopIn swaps from the database.
+// It is implemented by loopdb.SwapStore.
+type LoopInFetcher interface {
+	// FetchLoopInSwap returns the loop in swap with the given hash.
+	FetchLoopInSwap(ctx context.Context,
+		hash lntypes.Hash) (*loopdb.LoopIn, error)
+}
+
 // SwapStoreWrapper is LoopOutFetcher wrapper providing SweepFetcher interface.
+// If the wrapped store also implements LoopInFetcher, the timeout sweeps of
+// loop in swaps are supported as well.
 type SwapStoreWrapper struct {
 	// swapStore is used to load LoopOut swaps from the database.
 	swapStore LoopOutFetcher
@@ -1014,8 +1040,22 @@ func (f *SwapStoreWrapper) FetchSweep(ctx context.Context,
 
 	swap, err := f.swapStore.FetchLoopOutSwap(ctx, swapHash)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch loop out for %x: %w",
-			swapHash[:6], err)
+		// The sweep may belong to a loop in swap that timed out.
+		loopInFetcher, ok := f.swapStore.(LoopInFetcher)
+		if !ok {
+			return nil, fmt.Errorf("failed to fetch loop out for "+
+				"%x: %w", swapHash[:6], err)
+		}
+
+		loopIn, loopInErr := loopInFetcher.FetchLoopInSwap(
+			ctx, swapHash,
+		)
+		if loopInErr != nil {
+			return nil, fmt.Errorf("failed to fetch loop out or "+
+				"loop in for %x: %w", swapHash[:6], err)
+		}
+
+		return f.loopInTimeoutSweep(loopIn)
 	}
 
 	htlc, err := utils.GetHtlc(
@@ -1047,6 +1087,32 @@ func (f *SwapStoreWrapper) FetchSweep(ctx context.Context,
 	}, nil
 }
 
+// loopInTimeoutSweep returns the details of the sweep that spends the htlc of
+// the passed loop in swap using the timeout path.
+func (f *SwapStoreWrapper) loopInTimeoutSweep(
+	loopIn *loopdb.LoopIn) (*SweepInfo, error) {
+
+	htlc, err := utils.GetHtlc(
+		loopIn.Hash, &loopIn.Contract.SwapContract, f.chainParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get htlc: %w", err)
+	}
+
+	return &SweepInfo{
+		ConfTarget:           loopInTimeoutConfTarget,
+		Timeout:              loopIn.Contract.CltvExpiry,
+		InitiationHeight:     loopIn.Contract.InitiationHeight,
+		HTLC:                 *htlc,
+		HTLCKeys:             loopIn.Contract.HtlcKeys,
+		HTLCSuccessEstimator: htlc.AddTimeoutToEstimator,
+		ProtocolVersion:      loopIn.Contract.ProtocolVersion,
+		IsTimeoutSweep:       true,
+		MaxFee:               loopIn.Contract.MaxMinerFee,
+		NonCoopHint:          true,
+	}, nil
+}
+
 // NewSweepFetcherFromSwapStore accepts swapStore (e.g. loopdb) and returns
 // a wrapper implementing SweepFetcher interface (suitable for NewBatcher).
 func NewSweepFetcherFromSwapStore(swapStore LoopOutFetcher,
@@ -1117,6 +1183,8 @@ func (b *Batcher) loadSweep(ctx context.Context, swapHash lntypes.Hash,
 		htlcSuccessEstimator:   s.HTLCSuccessEstimator,
 		protocolVersion:        s.ProtocolVersion,
 		isExternalAddr:         s.IsExternalAddr,
+		isTimeoutSweep:         s.IsTimeoutSweep,
+		maxFee:                 s.MaxFee,
 		destAddr:               s.DestAddr,
 		minFeeRate:             minFeeRate,
 		nonCoopHint:            s.NonCoopHint,
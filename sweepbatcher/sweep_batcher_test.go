@@ -1894,6 +1894,200 @@ func testSweepBatcherNonWalletAddr(t *testing.T, store testStore,
 	require.True(t, batcherStore.AssertSweepStored(sweepReq3.SwapHash))
 }
 
+// testSweepBatcherLoopInTimeout tests that the timeout sweeps of loop in swaps
+// are batched together, spend the timeout path of their htlcs, don't pay more
+// than the max miner fee of their swaps and are never batched together with
+// loop out sweeps.
+func testSweepBatcherLoopInTimeout(t *testing.T, store testStore,
+	batcherStore testBatcherStore) {
+
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sweepStore, err := NewSweepFetcherFromSwapStore(store, lnd.ChainParams)
+	require.NoError(t, err)
+
+	walletKit := &walletKitWrapper{WalletKitClient: lnd.WalletKit}
+
+	batcher := NewBatcher(walletKit, lnd.ChainNotifier, lnd.Signer,
+		testMuSig2SignSweep, testVerifySchnorrSig, lnd.ChainParams,
+		batcherStore, sweepStore)
+	go func() {
+		err := batcher.Run(ctx)
+		checkBatcherError(t, err)
+	}()
+
+	// Create the timeout sweep of a loop in swap that already expired.
+	sweepReq1 := SweepRequest{
+		SwapHash: lntypes.Hash{1, 1, 1},
+		Value:    111,
+		Outpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1, 1},
+			Index: 1,
+		},
+		Notifier: &dummyNotifier,
+	}
+
+	swap1 := &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			CltvExpiry:      590,
+			AmountRequested: 111,
+			ProtocolVersion: loopdb.ProtocolVersionMuSig2,
+			HtlcKeys:        htlcKeys,
+			MaxMinerFee:     10,
+
+			// Make preimage unique to pass SQL constraints.
+			Preimage: lntypes.Preimage{1},
+		},
+		HtlcConfTarget: 2,
+	}
+
+	err = store.CreateLoopIn(ctx, sweepReq1.SwapHash, swap1)
+	require.NoError(t, err)
+	store.AssertLoopInStored()
+
+	require.NoError(t, batcher.AddSweep(&sweepReq1))
+
+	// Since a batch was created we check that it registered for its primary
+	// sweep's spend.
+	<-lnd.RegisterSpendChannel
+
+	require.Eventually(t, func() bool {
+		return len(batcher.batches) == 1
+	}, test.Timeout, eventuallyCheckFrequency)
+
+	// The batch is published right away, using the timeout path of the
+	// htlc and the loop in batch label.
+	signReq := <-lnd.SignOutputRawChannel
+	require.Len(t, signReq.SignDescriptors, 1)
+	require.Equal(
+		t, htlcKeys.SenderScriptKey[:],
+		signReq.SignDescriptors[0].KeyDesc.PubKey.SerializeCompressed(),
+	)
+
+	tx := <-lnd.TxPublishChannel
+	require.Len(t, tx.TxIn, 1)
+	require.Equal(t, uint32(600), tx.LockTime)
+	require.Zero(t, tx.TxIn[0].Sequence)
+
+	// The fee doesn't exceed the max miner fee of the swap.
+	require.EqualValues(t, 111-10, tx.TxOut[0].Value)
+
+	// The timeout witness of a taproot htlc consists of the signature,
+	// the timeout script and the control block.
+	require.Len(t, tx.TxIn[0].Witness, 3)
+
+	timeoutBatch := getOnlyBatch(batcher)
+
+	wantLabel := fmt.Sprintf("BatchInSweepTimeout -- %d", timeoutBatch.id)
+	require.Equal(t, wantLabel, walletKit.lastLabel)
+
+	// A second loop in timeout sweep joins the same batch.
+	sweepReq2 := SweepRequest{
+		SwapHash: lntypes.Hash{2, 2, 2},
+		Value:    222,
+		Outpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{2, 2},
+			Index: 2,
+		},
+		Notifier: &dummyNotifier,
+	}
+
+	swap2 := &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			CltvExpiry:      595,
+			AmountRequested: 222,
+			ProtocolVersion: loopdb.ProtocolVersionMuSig2,
+			HtlcKeys:        htlcKeys,
+
+			// Make preimage unique to pass SQL constraints.
+			Preimage: lntypes.Preimage{2},
+		},
+		HtlcConfTarget: 2,
+	}
+
+	err = store.CreateLoopIn(ctx, sweepReq2.SwapHash, swap2)
+	require.NoError(t, err)
+	store.AssertLoopInStored()
+
+	require.NoError(t, batcher.AddSweep(&sweepReq2))
+
+	require.Eventually(t, func() bool {
+		return timeoutBatch.sweepExists(sweepReq2.SwapHash)
+	}, test.Timeout, eventuallyCheckFrequency)
+
+	// Tick tock next block.
+	err = lnd.NotifyHeight(601)
+	require.NoError(t, err)
+
+	signReq = <-lnd.SignOutputRawChannel
+	require.Len(t, signReq.SignDescriptors, 2)
+
+	tx = <-lnd.TxPublishChannel
+	require.Len(t, tx.TxIn, 2)
+	require.Equal(t, uint32(601), tx.LockTime)
+	for _, txIn := range tx.TxIn {
+		require.Zero(t, txIn.Sequence)
+	}
+
+	// The share of the first swap still doesn't exceed its max miner fee.
+	require.EqualValues(t, 111+222-2*10, tx.TxOut[0].Value)
+
+	// A loop out sweep is never batched with timeout sweeps, even if the
+	// timeout distance is small enough.
+	sweepReq3 := SweepRequest{
+		SwapHash: lntypes.Hash{3, 3, 3},
+		Value:    333,
+		Outpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{3, 3},
+			Index: 3,
+		},
+		Notifier: &dummyNotifier,
+	}
+
+	swap3 := &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			CltvExpiry:      700,
+			AmountRequested: 333,
+			ProtocolVersion: loopdb.ProtocolVersionMuSig2,
+			HtlcKeys:        htlcKeys,
+
+			// Make preimage unique to pass SQL constraints.
+			Preimage: lntypes.Preimage{3},
+		},
+
+		DestAddr:        destAddr,
+		SwapInvoice:     swapInvoice,
+		SweepConfTarget: 111,
+	}
+
+	err = store.CreateLoopOut(ctx, sweepReq3.SwapHash, swap3)
+	require.NoError(t, err)
+	store.AssertLoopOutStored()
+
+	require.NoError(t, batcher.AddSweep(&sweepReq3))
+
+	// Since a batch was created we check that it registered for its primary
+	// sweep's spend.
+	<-lnd.RegisterSpendChannel
+
+	require.Eventually(t, func() bool {
+		return len(batcher.batches) == 2
+	}, test.Timeout, eventuallyCheckFrequency)
+
+	tx = <-lnd.TxPublishChannel
+	require.Len(t, tx.TxIn, 1)
+	require.Equal(t, sweepReq3.Outpoint, tx.TxIn[0].PreviousOutPoint)
+
+	// Check that all sweeps were stored.
+	require.True(t, batcherStore.AssertSweepStored(sweepReq1.SwapHash))
+	require.True(t, batcherStore.AssertSweepStored(sweepReq2.SwapHash))
+	require.True(t, batcherStore.AssertSweepStored(sweepReq3.SwapHash))
+}
+
 // testSweepBatcherComposite tests that sweep requests that sweep to both wallet
 // addresses and non-wallet addresses enter the correct batches.
 func testSweepBatcherComposite(t *testing.T, store testStore,
@@ -2286,6 +2480,46 @@ func testGetFeePortionForSweep(t *testing.T, store testStore,
 	}
 }
 
+// TestClampSweepsFee tests that the batch fee is clamped so that no sweep pays
+// more than its max fee, including the rounding difference.
+func TestClampSweepsFee(t *testing.T) {
+	tests := []struct {
+		name        string
+		fee         btcutil.Amount
+		maxFees     []btcutil.Amount
+		expectedFee btcutil.Amount
+	}{
+		{
+			"No Max Fee",
+			500, []btcutil.Amount{0, 0}, 500,
+		},
+		{
+			"Below Max Fee",
+			150, []btcutil.Amount{100, 0}, 150,
+		},
+		{
+			"Lowest Max Fee",
+			500, []btcutil.Amount{100, 0, 200}, 300,
+		},
+		{
+			"Rounding Diff Exceeds Max Fee",
+			299, []btcutil.Amount{100, 100, 100}, 297,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sweeps := make([]sweep, 0, len(tt.maxFees))
+			for _, maxFee := range tt.maxFees {
+				sweeps = append(sweeps, sweep{maxFee: maxFee})
+			}
+
+			fee := clampSweepsFee(tt.fee, sweeps)
+			require.Equal(t, tt.expectedFee, fee)
+		})
+	}
+}
+
 // testRestoringEmptyBatch tests that the batcher can be restored with an empty
 // batch.
 func testRestoringEmptyBatch(t *testing.T, store testStore,
@@ -3762,6 +3996,12 @@ func TestSweepBatcherNonWalletAddr(t *testing.T) {
 	runTests(t, testSweepBatcherNonWalletAddr)
 }
 
+// TestSweepBatcherLoopInTimeout tests that the timeout sweeps of loop in
+// swaps are batched separately from loop out sweeps.
+func TestSweepBatcherLoopInTimeout(t *testing.T) {
+	runTests(t, testSweepBatcherLoopInTimeout)
+}
+
 // TestSweepBatcherComposite tests that sweep requests that sweep to both wallet
 // addresses and non-wallet addresses enter the correct batches.
 func TestSweepBatcherComposite(t *testing.T) {
@@ -3877,6 +4117,9 @@ type testStore interface {
 
 	// AssertLoopOutStored asserts that a swap is stored.
 	AssertLoopOutStored()
+
+	// AssertLoopInStored asserts that a loop in swap is stored.
+	AssertLoopInStored()
 }
 
 // loopdbStore wraps loopdb.SwapStore and implements testStore interface.
@@ -3886,6 +4129,8 @@ type loopdbStore struct {
 	t *testing.T
 
 	loopOutStoreChan chan struct{}
+
+	loopInStoreChan chan struct{}
 }
 
 // newLoopdbStore creates new loopdbStore instance.
@@ -3894,6 +4139,7 @@ func newLoopdbStore(t *testing.T, swapStore loopdb.SwapStore) *loopdbStore {
 		SwapStore:        swapStore,
 		t:                t,
 		loopOutStoreChan: make(chan struct{}, 1),
+		loopInStoreChan:  make(chan struct{}, 1),
 	}
 }
 
@@ -3920,6 +4166,29 @@ func (s *loopdbStore) AssertLoopOutStored() {
 	}
 }
 
+// CreateLoopIn adds an initiated loop in swap to the store.
+func (s *loopdbStore) CreateLoopIn(ctx context.Context, hash lntypes.Hash,
+	swap *loopdb.LoopInContract) error {
+
+	err := s.SwapStore.CreateLoopIn(ctx, hash, swap)
+	if err == nil {
+		s.loopInStoreChan <- struct{}{}
+	}
+
+	return err
+}
+
+// AssertLoopInStored asserts that a loop in swap is stored.
+func (s *loopdbStore) AssertLoopInStored() {
+	s.t.Helper()
+
+	select {
+	case <-s.loopInStoreChan:
+	case <-time.After(test.Timeout):
+		s.t.Fatalf("expected swap to be stored")
+	}
+}
+
 // runTests runs a test with both mock and loopdb.
 func runTests(t *testing.T, testFn func(t *testing.T, store testStore,
 	batcherStore testBatcherStore)) {
@@ -54,6 +54,7 @@ func NewMockLnd() *LndMockServices {
 		SpendChannel:                 make(chan *chainntnfs.SpendDetail),
 		TxPublishChannel:             make(chan *wire.MsgTx),
 		SendOutputsChannel:           make(chan wire.MsgTx),
+		BumpFeeChannel:               make(chan BumpFeeRequest),
 		SettleInvoiceChannel:         make(chan lntypes.Preimage),
 		SingleInvoiceSubcribeChannel: make(chan *SingleInvoiceSubscription, 1),
 
@@ -128,6 +129,12 @@ type SignOutputRawRequest struct {
 	SignDescriptors []*lndclient.SignDescriptor
 }
 
+// BumpFeeRequest contains the input data of a fee bump request.
+type BumpFeeRequest struct {
+	Outpoint wire.OutPoint
+	FeeRate  chainfee.SatPerKWeight
+}
+
 // LndMockServices provides a full set of mocked lnd services.
 type LndMockServices struct {
 	lndclient.LndServices
@@ -136,6 +143,7 @@ type LndMockServices struct {
 	SpendChannel         chan *chainntnfs.SpendDetail
 	TxPublishChannel     chan *wire.MsgTx
 	SendOutputsChannel   chan wire.MsgTx
+	BumpFeeChannel       chan BumpFeeRequest
 	SettleInvoiceChannel chan lntypes.Preimage
 	FailInvoiceChannel   chan lntypes.Hash
 	blockHeightListeners []chan int32
@@ -237,6 +245,12 @@ func (s *LndMockServices) IsDone() error {
 	default:
 	}
 
+	select {
+	case <-s.BumpFeeChannel:
+		return errors.New("BumpFeeChannel not empty")
+	default:
+	}
+
 	select {
 	case <-s.SettleInvoiceChannel:
 		return errors.New("SettleInvoiceChannel not empty")
@@ -182,8 +182,13 @@ func (m *mockWalletKit) ListSweepsVerbose(ctx context.Context, _ int32) (
 // child-pays-for-parent (CPFP) scenario. If the given output has been
 // used in a previous BumpFee call, then a transaction replacing the
 // previous is broadcast, resulting in a replace-by-fee (RBF) scenario.
-func (m *mockWalletKit) BumpFee(context.Context, wire.OutPoint,
-	chainfee.SatPerKWeight) error {
+func (m *mockWalletKit) BumpFee(_ context.Context, outpoint wire.OutPoint,
+	feeRate chainfee.SatPerKWeight) error {
+
+	m.lnd.BumpFeeChannel <- BumpFeeRequest{
+		Outpoint: outpoint,
+		FeeRate:  feeRate,
+	}
 
 	return nil
 }
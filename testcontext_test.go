@@ -109,6 +109,9 @@ func newSwapClient(t *testing.T, config *clientConfig) *Client {
 		executor:     executor,
 		resumeReady:  make(chan struct{}),
 		abandonChans: make(map[lntypes.Hash]chan struct{}),
+		externalHtlcChans: make(
+			map[lntypes.Hash]chan *externalHtlcTx,
+		),
 	}
 }
 